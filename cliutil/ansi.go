@@ -0,0 +1,161 @@
+package cliutil
+
+import (
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/gookit/goutil/strutil"
+)
+
+// match the ANSI escape sequences: CSI(eg: color codes, cursor control) and OSC(eg: hyperlinks)
+var ansiRegex = regexp.MustCompile("\x1b\\[[0-9;?]*[ -/]*[@-~]|\x1b\\][^\x07\x1b]*(?:\x07|\x1b\\\\)")
+
+// StripANSI remove the ANSI escape sequences from the string
+//
+// Usage:
+// 	cliutil.StripANSI("\x1b[31mred\x1b[0m") // "red"
+func StripANSI(s string) string {
+	if !strings.Contains(s, "\x1b") {
+		return s
+	}
+	return ansiRegex.ReplaceAllString(s, "")
+}
+
+// VisibleWidth get the display width of the string on terminal, the ANSI escape sequences will be ignored.
+//
+// Usage:
+// 	cliutil.VisibleWidth("\x1b[31m中文\x1b[0m") // 4
+func VisibleWidth(s string) int {
+	return strutil.TextWidth(StripANSI(s))
+}
+
+// PadANSI padding the string with spaces to the width, by the visible width.
+func PadANSI(s string, width int) string {
+	if n := width - VisibleWidth(s); n > 0 {
+		return s + strings.Repeat(" ", n)
+	}
+	return s
+}
+
+// WrapANSI wrap the text by the visible width, will break at spaces if possible.
+// the active color styles will be reset at line end and restored at next line start.
+//
+// Usage:
+// 	text := cliutil.WrapANSI(longColoredText, cliutil.TermWidth())
+func WrapANSI(s string, width int) string {
+	if width <= 0 {
+		return s
+	}
+
+	ww := &ansiWrapper{width: width}
+	for i, line := range strings.Split(s, "\n") {
+		if i > 0 {
+			ww.newline(false)
+		}
+		ww.wrapLine(line)
+	}
+	return ww.sb.String()
+}
+
+type ansiWrapper struct {
+	sb    strings.Builder
+	width int
+	// the current line width
+	lineW int
+	// the active SGR sequences, will be restored at new line
+	active string
+}
+
+func (ww *ansiWrapper) wrapLine(line string) {
+	var pending string // pending spaces before next word
+	for _, tok := range splitWords(line) {
+		if strings.TrimLeft(tok, " \t") == "" {
+			pending += tok
+			continue
+		}
+
+		w, spaceW := VisibleWidth(tok), strutil.TextWidth(pending)
+		if ww.lineW > 0 && ww.lineW+spaceW+w > ww.width {
+			ww.newline(true)
+		} else {
+			ww.sb.WriteString(pending)
+			ww.lineW += spaceW
+		}
+
+		pending = ""
+		ww.writeWord(tok, w)
+	}
+}
+
+// writeWord write the word, will hard break it on the word is too long.
+func (ww *ansiWrapper) writeWord(word string, w int) {
+	if ww.lineW+w <= ww.width {
+		ww.writeEscapes(word)
+		ww.sb.WriteString(word)
+		ww.lineW += w
+		return
+	}
+
+	for len(word) > 0 {
+		if loc := ansiRegex.FindStringIndex(word); loc != nil && loc[0] == 0 {
+			ww.writeEscapes(word[:loc[1]])
+			ww.sb.WriteString(word[:loc[1]])
+			word = word[loc[1]:]
+			continue
+		}
+
+		r, size := utf8.DecodeRuneInString(word)
+		rw := strutil.RuneWidth(r)
+		if ww.lineW > 0 && ww.lineW+rw > ww.width {
+			ww.newline(true)
+		}
+
+		ww.sb.WriteString(word[:size])
+		ww.lineW += rw
+		word = word[size:]
+	}
+}
+
+// writeEscapes track the SGR sequences in the text
+func (ww *ansiWrapper) writeEscapes(text string) {
+	for _, seq := range ansiRegex.FindAllString(text, -1) {
+		if !strings.HasSuffix(seq, "m") || !strings.HasPrefix(seq, "\x1b[") {
+			continue
+		}
+
+		if seq == "\x1b[0m" || seq == "\x1b[m" {
+			ww.active = ""
+		} else {
+			ww.active += seq
+		}
+	}
+}
+
+func (ww *ansiWrapper) newline(wrapped bool) {
+	if ww.active != "" && wrapped {
+		ww.sb.WriteString("\x1b[0m\n" + ww.active)
+	} else {
+		ww.sb.WriteByte('\n')
+	}
+	ww.lineW = 0
+}
+
+// splitWords split the line to words and spaces, the escape sequences is a part of the word.
+func splitWords(line string) []string {
+	var toks []string
+	start, inSpace := 0, false
+	for i, r := range line {
+		isSpace := r == ' ' || r == '\t'
+		if i > start && isSpace != inSpace {
+			toks = append(toks, line[start:i])
+			start = i
+		}
+		inSpace = isSpace
+	}
+
+	if start < len(line) {
+		toks = append(toks, line[start:])
+	}
+	return toks
+}
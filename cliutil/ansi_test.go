@@ -0,0 +1,40 @@
+package cliutil_test
+
+import (
+	"testing"
+
+	"github.com/gookit/goutil/cliutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStripANSI(t *testing.T) {
+	assert.Equal(t, "abc", cliutil.StripANSI("abc"))
+	assert.Equal(t, "red", cliutil.StripANSI("\x1b[31mred\x1b[0m"))
+	assert.Equal(t, "bold green", cliutil.StripANSI("\x1b[1;32mbold green\x1b[m"))
+	assert.Equal(t, "up", cliutil.StripANSI("\x1b[2Aup\x1b[K"))
+	assert.Equal(t, "link", cliutil.StripANSI("\x1b]8;;https://example.com\x1b\\link\x1b]8;;\x1b\\"))
+	assert.Equal(t, "title", cliutil.StripANSI("\x1b]0;my title\x07title"))
+}
+
+func TestVisibleWidth(t *testing.T) {
+	assert.Equal(t, 3, cliutil.VisibleWidth("abc"))
+	assert.Equal(t, 4, cliutil.VisibleWidth("\x1b[31m中文\x1b[0m"))
+	assert.Equal(t, "\x1b[31mab\x1b[0m  ", cliutil.PadANSI("\x1b[31mab\x1b[0m", 4))
+	assert.Equal(t, "abc", cliutil.PadANSI("abc", 2))
+}
+
+func TestWrapANSI(t *testing.T) {
+	assert.Equal(t, "abc", cliutil.WrapANSI("abc", 0))
+	assert.Equal(t, "the quick\nbrown fox\njumps", cliutil.WrapANSI("the quick brown fox jumps", 10))
+	assert.Equal(t, "abcde\nfghij\nk", cliutil.WrapANSI("abcdefghijk", 5))
+	assert.Equal(t, "  ab\ncd\n\nef", cliutil.WrapANSI("  ab cd\n\nef", 4))
+	assert.Equal(t, "中文\n字符", cliutil.WrapANSI("中文字符", 5))
+
+	// with color
+	s := cliutil.WrapANSI("\x1b[31mthe quick brown\x1b[0m fox", 10)
+	assert.Equal(t, "\x1b[31mthe quick\x1b[0m\n\x1b[31mbrown\x1b[0m fox", s)
+	assert.Equal(t, "the quick\nbrown fox", cliutil.StripANSI(s))
+
+	s = cliutil.WrapANSI("\x1b[32mabcdefgh\x1b[0m", 4)
+	assert.Equal(t, "\x1b[32mabcd\x1b[0m\n\x1b[32mefgh\x1b[0m", s)
+}
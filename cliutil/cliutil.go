@@ -30,10 +30,31 @@ func StringToOSArgs(line string) []string {
 }
 
 // ParseLine input command line text. alias of the StringToOSArgs()
+//
+// NOTICE: it is a loose parser, use SplitLine() for strict parse by OS rules.
 func ParseLine(line string) []string {
 	return cmdline.NewParser(line).Parse()
 }
 
+// SplitLine split the command line string to args by the rules of the current OS,
+// will return error on the quote is not closed.
+//
+// Usage:
+// 	args, err := cliutil.SplitLine(`git commit -m "the message"`)
+func SplitLine(line string) ([]string, error) {
+	return cmdline.Split(line)
+}
+
+// QuoteArg quote the arg by the rules of the current OS, returns raw arg on not need quote.
+func QuoteArg(arg string) string {
+	return cmdline.QuoteArg(arg)
+}
+
+// JoinArgs quote each arg and join them to command line string. it is the reverse of the SplitLine()
+func JoinArgs(args []string) string {
+	return cmdline.JoinArgs(args)
+}
+
 // QuickExec quick exec an simple command line
 func QuickExec(cmdLine string, workDir ...string) (string, error) {
 	return sysutil.ExecLine(cmdLine, workDir...)
@@ -83,3 +83,17 @@ func TestParseLine(t *testing.T) {
 	assert.Len(t, args, 7)
 	assert.Equal(t, "msg text", args[6])
 }
+
+func TestSplitLine(t *testing.T) {
+	args, err := cliutil.SplitLine(`./app top sub -a ddd --xx "msg text"`)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"./app", "top", "sub", "-a", "ddd", "--xx", "msg text"}, args)
+
+	_, err = cliutil.SplitLine(`./app --xx "msg`)
+	assert.Error(t, err)
+
+	assert.Equal(t, "abc", cliutil.QuoteArg("abc"))
+	args, err = cliutil.SplitLine(cliutil.JoinArgs([]string{"./app", "--xx", `it's "msg"`}))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"./app", "--xx", `it's "msg"`}, args)
+}
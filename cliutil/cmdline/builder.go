@@ -43,6 +43,17 @@ func (b *LineBuilder) AddArray(args []string) {
 // WriteString arg string to the builder, will auto quote special string.
 // refer strconv.Quote()
 func (b *LineBuilder) WriteString(a string) (int, error) {
+	// contains both quote chars, use the POSIX quote
+	if strings.ContainsRune(a, '"') && strings.ContainsRune(a, '\'') {
+		a = QuotePosix(a)
+		if b.buf != nil {
+			b.buf = append(b.buf, ' ')
+		}
+
+		b.buf = append(b.buf, a...)
+		return len(a) + 1, nil
+	}
+
 	var quote byte
 	if strings.ContainsRune(a, '"') {
 		quote = '\''
@@ -44,3 +44,12 @@ func TestLineBuild(t *testing.T) {
 	b.AddArgs("myapp", "-a", `the 'val0' of option`)
 	assert.Equal(t, `myapp -a "the 'val0' of option"`, b.String())
 }
+
+func TestLineBuilder_bothQuotes(t *testing.T) {
+	b := cmdline.NewBuilder("myapp", "-a", `it's "val0"`)
+	assert.Equal(t, `myapp -a 'it'\''s "val0"'`, b.String())
+
+	args, err := cmdline.SplitPosix(b.String())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"myapp", "-a", `it's "val0"`}, args)
+}
@@ -0,0 +1,254 @@
+package cmdline
+
+import (
+	"errors"
+	"runtime"
+	"strings"
+)
+
+// ErrUnclosedQuote the quote is not closed on split the command line
+var ErrUnclosedQuote = errors.New("cmdline: the quote is not closed")
+
+// ErrTrailingEscape the line ends with the escape char '\'
+var ErrTrailingEscape = errors.New("cmdline: the line ends with escape char")
+
+// Split the command line string to args by the rules of the current OS.
+// see SplitPosix() and SplitWindows()
+func Split(line string) ([]string, error) {
+	if runtime.GOOS == "windows" {
+		return SplitWindows(line)
+	}
+	return SplitPosix(line)
+}
+
+// QuoteArg quote the arg by the rules of the current OS. see QuotePosix() and QuoteWindows()
+func QuoteArg(arg string) string {
+	if runtime.GOOS == "windows" {
+		return QuoteWindows(arg)
+	}
+	return QuotePosix(arg)
+}
+
+// JoinArgs quote each arg and join them to command line string by the rules of the current OS.
+func JoinArgs(args []string) string {
+	quoted := make([]string, len(args))
+	for i, arg := range args {
+		quoted[i] = QuoteArg(arg)
+	}
+	return strings.Join(quoted, " ")
+}
+
+// SplitPosix split the command line string by the POSIX shell rules:
+//
+// - the args split by whitespaces
+// - the contents in single quotes is literal
+// - in double quotes, the '\' only escape the chars: $ ` " \ and newline
+// - out of quotes, the '\' escape any next char
+//
+// Usage:
+// 	args, err := cmdline.SplitPosix(`git commit -m "the message"`)
+// 	// args: []string{"git", "commit", "-m", "the message"}
+func SplitPosix(line string) ([]string, error) {
+	var args []string
+	var sb strings.Builder
+
+	// inArg mark has an arg, the empty quotes "" also is an arg.
+	inArg := false
+	runes := []rune(line)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch r {
+		case ' ', '\t', '\n', '\r':
+			if inArg {
+				args = append(args, sb.String())
+				sb.Reset()
+				inArg = false
+			}
+		case '\\':
+			i++
+			if i >= len(runes) {
+				return nil, ErrTrailingEscape
+			}
+
+			// escaped newline is line continuation
+			if runes[i] != '\n' {
+				sb.WriteRune(runes[i])
+				inArg = true
+			}
+		case '\'':
+			end := indexRune(runes, '\'', i+1)
+			if end < 0 {
+				return nil, ErrUnclosedQuote
+			}
+
+			sb.WriteString(string(runes[i+1 : end]))
+			i, inArg = end, true
+		case '"':
+			i++
+			for ; i < len(runes) && runes[i] != '"'; i++ {
+				if runes[i] == '\\' && i+1 < len(runes) && strings.ContainsRune("$`\"\\\n", runes[i+1]) {
+					i++
+					if runes[i] == '\n' {
+						continue
+					}
+				}
+				sb.WriteRune(runes[i])
+			}
+
+			if i >= len(runes) {
+				return nil, ErrUnclosedQuote
+			}
+			inArg = true
+		default:
+			sb.WriteRune(r)
+			inArg = true
+		}
+	}
+
+	if inArg {
+		args = append(args, sb.String())
+	}
+	return args, nil
+}
+
+func indexRune(runes []rune, r rune, start int) int {
+	for i := start; i < len(runes); i++ {
+		if runes[i] == r {
+			return i
+		}
+	}
+	return -1
+}
+
+// SplitWindows split the command line string by the rules of the windows CommandLineToArgvW:
+//
+// - the args split by whitespaces, out of double quotes
+// - 2n backslashes followed by a quote produce n backslashes and a start/end quote
+// - 2n+1 backslashes followed by a quote produce n backslashes and a literal quote
+// - backslashes not followed by a quote are literal
+// - in double quotes, the "" produce a literal quote
+//
+// NOTICE: the unclosed quote is allowed on windows, will returns error for find the mistake early.
+func SplitWindows(line string) ([]string, error) {
+	var args []string
+	var sb strings.Builder
+
+	inArg, inQuote := false, false
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+		switch {
+		case c == '\\':
+			n := 0
+			for ; i < len(line) && line[i] == '\\'; i++ {
+				n++
+			}
+
+			if i < len(line) && line[i] == '"' {
+				sb.WriteString(strings.Repeat(`\`, n/2))
+				if n%2 == 1 {
+					sb.WriteByte('"')
+				} else {
+					inQuote = !inQuote
+				}
+			} else {
+				sb.WriteString(strings.Repeat(`\`, n))
+				i-- // the current char is not processed
+			}
+			inArg = true
+		case c == '"':
+			if inQuote && i+1 < len(line) && line[i+1] == '"' {
+				sb.WriteByte('"')
+				i++
+			} else {
+				inQuote = !inQuote
+			}
+			inArg = true
+		case (c == ' ' || c == '\t' || c == '\n' || c == '\r') && !inQuote:
+			if inArg {
+				args = append(args, sb.String())
+				sb.Reset()
+				inArg = false
+			}
+		default:
+			sb.WriteByte(c)
+			inArg = true
+		}
+	}
+
+	if inQuote {
+		return nil, ErrUnclosedQuote
+	}
+
+	if inArg {
+		args = append(args, sb.String())
+	}
+	return args, nil
+}
+
+// QuotePosix quote the arg for POSIX shell, will use single quotes on the arg has special chars.
+//
+// Usage:
+// 	cmdline.QuotePosix("abc")        // abc
+// 	cmdline.QuotePosix("it's a dog") // 'it'\''s a dog'
+func QuotePosix(arg string) string {
+	if arg == "" {
+		return "''"
+	}
+
+	if isSafeArg(arg) {
+		return arg
+	}
+	return "'" + strings.Replace(arg, "'", `'\''`, -1) + "'"
+}
+
+func isSafeArg(arg string) bool {
+	for _, c := range arg {
+		if c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' {
+			continue
+		}
+
+		if !strings.ContainsRune("@%+=:,./-_", c) {
+			return false
+		}
+	}
+	return true
+}
+
+// QuoteWindows quote the arg for the windows CommandLineToArgvW. refer the syscall.EscapeArg()
+//
+// Usage:
+// 	cmdline.QuoteWindows(`C:\Program Files\app`) // "C:\Program Files\app"
+// 	cmdline.QuoteWindows(`say "hi"`)             // "say \"hi\""
+func QuoteWindows(arg string) string {
+	if arg == "" {
+		return `""`
+	}
+
+	if !strings.ContainsAny(arg, " \t\n\"") {
+		return arg
+	}
+
+	var sb strings.Builder
+	sb.WriteByte('"')
+
+	slashes := 0
+	for i := 0; i < len(arg); i++ {
+		c := arg[i]
+		switch c {
+		case '\\':
+			slashes++
+		case '"':
+			// the backslashes before quote must be escaped, and escape the quote
+			sb.WriteString(strings.Repeat(`\`, slashes+1))
+			slashes = 0
+		default:
+			slashes = 0
+		}
+		sb.WriteByte(c)
+	}
+
+	// the backslashes before the end quote must be escaped
+	sb.WriteString(strings.Repeat(`\`, slashes))
+	sb.WriteByte('"')
+	return sb.String()
+}
@@ -0,0 +1,93 @@
+package cmdline_test
+
+import (
+	"testing"
+
+	"github.com/gookit/goutil/cliutil/cmdline"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitPosix(t *testing.T) {
+	tests := []struct {
+		line string
+		args []string
+	}{
+		{"", nil},
+		{"  git   status ", []string{"git", "status"}},
+		{`git commit -m "the message"`, []string{"git", "commit", "-m", "the message"}},
+		{`echo 'it''s' "a \"b\" \$c \d"`, []string{"echo", "its", `a "b" $c \d`}},
+		{`echo it\'s a\ b "" ''`, []string{"echo", "it's", "a b", "", ""}},
+		{"echo a\\\nb \"c\\\nd\"", []string{"echo", "ab", "cd"}},
+		{`--name="inhere tom"`, []string{"--name=inhere tom"}},
+		{`中文 "参数 值"`, []string{"中文", "参数 值"}},
+	}
+
+	for _, tt := range tests {
+		args, err := cmdline.SplitPosix(tt.line)
+		assert.NoError(t, err, tt.line)
+		assert.Equal(t, tt.args, args, tt.line)
+	}
+
+	for _, line := range []string{`echo "abc`, `echo 'abc`, `echo abc\`} {
+		_, err := cmdline.SplitPosix(line)
+		assert.Error(t, err, line)
+	}
+}
+
+func TestSplitWindows(t *testing.T) {
+	tests := []struct {
+		line string
+		args []string
+	}{
+		{"", nil},
+		{`app.exe "C:\Program Files\app" a\b`, []string{"app.exe", `C:\Program Files\app`, `a\b`}},
+		{`a\\\"b "c\\" d`, []string{`a\"b`, `c\`, "d"}},
+		{`"say ""hi""" ""`, []string{`say "hi"`, ""}},
+		{`a"b c"d 'e f'`, []string{"ab cd", "'e", "f'"}},
+	}
+
+	for _, tt := range tests {
+		args, err := cmdline.SplitWindows(tt.line)
+		assert.NoError(t, err, tt.line)
+		assert.Equal(t, tt.args, args, tt.line)
+	}
+
+	_, err := cmdline.SplitWindows(`echo "abc`)
+	assert.Error(t, err)
+}
+
+func TestQuotePosix(t *testing.T) {
+	assert.Equal(t, "''", cmdline.QuotePosix(""))
+	assert.Equal(t, "abc", cmdline.QuotePosix("abc"))
+	assert.Equal(t, "--name=a/b.txt", cmdline.QuotePosix("--name=a/b.txt"))
+	assert.Equal(t, "'a b'", cmdline.QuotePosix("a b"))
+	assert.Equal(t, `'it'\''s "a" $dog'`, cmdline.QuotePosix(`it's "a" $dog`))
+
+	for _, arg := range []string{"", "a b", `it's "a" $dog`, "a\\b\nc", "中文 参数"} {
+		args, err := cmdline.SplitPosix("app " + cmdline.QuotePosix(arg))
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"app", arg}, args)
+	}
+}
+
+func TestQuoteWindows(t *testing.T) {
+	assert.Equal(t, `""`, cmdline.QuoteWindows(""))
+	assert.Equal(t, `a\b`, cmdline.QuoteWindows(`a\b`))
+	assert.Equal(t, `"C:\Program Files\app"`, cmdline.QuoteWindows(`C:\Program Files\app`))
+	assert.Equal(t, `"say \"hi\""`, cmdline.QuoteWindows(`say "hi"`))
+
+	for _, arg := range []string{"", "a b", `say "hi"`, `c:\a b\`, `a\\"b`, "tab\there"} {
+		args, err := cmdline.SplitWindows("app " + cmdline.QuoteWindows(arg))
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"app", arg}, args)
+	}
+}
+
+func TestJoinArgs(t *testing.T) {
+	args := []string{"git", "commit", "-m", `it's "ok"`}
+	line := cmdline.JoinArgs(args)
+
+	got, err := cmdline.Split(line)
+	assert.NoError(t, err)
+	assert.Equal(t, args, got)
+}
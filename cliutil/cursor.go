@@ -0,0 +1,133 @@
+package cliutil
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+)
+
+// ctrlEnabled check the Output is a terminal, the control sequences only write to terminal.
+func ctrlEnabled() bool {
+	return IsTerminalWriter(Output)
+}
+
+func writeCtrl(seq string) {
+	if ctrlEnabled() {
+		_, _ = io.WriteString(Output, seq)
+	}
+}
+
+// HideCursor hide the cursor of the terminal
+func HideCursor() {
+	writeCtrl("\x1b[?25l")
+}
+
+// ShowCursor show the cursor of the terminal
+func ShowCursor() {
+	writeCtrl("\x1b[?25h")
+}
+
+// CursorUp move the cursor up n lines
+func CursorUp(n int) {
+	if n > 0 {
+		writeCtrl(fmt.Sprintf("\x1b[%dA", n))
+	}
+}
+
+// CursorDown move the cursor down n lines
+func CursorDown(n int) {
+	if n > 0 {
+		writeCtrl(fmt.Sprintf("\x1b[%dB", n))
+	}
+}
+
+// ClearLine clear the current line and move the cursor to line start
+func ClearLine() {
+	writeCtrl("\r\x1b[2K")
+}
+
+// ClearScreen clear the screen and move the cursor to top left
+func ClearScreen() {
+	writeCtrl("\x1b[2J\x1b[H")
+}
+
+// LineUpdater update the text in place, for show the live status. the text can be multi lines.
+// on the Writer is not a terminal, only the last text will be printed on Done().
+//
+// Usage:
+// 	lu := cliutil.NewLineUpdater()
+// 	for i := 1; i <= 100; i++ {
+// 		lu.Update("processing %d/100", i)
+// 	}
+// 	lu.Done()
+type LineUpdater struct {
+	// Writer default is the Output
+	Writer io.Writer
+	// Disabled not update in place. default is true on the Writer is not a terminal,
+	// it is checked by the Writer after apply the option funcs.
+	Disabled bool
+
+	mu   sync.Mutex
+	text string
+	// the lines of the last printed text
+	lines int
+}
+
+// NewLineUpdater create a LineUpdater
+func NewLineUpdater(fns ...func(lu *LineUpdater)) *LineUpdater {
+	lu := &LineUpdater{Writer: Output}
+	lu.Disabled = !IsTerminalWriter(lu.Writer)
+
+	for _, fn := range fns {
+		fn(lu)
+	}
+
+	lu.Disabled = checkDisabled(Output, lu.Writer, lu.Disabled)
+	return lu
+}
+
+// Update the text in place
+func (lu *LineUpdater) Update(format string, args ...interface{}) {
+	text := format
+	if len(args) > 0 {
+		text = fmt.Sprintf(format, args...)
+	}
+	text = strings.TrimRight(text, "\n")
+
+	lu.mu.Lock()
+	defer lu.mu.Unlock()
+
+	lu.text = text
+	if lu.Disabled {
+		return
+	}
+
+	var sb strings.Builder
+	sb.WriteByte('\r')
+	if lu.lines > 1 {
+		sb.WriteString(fmt.Sprintf("\x1b[%dA", lu.lines-1))
+	}
+
+	// clear to the end of screen
+	sb.WriteString("\x1b[J")
+	sb.WriteString(text)
+	_, _ = io.WriteString(lu.Writer, sb.String())
+	lu.lines = strings.Count(text, "\n") + 1
+}
+
+// Done end the updating, will write a newline. the next Update will print at new line.
+func (lu *LineUpdater) Done() {
+	lu.mu.Lock()
+	defer lu.mu.Unlock()
+
+	if lu.Disabled {
+		if lu.text != "" {
+			_, _ = io.WriteString(lu.Writer, lu.text+"\n")
+		}
+	} else if lu.lines > 0 {
+		_, _ = io.WriteString(lu.Writer, "\n")
+	}
+
+	lu.text, lu.lines = "", 0
+}
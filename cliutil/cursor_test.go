@@ -0,0 +1,45 @@
+package cliutil_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/gookit/goutil/cliutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCursorCtrl(t *testing.T) {
+	mockIO("", func(out *bytes.Buffer) {
+		cliutil.HideCursor()
+		cliutil.ShowCursor()
+		cliutil.CursorUp(2)
+		cliutil.CursorDown(2)
+		cliutil.ClearLine()
+		cliutil.ClearScreen()
+
+		// not write to the non-terminal
+		assert.Equal(t, "", out.String())
+	})
+}
+
+func TestLineUpdater(t *testing.T) {
+	buf := &bytes.Buffer{}
+	lu := cliutil.NewLineUpdater(func(lu *cliutil.LineUpdater) {
+		lu.Writer = buf
+	})
+	assert.True(t, lu.Disabled)
+
+	lu.Update("processing %d/%d", 1, 2)
+	lu.Update("processing %d/%d", 2, 2)
+	assert.Equal(t, "", buf.String())
+	lu.Done()
+	assert.Equal(t, "processing 2/2\n", buf.String())
+
+	buf.Reset()
+	lu.Disabled = false
+	lu.Update("line1\nline2\n")
+	lu.Update("done")
+	lu.Done()
+	lu.Done()
+	assert.Equal(t, "\r\x1b[Jline1\nline2\r\x1b[1A\x1b[Jdone\n", buf.String())
+}
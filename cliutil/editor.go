@@ -0,0 +1,85 @@
+package cliutil
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"runtime"
+
+	"github.com/gookit/goutil/cliutil/cmdline"
+)
+
+// fallback editors on the ENV VISUAL, EDITOR is not set.
+var fallbackEditors = []string{"vim", "vi", "nano"}
+
+// DefaultEditor get the editor command of the user.
+// find order: ENV VISUAL, ENV EDITOR, "notepad" on windows, vim, vi, nano
+func DefaultEditor() string {
+	for _, name := range []string{"VISUAL", "EDITOR"} {
+		if editor := os.Getenv(name); editor != "" {
+			return editor
+		}
+	}
+
+	if runtime.GOOS == "windows" {
+		return "notepad"
+	}
+
+	for _, editor := range fallbackEditors {
+		if _, err := exec.LookPath(editor); err == nil {
+			return editor
+		}
+	}
+	return ""
+}
+
+// EditText open the editor of the user to edit the text, returns the edited contents.
+// the editor command can contain args, eg: EDITOR="code --wait"
+//
+// Usage:
+// 	msg, err := cliutil.EditText("# input the commit message\n")
+func EditText(initial string) (string, error) {
+	editor := DefaultEditor()
+	if editor == "" {
+		return "", errors.New("cliutil: not found an editor, please set the ENV EDITOR")
+	}
+
+	args, err := cmdline.Split(editor)
+	if err != nil {
+		return "", err
+	}
+	if len(args) == 0 {
+		return "", errors.New("cliutil: the editor command is empty, please check the ENV VISUAL, EDITOR")
+	}
+
+	f, err := ioutil.TempFile("", "cliutil-edit-*.txt")
+	if err != nil {
+		return "", err
+	}
+
+	fpath := f.Name()
+	defer os.Remove(fpath)
+
+	_, err = f.WriteString(initial)
+	if cErr := f.Close(); err == nil {
+		err = cErr
+	}
+	if err != nil {
+		return "", err
+	}
+
+	cmd := exec.Command(args[0], append(args[1:], fpath)...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return "", err
+	}
+
+	bs, err := ioutil.ReadFile(fpath)
+	if err != nil {
+		return "", err
+	}
+	return string(bs), nil
+}
@@ -0,0 +1,43 @@
+package cliutil_test
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/gookit/goutil/cliutil"
+	"github.com/gookit/goutil/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEditText(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("skip on windows")
+	}
+
+	testutil.MockEnvValues(map[string]string{
+		"VISUAL": "",
+		"EDITOR": `sh -c 'echo edited >> "$0"'`,
+	}, func() {
+		assert.Equal(t, `sh -c 'echo edited >> "$0"'`, cliutil.DefaultEditor())
+
+		text, err := cliutil.EditText("initial\n")
+		assert.NoError(t, err)
+		assert.Equal(t, "initial\nedited\n", text)
+	})
+
+	testutil.MockEnvValues(map[string]string{"VISUAL": "", "EDITOR": "false"}, func() {
+		_, err := cliutil.EditText("initial\n")
+		assert.Error(t, err)
+	})
+
+	testutil.MockEnvValues(map[string]string{"VISUAL": "", "EDITOR": `vim "abc`}, func() {
+		_, err := cliutil.EditText("initial\n")
+		assert.Error(t, err)
+	})
+
+	// only whitespace
+	testutil.MockEnvValues(map[string]string{"VISUAL": " "}, func() {
+		_, err := cliutil.EditText("initial\n")
+		assert.ErrorContains(t, err, "editor command is empty")
+	})
+}
@@ -0,0 +1,261 @@
+package cliutil
+
+import (
+	"encoding"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gookit/goutil/internal/comfunc"
+)
+
+// BindFlags bind the struct fields to a new flag.FlagSet, then parse the args.
+// returns the remaining args after parsed.
+//
+// the field tag format: `flag:"name,shorthand,desc"`, mark required by the tag `required:"true"`.
+// the name is the lower field name on it is empty. eg: `flag:""`
+// the initial value of the field is the default value.
+//
+// supported field types:
+// 	string, bool, int, int64, uint, uint64, float64, time.Duration, []string, []int,
+// 	and the types implemented flag.Value or encoding.TextUnmarshaler
+//
+// Usage:
+// 	type Options struct {
+// 		Name    string        `flag:"name,n,the user name" required:"true"`
+// 		Tags    []string      `flag:"tag,t,the tags, can be repeated"`
+// 		Timeout time.Duration `flag:"timeout,,the request timeout"`
+// 	}
+//
+// 	opts := &Options{Timeout: 3 * time.Second}
+// 	args, err := cliutil.BindFlags(opts, os.Args[1:])
+func BindFlags(ptr interface{}, args []string) ([]string, error) {
+	fs := flag.NewFlagSet(os.Args[0], flag.ContinueOnError)
+	fs.SetOutput(Output)
+
+	required, err := BindFlagSet(fs, ptr)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+
+	setNames := make(map[string]bool)
+	fs.Visit(func(f *flag.Flag) {
+		setNames[f.Name] = true
+	})
+
+	for _, names := range required {
+		if !setNames[names[0]] && !setNames[names[1]] {
+			return nil, fmt.Errorf("cliutil: the flag -%s is required", names[0])
+		}
+	}
+	return fs.Args(), nil
+}
+
+// BindFlagSet define the flags on the FlagSet by the struct fields. see BindFlags()
+//
+// returns the names of required flags, each item is [name, shorthand].
+func BindFlagSet(fs *flag.FlagSet, ptr interface{}) (required [][2]string, err error) {
+	rv := reflect.ValueOf(ptr)
+	if rv.Kind() != reflect.Ptr || rv.Elem().Kind() != reflect.Struct {
+		return nil, errors.New("cliutil: BindFlags the ptr must be a pointer to struct")
+	}
+
+	sv := rv.Elem()
+	st := sv.Type()
+	for i := 0; i < st.NumField(); i++ {
+		sf := st.Field(i)
+		tv, ok := comfunc.LookupTag(sf, "flag")
+		if !ok || tv.Raw == "-" || sf.PkgPath != "" {
+			continue
+		}
+
+		// the desc can contain comma. eg: "name,n,the description"
+		nodes := tv.Parts(3)
+		name, short, desc := nodes[0], nodes[1], nodes[2]
+		if name == "" {
+			name = strings.ToLower(sf.Name)
+		}
+
+		val, err := flagValue(sv.Field(i))
+		if err != nil {
+			return nil, fmt.Errorf("cliutil: the field %s: %w", sf.Name, err)
+		}
+
+		fs.Var(val, name, desc)
+		if short != "" {
+			fs.Var(val, short, "alias of the -"+name)
+		}
+
+		req, _ := comfunc.LookupTag(sf, "required")
+		if bl, _ := strconv.ParseBool(req.Name); bl {
+			required = append(required, [2]string{name, short})
+		}
+	}
+	return required, nil
+}
+
+var (
+	durationType = reflect.TypeOf(time.Duration(0))
+	flagValType  = reflect.TypeOf((*flag.Value)(nil)).Elem()
+	textUmType   = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// flagValue create the flag.Value for the field
+func flagValue(fv reflect.Value) (flag.Value, error) {
+	ptr := fv.Addr()
+	if ptr.Type().Implements(flagValType) {
+		return ptr.Interface().(flag.Value), nil
+	}
+
+	if fv.Type() == durationType {
+		return &fieldValue{fv: fv}, nil
+	}
+
+	if ptr.Type().Implements(textUmType) {
+		return &textValue{fv: fv}, nil
+	}
+
+	switch fv.Kind() {
+	case reflect.String, reflect.Bool, reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint64, reflect.Float64:
+		return &fieldValue{fv: fv}, nil
+	case reflect.Slice:
+		switch fv.Type().Elem().Kind() {
+		case reflect.String, reflect.Int:
+			return &sliceValue{fv: fv}, nil
+		}
+	}
+	return nil, fmt.Errorf("unsupported flag type %s", fv.Type())
+}
+
+// fieldValue the basic type field value
+type fieldValue struct {
+	fv reflect.Value
+}
+
+func (v *fieldValue) String() string {
+	if !v.fv.IsValid() {
+		return ""
+	}
+	return fmt.Sprint(v.fv.Interface())
+}
+
+// IsBoolFlag allow the bool flag without value. eg: "-debug"
+func (v *fieldValue) IsBoolFlag() bool {
+	return v.fv.IsValid() && v.fv.Kind() == reflect.Bool
+}
+
+func (v *fieldValue) Set(s string) error {
+	if v.fv.Type() == durationType {
+		dur, err := time.ParseDuration(s)
+		if err != nil {
+			return err
+		}
+		v.fv.SetInt(int64(dur))
+		return nil
+	}
+
+	switch v.fv.Kind() {
+	case reflect.String:
+		v.fv.SetString(s)
+	case reflect.Bool:
+		bl, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		v.fv.SetBool(bl)
+	case reflect.Int, reflect.Int64:
+		i64, err := strconv.ParseInt(s, 0, v.fv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.fv.SetInt(i64)
+	case reflect.Uint, reflect.Uint64:
+		u64, err := strconv.ParseUint(s, 0, v.fv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.fv.SetUint(u64)
+	case reflect.Float64:
+		f64, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return err
+		}
+		v.fv.SetFloat(f64)
+	}
+	return nil
+}
+
+// textValue the field implemented encoding.TextUnmarshaler
+type textValue struct {
+	fv reflect.Value
+}
+
+func (v *textValue) String() string {
+	if !v.fv.IsValid() {
+		return ""
+	}
+
+	if tm, ok := v.fv.Interface().(encoding.TextMarshaler); ok {
+		bs, _ := tm.MarshalText()
+		return string(bs)
+	}
+	return fmt.Sprint(v.fv.Interface())
+}
+
+func (v *textValue) Set(s string) error {
+	return v.fv.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(s))
+}
+
+// sliceValue for the []string, []int field. can be repeated and split by comma.
+// eg: "-tag a -tag b,c" => []string{"a", "b", "c"}
+type sliceValue struct {
+	fv reflect.Value
+	// the default value will be replaced on first set
+	changed bool
+}
+
+func (v *sliceValue) String() string {
+	if !v.fv.IsValid() || v.fv.Len() == 0 {
+		return ""
+	}
+
+	ss := make([]string, v.fv.Len())
+	for i := range ss {
+		ss[i] = fmt.Sprint(v.fv.Index(i).Interface())
+	}
+	return strings.Join(ss, ",")
+}
+
+func (v *sliceValue) Set(s string) error {
+	if !v.changed {
+		v.changed = true
+		v.fv.Set(reflect.MakeSlice(v.fv.Type(), 0, 1))
+	}
+
+	for _, item := range strings.Split(s, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		elem := reflect.ValueOf(item)
+		if v.fv.Type().Elem().Kind() == reflect.Int {
+			num, err := strconv.Atoi(item)
+			if err != nil {
+				return err
+			}
+			elem = reflect.ValueOf(num)
+		}
+		v.fv.Set(reflect.Append(v.fv, elem.Convert(v.fv.Type().Elem())))
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package cliutil_test
+
+import (
+	"bytes"
+	"flag"
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/gookit/goutil/cliutil"
+	"github.com/stretchr/testify/assert"
+)
+
+type flagOpts struct {
+	Name    string        `flag:"name,n,the user name" required:"true"`
+	Debug   bool          `flag:"debug,d,enable debug mode"`
+	Age     int           `flag:"age,,the user age"`
+	Size    uint64        `flag:"size"`
+	Rate    float64       `flag:"rate"`
+	Tags    []string      `flag:"tag,t,the tags, can be repeated"`
+	Ids     []int         `flag:"id"`
+	Timeout time.Duration `flag:"timeout,,the request timeout"`
+	IP      net.IP        `flag:"ip"`
+	Ignored string
+	Count   int64 `flag:""`
+}
+
+func TestBindFlags(t *testing.T) {
+	opts := &flagOpts{Timeout: 3 * time.Second, Tags: []string{"def"}}
+	args, err := cliutil.BindFlags(opts, []string{
+		"-n", "inhere", "-d", "--age=20", "-size", "1024", "-rate", "0.5",
+		"-t", "a", "--tag", "b,c", "-id", "1,2", "-timeout", "1m",
+		"-ip", "127.0.0.1", "arg0", "arg1",
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"arg0", "arg1"}, args)
+	assert.Equal(t, "inhere", opts.Name)
+	assert.True(t, opts.Debug)
+	assert.Equal(t, 20, opts.Age)
+	assert.Equal(t, uint64(1024), opts.Size)
+	assert.Equal(t, 0.5, opts.Rate)
+	assert.Equal(t, []string{"a", "b", "c"}, opts.Tags)
+	assert.Equal(t, []int{1, 2}, opts.Ids)
+	assert.Equal(t, time.Minute, opts.Timeout)
+	assert.Equal(t, "127.0.0.1", opts.IP.String())
+
+	// default values
+	opts = &flagOpts{Timeout: 3 * time.Second, Tags: []string{"def"}}
+	_, err = cliutil.BindFlags(opts, []string{"-name", "tom", "-count", "3"})
+	assert.NoError(t, err)
+	assert.Equal(t, 3*time.Second, opts.Timeout)
+	assert.Equal(t, []string{"def"}, opts.Tags)
+	assert.Equal(t, int64(3), opts.Count)
+}
+
+func TestBindFlags_error(t *testing.T) {
+	buf := &bytes.Buffer{}
+	cliutil.Output = buf
+	defer func() {
+		cliutil.Output = os.Stdout
+	}()
+
+	_, err := cliutil.BindFlags(&flagOpts{}, []string{"-debug"})
+	assert.Equal(t, "cliutil: the flag -name is required", err.Error())
+
+	_, err = cliutil.BindFlags(&flagOpts{}, []string{"-age", "abc"})
+	assert.Error(t, err)
+	_, err = cliutil.BindFlags(&flagOpts{}, []string{"-id", "abc"})
+	assert.Error(t, err)
+	_, err = cliutil.BindFlags(&flagOpts{}, []string{"-timeout", "abc"})
+	assert.Error(t, err)
+
+	_, err = cliutil.BindFlags(&flagOpts{}, []string{"-h"})
+	assert.Equal(t, flag.ErrHelp, err)
+	assert.Contains(t, buf.String(), "the user name")
+	assert.Contains(t, buf.String(), "alias of the -name")
+
+	_, err = cliutil.BindFlags(flagOpts{}, nil)
+	assert.Error(t, err)
+
+	_, err = cliutil.BindFlags(&struct {
+		Ch chan int `flag:"ch"`
+	}{}, nil)
+	assert.Error(t, err)
+}
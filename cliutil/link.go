@@ -0,0 +1,64 @@
+package cliutil
+
+import (
+	"net/url"
+	"path/filepath"
+	"strings"
+
+	"github.com/gookit/goutil/envutil"
+)
+
+// LinkEnabled check can output OSC 8 hyperlink to the Output.
+// it can be override for custom the check logic.
+var LinkEnabled = func() bool {
+	return IsTerminalWriter(Output) && envutil.IsSupportHyperlink()
+}
+
+// Link make a clickable hyperlink text by the OSC 8 escape sequence.
+// will returns plain text like "text (url)" on the terminal not supported.
+//
+// Usage:
+// 	fmt.Println("see", cliutil.Link("the docs", "https://github.com/gookit/goutil"))
+func Link(text, link string) string {
+	if text == "" {
+		text = link
+	}
+
+	if LinkEnabled() {
+		return "\x1b]8;;" + link + "\x1b\\" + text + "\x1b]8;;\x1b\\"
+	}
+
+	if text == link {
+		return link
+	}
+	return text + " (" + link + ")"
+}
+
+// FileLink make a clickable hyperlink for the file path, the text is the path.
+//
+// on the terminal not supported, will returns the path.
+func FileLink(path string) string {
+	if !LinkEnabled() {
+		return path
+	}
+	return Link(path, FileURL(path))
+}
+
+// FileURL convert the file path to a "file://" URL.
+//
+// Usage:
+// 	cliutil.FileURL("/tmp/my file.txt") // "file:///tmp/my%20file.txt"
+func FileURL(path string) string {
+	if abs, err := filepath.Abs(path); err == nil {
+		path = abs
+	}
+
+	path = filepath.ToSlash(path)
+	// on Windows, e.g "C:/path/to" => "/C:/path/to"
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+
+	u := url.URL{Scheme: "file", Path: path}
+	return u.String()
+}
@@ -0,0 +1,28 @@
+package cliutil_test
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/gookit/goutil/cliutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLink(t *testing.T) {
+	assert.Equal(t, "docs (https://example.com)", cliutil.Link("docs", "https://example.com"))
+	assert.Equal(t, "https://example.com", cliutil.Link("", "https://example.com"))
+	assert.Equal(t, "/tmp/a.txt", cliutil.FileLink("/tmp/a.txt"))
+
+	backup := cliutil.LinkEnabled
+	defer func() { cliutil.LinkEnabled = backup }()
+
+	cliutil.LinkEnabled = func() bool { return true }
+	assert.Equal(t, "\x1b]8;;https://example.com\x1b\\docs\x1b]8;;\x1b\\", cliutil.Link("docs", "https://example.com"))
+	assert.Equal(t, "docs", cliutil.StripANSI(cliutil.Link("docs", "https://example.com")))
+
+	if runtime.GOOS != "windows" {
+		s := cliutil.FileLink("/tmp/my file.txt")
+		assert.Contains(t, s, "file:///tmp/my%20file.txt")
+		assert.Equal(t, "/tmp/my file.txt", cliutil.StripANSI(s))
+	}
+}
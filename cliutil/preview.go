@@ -0,0 +1,239 @@
+package cliutil
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+
+	"github.com/gookit/goutil/strutil"
+)
+
+// the ANSI color codes for the diff and code block
+const (
+	colorDiffDel = "31"
+	colorDiffAdd = "32"
+	colorKeyword = "35"
+	colorString  = "32"
+	colorNumber  = "33"
+	colorComment = "90"
+)
+
+// PrintDiff print the line differences of two texts to the Output.
+// the deleted lines start with "-" and in red, the added lines start with "+" and in green.
+//
+// Usage:
+// 	cliutil.PrintDiff(oldContent, newContent)
+// 	if cliutil.ReadConfirm("apply the changes?") {
+// 		// ...
+// 	}
+func PrintDiff(oldText, newText string) {
+	FprintDiff(Output, oldText, newText)
+}
+
+// FprintDiff print the line differences of two texts to the writer. see PrintDiff()
+func FprintDiff(w io.Writer, oldText, newText string) {
+	enable := colorEnabled(w)
+
+	var sb strings.Builder
+	for _, dl := range strutil.DiffLines(oldText, newText) {
+		switch dl.Type {
+		case strutil.DiffDelete:
+			sb.WriteString(colorize(dl.String(), colorDiffDel, enable))
+		case strutil.DiffInsert:
+			sb.WriteString(colorize(dl.String(), colorDiffAdd, enable))
+		default:
+			sb.WriteString(dl.String())
+		}
+		sb.WriteByte('\n')
+	}
+
+	_, _ = io.WriteString(w, sb.String())
+}
+
+// langSyntax the simple syntax definition for highlight
+type langSyntax struct {
+	// line comment start. eg: "//"
+	comment  string
+	quotes   string
+	keywords map[string]bool
+	// keywords is case-insensitive. eg: SQL
+	ignoreCase bool
+}
+
+func makeWordSet(words string) map[string]bool {
+	set := make(map[string]bool)
+	for _, word := range strings.Fields(words) {
+		set[word] = true
+	}
+	return set
+}
+
+var (
+	jsSyntax = &langSyntax{
+		comment: "//",
+		quotes:  "\"'`",
+		keywords: makeWordSet(`async await break case catch class const continue default delete do else export
+extends false finally for from function if import in instanceof let new null return switch this throw true
+try typeof undefined var void while yield interface type enum implements`),
+	}
+	pySyntax = &langSyntax{
+		comment: "#",
+		quotes:  "\"'",
+		keywords: makeWordSet(`and as assert async await break class continue def del elif else except False
+finally for from global if import in is lambda None nonlocal not or pass raise return True try while with yield`),
+	}
+	shSyntax = &langSyntax{
+		comment: "#",
+		quotes:  "\"'",
+		keywords: makeWordSet(`if then else elif fi for while until do done case esac in function return
+local export exit echo set unset`),
+	}
+)
+
+// langSyntaxes the supported languages of the PrintCodeBlock
+var langSyntaxes = map[string]*langSyntax{
+	"go": {
+		comment: "//",
+		quotes:  "\"'`",
+		keywords: makeWordSet(`break case chan const continue default defer else fallthrough for func go goto
+if import interface map package range return select struct switch type var nil true false iota`),
+	},
+	"js":         jsSyntax,
+	"javascript": jsSyntax,
+	"ts":         jsSyntax,
+	"typescript": jsSyntax,
+	"py":         pySyntax,
+	"python":     pySyntax,
+	"sh":         shSyntax,
+	"bash":       shSyntax,
+	"shell":      shSyntax,
+	"json": {
+		quotes:   "\"",
+		keywords: makeWordSet("true false null"),
+	},
+	"sql": {
+		comment:    "--",
+		quotes:     "'\"",
+		ignoreCase: true,
+		keywords: makeWordSet(`SELECT FROM WHERE INSERT INTO VALUES UPDATE SET DELETE CREATE TABLE DROP ALTER
+INDEX AND OR NOT NULL IS IN AS JOIN LEFT RIGHT INNER OUTER ON GROUP BY ORDER HAVING LIMIT OFFSET DISTINCT`),
+	},
+}
+
+// PrintCodeBlock print the code with line numbers to the Output.
+// will highlight the keywords, strings, numbers and comments on the lang is supported.
+//
+// Supported lang:
+// 	go, js, ts, python, shell, json, sql
+//
+// Usage:
+// 	cliutil.PrintCodeBlock("go", `fmt.Println("hello")`)
+//
+// Output like:
+// 	1 | fmt.Println("hello")
+func PrintCodeBlock(lang, code string) {
+	FprintCodeBlock(Output, lang, code)
+}
+
+// FprintCodeBlock print the code with line numbers to the writer. see PrintCodeBlock()
+func FprintCodeBlock(w io.Writer, lang, code string) {
+	enable := colorEnabled(w)
+	syntax := langSyntaxes[strings.ToLower(lang)]
+
+	code = strings.Replace(code, "\r\n", "\n", -1)
+	lines := strings.Split(strings.TrimRight(code, "\n"), "\n")
+	numWidth := len(fmt.Sprint(len(lines)))
+
+	var sb strings.Builder
+	for i, line := range lines {
+		sb.WriteString(colorize(fmt.Sprintf("%*d |", numWidth, i+1), colorComment, enable))
+		if line != "" {
+			sb.WriteByte(' ')
+			if enable && syntax != nil {
+				line = syntax.highlight(line)
+			}
+			sb.WriteString(line)
+		}
+		sb.WriteByte('\n')
+	}
+
+	_, _ = io.WriteString(w, sb.String())
+}
+
+// HighlightCode highlight the code by ANSI color, will returns raw code on the lang is not supported.
+func HighlightCode(lang, code string) string {
+	syntax := langSyntaxes[strings.ToLower(lang)]
+	if syntax == nil {
+		return code
+	}
+
+	lines := strings.Split(code, "\n")
+	for i, line := range lines {
+		lines[i] = syntax.highlight(line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// highlight a line of code
+func (ls *langSyntax) highlight(line string) string {
+	var sb strings.Builder
+	rs := []rune(line)
+
+	for i := 0; i < len(rs); {
+		r := rs[i]
+		switch {
+		case ls.comment != "" && strings.HasPrefix(string(rs[i:]), ls.comment):
+			sb.WriteString(colorize(string(rs[i:]), colorComment, true))
+			return sb.String()
+		case strings.ContainsRune(ls.quotes, r):
+			end := i + 1
+			for end < len(rs) && rs[end] != r {
+				if rs[end] == '\\' {
+					end++
+				}
+				end++
+			}
+
+			if end >= len(rs) {
+				end = len(rs) - 1
+			}
+			sb.WriteString(colorize(string(rs[i:end+1]), colorString, true))
+			i = end + 1
+		case unicode.IsDigit(r):
+			end := i
+			for end < len(rs) && (isWordRune(rs[end]) || rs[end] == '.') {
+				end++
+			}
+			sb.WriteString(colorize(string(rs[i:end]), colorNumber, true))
+			i = end
+		case isWordRune(r):
+			end := i
+			for end < len(rs) && isWordRune(rs[end]) {
+				end++
+			}
+
+			word := string(rs[i:end])
+			if ls.isKeyword(word) {
+				word = colorize(word, colorKeyword, true)
+			}
+			sb.WriteString(word)
+			i = end
+		default:
+			sb.WriteRune(r)
+			i++
+		}
+	}
+	return sb.String()
+}
+
+func (ls *langSyntax) isKeyword(word string) bool {
+	if ls.ignoreCase {
+		word = strings.ToUpper(word)
+	}
+	return ls.keywords[word]
+}
+
+func isWordRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
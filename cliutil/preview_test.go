@@ -0,0 +1,44 @@
+package cliutil_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/gookit/goutil/cliutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrintDiff(t *testing.T) {
+	mockIO("", func(out *bytes.Buffer) {
+		cliutil.PrintDiff("a\nb\nc", "a\nc\nd\n")
+		assert.Equal(t, "  a\n- b\n  c\n+ d\n", out.String())
+	})
+}
+
+func TestPrintCodeBlock(t *testing.T) {
+	mockIO("", func(out *bytes.Buffer) {
+		cliutil.PrintCodeBlock("go", "package main\n\nfunc main() {}\n")
+		assert.Equal(t, "1 | package main\n2 |\n3 | func main() {}\n", out.String())
+	})
+
+	buf := &bytes.Buffer{}
+	code := "1\n2\n3\n4\n5\n6\n7\n8\n9\n10"
+	cliutil.FprintCodeBlock(buf, "unknown", code)
+	assert.Contains(t, buf.String(), " 9 | 9\n10 | 10\n")
+}
+
+func TestHighlightCode(t *testing.T) {
+	s := cliutil.HighlightCode("go", `if n := 10; n > 1 { return "a\"b" } // end`)
+	assert.Equal(t, "\x1b[35mif\x1b[0m n := \x1b[33m10\x1b[0m; n > \x1b[33m1\x1b[0m { \x1b[35mreturn\x1b[0m \x1b[32m\"a\\\"b\"\x1b[0m } \x1b[90m// end\x1b[0m", s)
+	assert.Equal(t, `if n := 10; n > 1 { return "a\"b" } // end`, cliutil.StripANSI(s))
+
+	// case-insensitive keywords, unclosed string
+	s = cliutil.HighlightCode("SQL", "select * from users where name = 'tom")
+	assert.Contains(t, s, "\x1b[35mselect\x1b[0m")
+	assert.Contains(t, s, "\x1b[32m'tom\x1b[0m")
+
+	s = cliutil.HighlightCode("python", "# comment\nname = None")
+	assert.Equal(t, "\x1b[90m# comment\x1b[0m\nname = \x1b[35mNone\x1b[0m", s)
+
+	assert.Equal(t, "if a", cliutil.HighlightCode("not-exists", "if a"))
+}
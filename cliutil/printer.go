@@ -0,0 +1,228 @@
+package cliutil
+
+import (
+	"fmt"
+	"io"
+	"runtime"
+	"strings"
+
+	"github.com/gookit/color"
+	"github.com/gookit/goutil/envutil"
+)
+
+// verbosity levels for the Printer
+const (
+	// VerbQuiet only print the error messages
+	VerbQuiet = iota
+	// VerbNormal print all messages except the debug. it is default.
+	VerbNormal
+	// VerbDebug print all messages
+	VerbDebug
+)
+
+// message types for the Printer
+const (
+	MsgInfo = iota
+	MsgWarn
+	MsgError
+	MsgSuccess
+	MsgStep
+	MsgDebug
+)
+
+// MsgSymbols the symbols of each message type
+var MsgSymbols = map[int]string{
+	MsgInfo:    "ℹ",
+	MsgWarn:    "⚠",
+	MsgError:   "✖",
+	MsgSuccess: "✔",
+	MsgStep:    "➜",
+	MsgDebug:   "•",
+}
+
+// windows console maybe not support the unicode symbols
+var asciiSymbols = map[int]string{
+	MsgInfo:    "i",
+	MsgWarn:    "!",
+	MsgError:   "x",
+	MsgSuccess: "v",
+	MsgStep:    ">",
+	MsgDebug:   "-",
+}
+
+// the ANSI color codes of each message type
+var msgColors = map[int]string{
+	MsgInfo:    "36",
+	MsgWarn:    "33",
+	MsgError:   "31",
+	MsgSuccess: "32",
+	MsgStep:    "1;35",
+	MsgDebug:   "90",
+}
+
+// Printer a leveled console message printer, each message has a symbol prefix.
+//
+// Usage:
+// 	p := cliutil.NewPrinter()
+// 	p.Step("build the project")
+// 	p.Success("build completed in %s", dur)
+//
+// Output like:
+// 	➜ build the project
+// 	✔ build completed in 3s
+type Printer struct {
+	// Writer for print messages, default is the Output
+	Writer io.Writer
+	// Verbosity level, default is VerbNormal
+	Verbosity int
+	// NoColor disable the color, default is disabled on the envutil.ColorLevel() is none.
+	// the color also is disabled on the Writer is not a terminal.
+	NoColor bool
+	// Prefix add before each message. eg: "[app] "
+	Prefix string
+}
+
+// NewPrinter create a Printer
+func NewPrinter(fns ...func(p *Printer)) *Printer {
+	p := &Printer{
+		Verbosity: VerbNormal,
+		NoColor:   envutil.ColorLevel() == envutil.ColorLevelNone,
+	}
+
+	for _, fn := range fns {
+		fn(p)
+	}
+	return p
+}
+
+// Info print the info message
+func (p *Printer) Info(format string, args ...interface{}) {
+	p.Print(MsgInfo, format, args...)
+}
+
+// Warn print the warning message
+func (p *Printer) Warn(format string, args ...interface{}) {
+	p.Print(MsgWarn, format, args...)
+}
+
+// Error print the error message, it will be printed on quiet.
+func (p *Printer) Error(format string, args ...interface{}) {
+	p.Print(MsgError, format, args...)
+}
+
+// Success print the success message
+func (p *Printer) Success(format string, args ...interface{}) {
+	p.Print(MsgSuccess, format, args...)
+}
+
+// Step print the step message
+func (p *Printer) Step(format string, args ...interface{}) {
+	p.Print(MsgStep, format, args...)
+}
+
+// Debug print the debug message, only print on the Verbosity is VerbDebug.
+func (p *Printer) Debug(format string, args ...interface{}) {
+	p.Print(MsgDebug, format, args...)
+}
+
+// Print the message by the message type. eg: MsgInfo
+func (p *Printer) Print(typ int, format string, args ...interface{}) {
+	if !p.allow(typ) {
+		return
+	}
+
+	msg := format
+	if len(args) > 0 {
+		msg = fmt.Sprintf(format, args...)
+	}
+
+	symbol := MsgSymbols[typ]
+	if runtime.GOOS == "windows" {
+		symbol = asciiSymbols[typ]
+	}
+
+	w := p.Writer
+	if w == nil {
+		w = Output
+	}
+
+	enable := !p.NoColor && colorEnabled(w)
+	symbol = colorize(symbol, msgColors[typ], enable)
+	if typ == MsgError || typ == MsgDebug {
+		msg = colorize(msg, msgColors[typ], enable)
+	}
+	_, _ = io.WriteString(w, p.Prefix+symbol+" "+strings.TrimRight(msg, "\n")+"\n")
+}
+
+// colorEnabled check can render color to the writer: is a terminal and the color is not disabled by ENV.
+// the color can be forced on by the ENV "FORCE_COLOR".
+func colorEnabled(w io.Writer) bool {
+	if envutil.IsForceColor() {
+		return true
+	}
+	return IsTerminalWriter(w) && envutil.ColorLevel() != envutil.ColorLevelNone
+}
+
+// colorize render the text with the color code. eg: "31", "1;35". returns raw text on enable is false.
+func colorize(s, code string, enable bool) string {
+	if !enable || s == "" {
+		return s
+	}
+	return fmt.Sprintf(color.FullColorTpl, code, s)
+}
+
+func (p *Printer) allow(typ int) bool {
+	switch p.Verbosity {
+	case VerbQuiet:
+		return typ == MsgError
+	case VerbDebug:
+		return true
+	}
+	return typ != MsgDebug
+}
+
+// std the default printer
+var std = NewPrinter()
+
+// StdPrinter get the default printer, can change the settings of it.
+func StdPrinter() *Printer {
+	return std
+}
+
+// SetVerbosity set the verbosity level of the default printer. eg: VerbQuiet
+func SetVerbosity(level int) {
+	std.Verbosity = level
+}
+
+// Info print the info message by the default printer.
+//
+// Usage:
+// 	cliutil.Info("found %d files", n)
+func Info(format string, args ...interface{}) {
+	std.Print(MsgInfo, format, args...)
+}
+
+// Warn print the warning message by the default printer.
+func Warn(format string, args ...interface{}) {
+	std.Print(MsgWarn, format, args...)
+}
+
+// Error print the error message by the default printer.
+func Error(format string, args ...interface{}) {
+	std.Print(MsgError, format, args...)
+}
+
+// Success print the success message by the default printer.
+func Success(format string, args ...interface{}) {
+	std.Print(MsgSuccess, format, args...)
+}
+
+// Step print the step message by the default printer.
+func Step(format string, args ...interface{}) {
+	std.Print(MsgStep, format, args...)
+}
+
+// Debug print the debug message by the default printer.
+func Debug(format string, args ...interface{}) {
+	std.Print(MsgDebug, format, args...)
+}
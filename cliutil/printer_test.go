@@ -0,0 +1,80 @@
+package cliutil_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/gookit/goutil/cliutil"
+	"github.com/gookit/goutil/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrinter(t *testing.T) {
+	buf := &bytes.Buffer{}
+	p := cliutil.NewPrinter(func(p *cliutil.Printer) {
+		p.Writer = buf
+		p.NoColor = true
+		p.Prefix = "[app] "
+	})
+
+	p.Info("found %d files", 3)
+	p.Warn("warn message")
+	p.Error("error message\n")
+	p.Success("done")
+	p.Step("build")
+	p.Debug("debug message")
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	assert.Len(t, lines, 5)
+	assert.True(t, strings.HasPrefix(lines[0], "[app] "))
+	assert.True(t, strings.HasSuffix(lines[0], " found 3 files"))
+	assert.True(t, strings.HasSuffix(lines[2], " error message"))
+	assert.NotContains(t, buf.String(), "\x1b[")
+
+	// quiet
+	buf.Reset()
+	p.Verbosity = cliutil.VerbQuiet
+	p.Info("info message")
+	p.Error("error message")
+	assert.Equal(t, 1, strings.Count(buf.String(), "\n"))
+	assert.Contains(t, buf.String(), "error message")
+
+	// debug, not render color on the writer is not a terminal
+	buf.Reset()
+	p.Verbosity = cliutil.VerbDebug
+	p.NoColor = false
+	p.Debug("debug %s", "message")
+	assert.Contains(t, buf.String(), " debug message\n")
+	assert.NotContains(t, buf.String(), "\x1b[")
+
+	// force render color by ENV
+	buf.Reset()
+	testutil.MockOsEnv(map[string]string{"FORCE_COLOR": "1"}, func() {
+		p.Debug("debug %s", "message")
+	})
+	assert.Contains(t, buf.String(), "\x1b[90mdebug message\x1b[0m")
+}
+
+func TestStdPrinter(t *testing.T) {
+	buf := &bytes.Buffer{}
+	std := cliutil.StdPrinter()
+	std.Writer = buf
+	defer func() {
+		std.Writer = nil
+		cliutil.SetVerbosity(cliutil.VerbNormal)
+	}()
+
+	cliutil.Info("info")
+	cliutil.Warn("warn")
+	cliutil.Error("error")
+	cliutil.Success("success")
+	cliutil.Step("step")
+	cliutil.Debug("debug")
+	assert.Equal(t, 5, strings.Count(buf.String(), "\n"))
+
+	buf.Reset()
+	cliutil.SetVerbosity(cliutil.VerbDebug)
+	cliutil.Debug("debug")
+	assert.Contains(t, buf.String(), "debug")
+}
@@ -0,0 +1,307 @@
+package cliutil
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gookit/goutil/mathutil"
+	"github.com/gookit/goutil/sysutil"
+)
+
+// IsTerminalWriter check the writer is a terminal
+func IsTerminalWriter(w io.Writer) bool {
+	if f, ok := w.(*os.File); ok {
+		return sysutil.IsTerminal(f.Fd())
+	}
+	return false
+}
+
+// ProgressBar a simple progress bar. it also is an io.Writer, can be used for report copy progress.
+//
+// Usage:
+// 	bar := cliutil.NewProgressBar(fileSize, func(pb *cliutil.ProgressBar) {
+// 		pb.ShowBytes = true
+// 		pb.ShowETA = true
+// 	})
+// 	_, err := io.Copy(dst, io.TeeReader(resp.Body, bar))
+// 	bar.Finish()
+//
+// Output like:
+// 	[====================>-------------------]  50% 5.00M/10.00M ETA 3s
+type ProgressBar struct {
+	// Total the total count, progress will not show the percent on total <= 0
+	Total int64
+	// Width of the bar, default is 40
+	Width int
+	// ShowBytes show the current and total as data size. eg: 1.20M/5.00M
+	ShowBytes bool
+	// ShowETA show the estimated remaining time
+	ShowETA bool
+	// Writer for render the progress bar, default is the Output
+	Writer io.Writer
+	// Disabled not render the bar. default is true on the Writer is not a terminal,
+	// it is checked by the Writer after apply the option funcs.
+	Disabled bool
+	// RefreshInterval min interval for render, default is 100ms. 0 is render on every change
+	RefreshInterval time.Duration
+
+	mu       sync.Mutex
+	current  int64
+	started  time.Time
+	rendered time.Time
+	finished bool
+}
+
+// NewProgressBar create a progress bar
+func NewProgressBar(total int64, fns ...func(pb *ProgressBar)) *ProgressBar {
+	pb := &ProgressBar{
+		Total:           total,
+		Width:           40,
+		Writer:          Output,
+		RefreshInterval: 100 * time.Millisecond,
+		started:         time.Now(),
+	}
+
+	pb.Disabled = !IsTerminalWriter(pb.Writer)
+	for _, fn := range fns {
+		fn(pb)
+	}
+
+	pb.Disabled = checkDisabled(Output, pb.Writer, pb.Disabled)
+	return pb
+}
+
+// checkDisabled re-check the disabled by the Writer after apply the option funcs.
+// will keep the disabled on the Writer is not changed, or the disabled is set by the option funcs.
+func checkDisabled(defWriter, w io.Writer, disabled bool) bool {
+	if w == defWriter || disabled != !IsTerminalWriter(defWriter) {
+		return disabled
+	}
+	return !IsTerminalWriter(w)
+}
+
+// Write implements the io.Writer, will add the len(p) to progress.
+func (pb *ProgressBar) Write(p []byte) (int, error) {
+	pb.Add(int64(len(p)))
+	return len(p), nil
+}
+
+// Add n to the progress
+func (pb *ProgressBar) Add(n int64) {
+	pb.mu.Lock()
+	pb.current += n
+	pb.render(false)
+	pb.mu.Unlock()
+}
+
+// Set the current progress
+func (pb *ProgressBar) Set(current int64) {
+	pb.mu.Lock()
+	pb.current = current
+	pb.render(false)
+	pb.mu.Unlock()
+}
+
+// Current get the current progress
+func (pb *ProgressBar) Current() int64 {
+	pb.mu.Lock()
+	defer pb.mu.Unlock()
+	return pb.current
+}
+
+// Finish render the last status and end with a newline.
+func (pb *ProgressBar) Finish() {
+	pb.mu.Lock()
+	defer pb.mu.Unlock()
+
+	if pb.finished {
+		return
+	}
+
+	pb.finished = true
+	pb.render(true)
+	if !pb.Disabled {
+		_, _ = io.WriteString(pb.Writer, "\n")
+	}
+}
+
+func (pb *ProgressBar) render(force bool) {
+	if pb.Disabled || pb.finished && !force {
+		return
+	}
+
+	now := time.Now()
+	if !force && now.Sub(pb.rendered) < pb.RefreshInterval {
+		return
+	}
+
+	pb.rendered = now
+	_, _ = io.WriteString(pb.Writer, "\r"+pb.line(now)+"\x1b[K")
+}
+
+// String get the progress bar line
+func (pb *ProgressBar) String() string {
+	pb.mu.Lock()
+	defer pb.mu.Unlock()
+	return pb.line(time.Now())
+}
+
+func (pb *ProgressBar) line(now time.Time) string {
+	var sb strings.Builder
+	if pb.Total > 0 {
+		ratio := float64(pb.current) / float64(pb.Total)
+		if ratio > 1 {
+			ratio = 1
+		}
+
+		done := int(ratio * float64(pb.Width))
+		sb.WriteByte('[')
+		sb.WriteString(strings.Repeat("=", done))
+		if done < pb.Width {
+			sb.WriteByte('>')
+			sb.WriteString(strings.Repeat("-", pb.Width-done-1))
+		}
+		sb.WriteString(fmt.Sprintf("] %3d%%", int(ratio*100)))
+	}
+
+	if pb.ShowBytes {
+		sb.WriteByte(' ')
+		sb.WriteString(mathutil.DataSize(uint64(pb.current)))
+		if pb.Total > 0 {
+			sb.WriteString("/" + mathutil.DataSize(uint64(pb.Total)))
+		}
+	} else if pb.Total <= 0 {
+		sb.WriteString(fmt.Sprintf("%d", pb.current))
+	}
+
+	if pb.ShowETA && pb.Total > 0 {
+		sb.WriteString(" ETA " + pb.eta(now))
+	}
+	return strings.TrimSpace(sb.String())
+}
+
+func (pb *ProgressBar) eta(now time.Time) string {
+	if pb.current >= pb.Total {
+		return "0s"
+	}
+	if pb.current <= 0 {
+		return "-"
+	}
+
+	elapsed := now.Sub(pb.started)
+	remain := time.Duration(float64(elapsed) * float64(pb.Total-pb.current) / float64(pb.current))
+	return remain.Round(time.Second).String()
+}
+
+// DefaultSpinnerFrames the default frames for Spinner
+var DefaultSpinnerFrames = []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
+
+// Spinner show a spinner with message for the long-running task.
+//
+// Usage:
+// 	sp := cliutil.NewSpinner("loading ...")
+// 	sp.Start()
+// 	// do something ...
+// 	sp.Stop("done")
+type Spinner struct {
+	// Frames for render, default is DefaultSpinnerFrames
+	Frames []string
+	// Interval for change frame, default is 100ms
+	Interval time.Duration
+	// Writer for render the spinner, default is the Output
+	Writer io.Writer
+	// Disabled not render the spinner. default is true on the Writer is not a terminal,
+	// it is checked by the Writer after apply the option funcs.
+	Disabled bool
+
+	mu      sync.Mutex
+	message string
+	stop    chan struct{}
+	done    chan struct{}
+}
+
+// NewSpinner create a spinner
+func NewSpinner(message string, fns ...func(s *Spinner)) *Spinner {
+	s := &Spinner{
+		Frames:   DefaultSpinnerFrames,
+		Interval: 100 * time.Millisecond,
+		Writer:   Output,
+		message:  message,
+	}
+
+	s.Disabled = !IsTerminalWriter(s.Writer)
+	for _, fn := range fns {
+		fn(s)
+	}
+
+	s.Disabled = checkDisabled(Output, s.Writer, s.Disabled)
+	return s
+}
+
+// SetMessage change the message on running
+func (s *Spinner) SetMessage(message string) {
+	s.mu.Lock()
+	s.message = message
+	s.mu.Unlock()
+}
+
+// Start the spinner in a new goroutine. repeat call will be ignored.
+func (s *Spinner) Start() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.Disabled || s.stop != nil {
+		return
+	}
+
+	s.stop = make(chan struct{})
+	s.done = make(chan struct{})
+	go s.run(s.stop, s.done)
+}
+
+func (s *Spinner) run(stop, done chan struct{}) {
+	defer close(done)
+
+	ticker := time.NewTicker(s.Interval)
+	defer ticker.Stop()
+
+	for i := 0; ; i++ {
+		s.mu.Lock()
+		_, _ = io.WriteString(s.Writer, "\r"+s.Frames[i%len(s.Frames)]+" "+s.message+"\x1b[K")
+		s.mu.Unlock()
+
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Stop the spinner, will clear the spinner line. if has the final message, will print it with newline.
+func (s *Spinner) Stop(finalMsg ...string) {
+	s.mu.Lock()
+	stop, done := s.stop, s.done
+	s.stop, s.done = nil, nil
+	s.mu.Unlock()
+
+	if stop == nil {
+		// only print the final message on disabled
+		if s.Disabled && len(finalMsg) > 0 {
+			_, _ = io.WriteString(s.Writer, finalMsg[0]+"\n")
+		}
+		return
+	}
+
+	close(stop)
+	<-done
+
+	_, _ = io.WriteString(s.Writer, "\r\x1b[K")
+	if len(finalMsg) > 0 {
+		_, _ = io.WriteString(s.Writer, finalMsg[0]+"\n")
+	}
+}
@@ -0,0 +1,144 @@
+package cliutil_test
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gookit/goutil/cliutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProgressBar(t *testing.T) {
+	buf := &bytes.Buffer{}
+	bar := cliutil.NewProgressBar(100, func(pb *cliutil.ProgressBar) {
+		pb.Width = 10
+		pb.Writer = buf
+		pb.RefreshInterval = 0
+	})
+	assert.True(t, bar.Disabled)
+
+	bar.Disabled = false
+	bar.Add(50)
+	assert.Equal(t, int64(50), bar.Current())
+	assert.Equal(t, "[=====>----]  50%", bar.String())
+	assert.Equal(t, "\r[=====>----]  50%\x1b[K", buf.String())
+
+	bar.Set(100)
+	bar.Finish()
+	bar.Finish()
+	assert.Equal(t, "[==========] 100%", bar.String())
+	assert.True(t, strings.HasSuffix(buf.String(), "\r[==========] 100%\x1b[K\n"))
+
+	// after finished
+	buf.Reset()
+	bar.Add(1)
+	assert.Equal(t, "", buf.String())
+}
+
+func TestProgressBar_bytesAndETA(t *testing.T) {
+	buf := &bytes.Buffer{}
+	bar := cliutil.NewProgressBar(4096, func(pb *cliutil.ProgressBar) {
+		pb.Width = 4
+		pb.Writer = buf
+		pb.ShowBytes = true
+		pb.ShowETA = true
+	})
+	assert.Equal(t, "[>---]   0% 0B/4.00K ETA -", bar.String())
+
+	n, err := io.Copy(ioutil.Discard, io.TeeReader(strings.NewReader(strings.Repeat("a", 2048)), bar))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2048), n)
+	assert.True(t, strings.HasPrefix(bar.String(), "[==>-]  50% 2.00K/4.00K ETA "))
+
+	bar.Set(4096)
+	assert.Equal(t, "[====] 100% 4.00K/4.00K ETA 0s", bar.String())
+
+	// disabled
+	bar.Finish()
+	assert.Equal(t, "", buf.String())
+
+	// unknown total
+	bar = cliutil.NewProgressBar(0)
+	bar.Add(12)
+	assert.Equal(t, "12", bar.String())
+	bar.ShowBytes = true
+	assert.Equal(t, "12B", bar.String())
+}
+
+func TestSpinner(t *testing.T) {
+	buf := &bytes.Buffer{}
+	sp := cliutil.NewSpinner("loading", func(s *cliutil.Spinner) {
+		s.Writer = buf
+		s.Interval = 5 * time.Millisecond
+		s.Frames = []string{"-", "+"}
+	})
+	assert.True(t, sp.Disabled)
+	lu := cliutil.NewLineUpdater(func(lu *cliutil.LineUpdater) {
+		lu.Writer = buf
+	})
+	assert.True(t, lu.Disabled)
+
+	sp.Start()
+	sp.Stop("done")
+	assert.Equal(t, "done\n", buf.String())
+
+	buf.Reset()
+	sp.Disabled = false
+	sp.Start()
+	sp.Start()
+	time.Sleep(12 * time.Millisecond)
+	sp.SetMessage("running")
+	time.Sleep(12 * time.Millisecond)
+	sp.Stop("ok")
+	sp.Stop()
+
+	out := buf.String()
+	assert.Contains(t, out, "\r- loading")
+	assert.Contains(t, out, "running\x1b[K")
+	assert.True(t, strings.HasSuffix(out, "\r\x1b[Kok\n"))
+}
+
+func TestNewProgressBar_checkDisabled(t *testing.T) {
+	tty, err := os.OpenFile("/dev/ptmx", os.O_RDWR, 0)
+	if err != nil || !cliutil.IsTerminalWriter(tty) {
+		t.Skip("skip on the terminal is not available")
+	}
+	defer tty.Close()
+
+	old := cliutil.Output
+	cliutil.Output = tty
+	defer func() {
+		cliutil.Output = old
+	}()
+
+	assert.False(t, cliutil.NewProgressBar(100).Disabled)
+	assert.False(t, cliutil.NewSpinner("loading").Disabled)
+	assert.False(t, cliutil.NewLineUpdater().Disabled)
+
+	// the custom writer is not a terminal
+	buf := &bytes.Buffer{}
+	bar := cliutil.NewProgressBar(100, func(pb *cliutil.ProgressBar) {
+		pb.Writer = buf
+	})
+	assert.True(t, bar.Disabled)
+	sp := cliutil.NewSpinner("loading", func(s *cliutil.Spinner) {
+		s.Writer = buf
+	})
+	assert.True(t, sp.Disabled)
+	lu := cliutil.NewLineUpdater(func(lu *cliutil.LineUpdater) {
+		lu.Writer = buf
+	})
+	assert.True(t, lu.Disabled)
+
+	// set by the option func
+	bar = cliutil.NewProgressBar(100, func(pb *cliutil.ProgressBar) {
+		pb.Writer = buf
+		pb.Disabled = true
+	})
+	assert.True(t, bar.Disabled)
+}
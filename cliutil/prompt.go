@@ -0,0 +1,316 @@
+package cliutil
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+
+	"github.com/gookit/color"
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+// ErrCanceled the user canceled the select by Ctrl+C or "q"
+var ErrCanceled = errors.New("cliutil: canceled by user")
+
+// InputOptions for ReadInput
+type InputOptions struct {
+	// Default value on the input is empty
+	Default string
+	// Validator check the input value, will re-ask on validate failed.
+	Validator func(val string) error
+	// MaxTry max times for ask on validate failed. default is 3
+	MaxTry int
+}
+
+// WithDefault set the default value for ReadInput
+func WithDefault(def string) func(opt *InputOptions) {
+	return func(opt *InputOptions) {
+		opt.Default = def
+	}
+}
+
+// WithValidator set the value validator for ReadInput
+func WithValidator(fn func(val string) error) func(opt *InputOptions) {
+	return func(opt *InputOptions) {
+		opt.Validator = fn
+	}
+}
+
+// ReadConfirm ask the user to confirm, allow answer: y, yes, n, no (case-insensitive).
+// returns the defVal on the answer is empty, default is false.
+//
+// Usage:
+// 	if cliutil.ReadConfirm("proceed? [y/N] ") {
+// 		// do something ...
+// 	}
+func ReadConfirm(question string, defVal ...bool) bool {
+	def := len(defVal) > 0 && defVal[0]
+	for {
+		color.Fprint(Output, question)
+		answer, err := readLine()
+		if answer == "" {
+			return def
+		}
+
+		switch strings.ToLower(answer) {
+		case "y", "yes":
+			return true
+		case "n", "no":
+			return false
+		}
+
+		if err != nil {
+			return def
+		}
+		color.Fprintln(Output, "<error>please input: y, yes, n or no</>")
+	}
+}
+
+// SelectOne let the user select one option from the list, returns the index of selected.
+// will use the arrow keys for select on terminal, otherwise input the number of option.
+//
+// Usage:
+// 	idx, err := cliutil.SelectOne("select env:", []string{"dev", "test", "prod"})
+func SelectOne(question string, options []string, defIdx ...int) (int, error) {
+	if len(options) == 0 {
+		return -1, errors.New("cliutil: the select options is empty")
+	}
+
+	def := -1
+	if len(defIdx) > 0 && defIdx[0] >= 0 && defIdx[0] < len(options) {
+		def = defIdx[0]
+	}
+
+	if canUseArrowKeys() {
+		sel, err := arrowSelect(question, options, def, false)
+		if err != nil {
+			return -1, err
+		}
+		return sel[0], nil
+	}
+
+	color.Fprintln(Output, question)
+	printOptions(options)
+
+	tip := fmt.Sprintf("Your choice [1-%d]", len(options))
+	if def >= 0 {
+		tip += fmt.Sprintf(" (default %d)", def+1)
+	}
+
+	for {
+		color.Fprint(Output, tip+": ")
+		answer, err := readLine()
+		if answer == "" && def >= 0 {
+			return def, nil
+		}
+
+		if idx, ok := optionIndex(answer, options); ok {
+			return idx, nil
+		}
+
+		if err != nil {
+			return -1, err
+		}
+		color.Fprintf(Output, "<error>invalid choice %q</>\n", answer)
+	}
+}
+
+// SelectMulti let the user select multi options from the list, returns the indexes of selected.
+// will use the arrow keys and space for select on terminal,
+// otherwise input the numbers of options, split by comma or space. eg: "1,3"
+func SelectMulti(question string, options []string) ([]int, error) {
+	if len(options) == 0 {
+		return nil, errors.New("cliutil: the select options is empty")
+	}
+
+	if canUseArrowKeys() {
+		return arrowSelect(question, options, -1, true)
+	}
+
+	color.Fprintln(Output, question)
+	printOptions(options)
+
+	tip := fmt.Sprintf("Your choices, split by comma [1-%d]: ", len(options))
+	for {
+		color.Fprint(Output, tip)
+		answer, err := readLine()
+		if answer != "" {
+			if idxs, ok := parseChoices(answer, options); ok {
+				return idxs, nil
+			}
+			color.Fprintf(Output, "<error>invalid choices %q</>\n", answer)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+func printOptions(options []string) {
+	for i, opt := range options {
+		color.Fprintf(Output, "  <info>%d)</> %s\n", i+1, opt)
+	}
+}
+
+// optionIndex the answer can be the number or the option text.
+func optionIndex(answer string, options []string) (int, bool) {
+	if num, err := strconv.Atoi(answer); err == nil {
+		return num - 1, num > 0 && num <= len(options)
+	}
+
+	for i, opt := range options {
+		if opt == answer {
+			return i, true
+		}
+	}
+	return -1, false
+}
+
+func parseChoices(answer string, options []string) ([]int, bool) {
+	fields := strings.FieldsFunc(answer, func(r rune) bool {
+		return r == ',' || r == ' '
+	})
+
+	seen := make(map[int]bool, len(fields))
+	idxs := make([]int, 0, len(fields))
+	for _, field := range fields {
+		idx, ok := optionIndex(field, options)
+		if !ok {
+			return nil, false
+		}
+
+		if !seen[idx] {
+			seen[idx] = true
+			idxs = append(idxs, idx)
+		}
+	}
+	return idxs, len(idxs) > 0
+}
+
+// canUseArrowKeys only on read from the terminal stdin. windows console not support the VT input by default.
+func canUseArrowKeys() bool {
+	return Input == os.Stdin && runtime.GOOS != "windows" && terminal.IsTerminal(int(os.Stdin.Fd()))
+}
+
+// key codes for arrowSelect
+const (
+	keyUp = iota + 1
+	keyDown
+	keySpace
+	keyEnter
+	keyCancel
+)
+
+// arrowSelect select options by arrow keys in raw mode.
+func arrowSelect(question string, options []string, def int, multi bool) ([]int, error) {
+	fd := int(os.Stdin.Fd())
+	state, err := terminal.MakeRaw(fd)
+	if err != nil {
+		return nil, err
+	}
+	defer terminal.Restore(fd, state)
+
+	cur := def
+	if cur < 0 {
+		cur = 0
+	}
+	checked := make([]bool, len(options))
+
+	tip := "(use arrow keys to move, enter to confirm)"
+	if multi {
+		tip = "(use arrow keys to move, space to select, enter to confirm)"
+	}
+
+	// in raw mode, must use \r\n for new line
+	color.Fprintf(Output, "%s <gray>%s</>\r\n", question, tip)
+	render := func(first bool) {
+		if !first {
+			_, _ = fmt.Fprintf(Output, "\x1b[%dA", len(options))
+		}
+
+		for i, opt := range options {
+			mark := "  "
+			if i == cur {
+				mark = "<info>></> "
+			}
+
+			if multi {
+				box := "[ ]"
+				if checked[i] {
+					box = "<green>[x]</>"
+				}
+				mark += box + " "
+			}
+			color.Fprint(Output, "\r\x1b[2K"+mark+opt+"\r\n")
+		}
+	}
+
+	render(true)
+	buf := make([]byte, 3)
+	for {
+		n, err := os.Stdin.Read(buf)
+		if err != nil {
+			return nil, err
+		}
+
+		switch parseKey(buf[:n]) {
+		case keyUp:
+			cur = (cur - 1 + len(options)) % len(options)
+		case keyDown:
+			cur = (cur + 1) % len(options)
+		case keySpace:
+			if multi {
+				checked[cur] = !checked[cur]
+			}
+		case keyEnter:
+			if !multi {
+				return []int{cur}, nil
+			}
+
+			var idxs []int
+			for i, ok := range checked {
+				if ok {
+					idxs = append(idxs, i)
+				}
+			}
+			return idxs, nil
+		case keyCancel:
+			return nil, ErrCanceled
+		}
+		render(false)
+	}
+}
+
+func parseKey(bs []byte) int {
+	if len(bs) == 3 && bs[0] == 0x1b && bs[1] == '[' {
+		switch bs[2] {
+		case 'A':
+			return keyUp
+		case 'B':
+			return keyDown
+		}
+		return 0
+	}
+
+	if len(bs) == 0 {
+		return 0
+	}
+
+	switch bs[0] {
+	case 'k':
+		return keyUp
+	case 'j':
+		return keyDown
+	case ' ':
+		return keySpace
+	case '\r', '\n':
+		return keyEnter
+	case 3, 'q': // 3 is Ctrl+C
+		return keyCancel
+	}
+	return 0
+}
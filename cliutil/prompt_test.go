@@ -0,0 +1,141 @@
+package cliutil_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/gookit/goutil/cliutil"
+	"github.com/stretchr/testify/assert"
+)
+
+// mockIO mock the input text and collect the output
+func mockIO(input string, fn func(out *bytes.Buffer)) {
+	out := &bytes.Buffer{}
+	cliutil.Input = strings.NewReader(input)
+	cliutil.Output = out
+	defer func() {
+		cliutil.Input = os.Stdin
+		cliutil.Output = os.Stdout
+	}()
+
+	fn(out)
+}
+
+func TestReadInput(t *testing.T) {
+	mockIO("inhere\n", func(out *bytes.Buffer) {
+		ans, err := cliutil.ReadInput("your name? ")
+		assert.NoError(t, err)
+		assert.Equal(t, "inhere", ans)
+		assert.Equal(t, "your name? ", out.String())
+	})
+
+	mockIO("\n", func(out *bytes.Buffer) {
+		ans, err := cliutil.ReadInput("port? ", cliutil.WithDefault("8080"))
+		assert.NoError(t, err)
+		assert.Equal(t, "8080", ans)
+	})
+
+	checkNum := func(val string) error {
+		if strings.Trim(val, "0123456789") != "" {
+			return errors.New("must be number")
+		}
+		return nil
+	}
+
+	mockIO("abc\n23", func(out *bytes.Buffer) {
+		ans, err := cliutil.ReadInput("port? ", cliutil.WithValidator(checkNum))
+		assert.NoError(t, err)
+		assert.Equal(t, "23", ans)
+		assert.Contains(t, out.String(), "must be number")
+	})
+
+	mockIO("a\nb\nc\nd\n", func(out *bytes.Buffer) {
+		_, err := cliutil.ReadInput("port? ", cliutil.WithValidator(checkNum))
+		assert.Error(t, err)
+	})
+
+	// EOF
+	mockIO("", func(out *bytes.Buffer) {
+		ans, err := cliutil.ReadInput("port? ", cliutil.WithDefault("80"), cliutil.WithValidator(checkNum))
+		assert.NoError(t, err)
+		assert.Equal(t, "80", ans)
+	})
+}
+
+func TestReadConfirm(t *testing.T) {
+	mockIO("y\n", func(out *bytes.Buffer) {
+		assert.True(t, cliutil.ReadConfirm("proceed? [y/N] "))
+	})
+	mockIO("No\n", func(out *bytes.Buffer) {
+		assert.False(t, cliutil.ReadConfirm("proceed? [Y/n] ", true))
+	})
+	mockIO("\n", func(out *bytes.Buffer) {
+		assert.True(t, cliutil.ReadConfirm("proceed? [Y/n] ", true))
+	})
+	mockIO("abc\nyes", func(out *bytes.Buffer) {
+		assert.True(t, cliutil.ReadConfirm("proceed? [y/N] "))
+		assert.Contains(t, out.String(), "please input")
+	})
+	mockIO("abc", func(out *bytes.Buffer) {
+		assert.False(t, cliutil.ReadConfirm("proceed? [y/N] "))
+	})
+}
+
+func TestSelectOne(t *testing.T) {
+	opts := []string{"dev", "test", "prod"}
+	mockIO("2\n", func(out *bytes.Buffer) {
+		idx, err := cliutil.SelectOne("select env:", opts)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, idx)
+		assert.Contains(t, out.String(), " prod\n")
+	})
+
+	mockIO("5\nprod\n", func(out *bytes.Buffer) {
+		idx, err := cliutil.SelectOne("select env:", opts)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, idx)
+		assert.Contains(t, out.String(), `invalid choice "5"`)
+	})
+
+	mockIO("\n", func(out *bytes.Buffer) {
+		idx, err := cliutil.SelectOne("select env:", opts, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, idx)
+	})
+
+	mockIO("", func(out *bytes.Buffer) {
+		_, err := cliutil.SelectOne("select env:", opts)
+		assert.Equal(t, io.EOF, err)
+	})
+
+	_, err := cliutil.SelectOne("select env:", nil)
+	assert.Error(t, err)
+}
+
+func TestSelectMulti(t *testing.T) {
+	opts := []string{"dev", "test", "prod"}
+	mockIO("3, 1 3\n", func(out *bytes.Buffer) {
+		idxs, err := cliutil.SelectMulti("select envs:", opts)
+		assert.NoError(t, err)
+		assert.Equal(t, []int{2, 0}, idxs)
+	})
+
+	mockIO("\n1,4\ntest", func(out *bytes.Buffer) {
+		idxs, err := cliutil.SelectMulti("select envs:", opts)
+		assert.NoError(t, err)
+		assert.Equal(t, []int{1}, idxs)
+		assert.Contains(t, out.String(), `invalid choices "1,4"`)
+	})
+
+	mockIO("", func(out *bytes.Buffer) {
+		_, err := cliutil.SelectMulti("select envs:", opts)
+		assert.Equal(t, io.EOF, err)
+	})
+
+	_, err := cliutil.SelectMulti("select envs:", nil)
+	assert.Error(t, err)
+}
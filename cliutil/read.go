@@ -2,25 +2,85 @@ package cliutil
 
 import (
 	"bufio"
+	"io"
+	"io/ioutil"
 	"os"
 	"strings"
 
 	"github.com/gookit/color"
 )
 
-// ReadInput read user input form Stdin
-func ReadInput(question string) (string, error) {
-	if len(question) > 0 {
-		color.Print(question)
+// Input the reader for read user input, can change it for testing.
+var Input io.Reader = os.Stdin
+
+// Output the writer for print the question and messages, can change it for testing.
+var Output io.Writer = os.Stdout
+
+var (
+	// the buffered reader of the Input
+	inReader *bufio.Reader
+	inSource io.Reader
+)
+
+// inputReader get the buffered reader of the Input, will rebuild it on the Input changed.
+func inputReader() *bufio.Reader {
+	if inReader == nil || inSource != Input {
+		inSource = Input
+		inReader = bufio.NewReader(Input)
 	}
+	return inReader
+}
+
+// readLine read one line from the Input, the last line without newline is allowed.
+func readLine() (string, error) {
+	line, err := inputReader().ReadString('\n')
+	if err == io.EOF && line != "" {
+		err = nil
+	}
+	return strings.TrimSpace(line), err
+}
 
-	scanner := bufio.NewScanner(os.Stdin)
-	if !scanner.Scan() { // reading
-		return "", scanner.Err()
+// ReadInput read user input form Stdin. can with options, see InputOptions
+//
+// Usage:
+// 	name, err := cliutil.ReadInput("your name? ")
+// 	port, err := cliutil.ReadInput("port? [8080] ", cliutil.WithDefault("8080"), cliutil.WithValidator(checkPort))
+func ReadInput(question string, fns ...func(opt *InputOptions)) (string, error) {
+	opt := &InputOptions{MaxTry: 3}
+	for _, fn := range fns {
+		fn(opt)
 	}
 
-	answer := scanner.Text()
-	return strings.TrimSpace(answer), nil
+	for i := 1; ; i++ {
+		if len(question) > 0 {
+			color.Fprint(Output, question)
+		}
+
+		// on EOF, use the default value.
+		answer, err := readLine()
+		if err != nil && err != io.EOF {
+			return answer, err
+		}
+
+		eof := err == io.EOF
+		if answer == "" {
+			answer = opt.Default
+		}
+
+		if opt.Validator == nil {
+			return answer, nil
+		}
+
+		err = opt.Validator(answer)
+		if err == nil {
+			return answer, nil
+		}
+
+		if eof || i >= opt.MaxTry {
+			return "", err
+		}
+		color.Fprintf(Output, "<error>%s</>\n", err.Error())
+	}
 }
 
 // ReadLine read one line from user input.
@@ -30,11 +90,10 @@ func ReadInput(question string) (string, error) {
 // 	ans, _ := cliutil.ReadLine("your name?")
 func ReadLine(question string) (string, error) {
 	if len(question) > 0 {
-		color.Print(question)
+		color.Fprint(Output, question)
 	}
 
-	reader := bufio.NewReader(os.Stdin)
-	answer, _, err := reader.ReadLine()
+	answer, _, err := inputReader().ReadLine()
 	return strings.TrimSpace(string(answer)), err
 }
 
@@ -51,20 +110,77 @@ func ReadFirst(question string) (string, error) {
 // 	ans, _ := cliutil.ReadFirstByte("continue?[y/n] ")
 func ReadFirstByte(question string) (byte, error) {
 	if len(question) > 0 {
-		color.Print(question)
+		color.Fprint(Output, question)
 	}
 
-	reader := bufio.NewReader(os.Stdin)
-	return reader.ReadByte()
+	return inputReader().ReadByte()
 }
 
 // ReadFirstRune read first rune char
 func ReadFirstRune(question string) (rune, error) {
 	if len(question) > 0 {
-		color.Print(question)
+		color.Fprint(Output, question)
 	}
 
-	reader := bufio.NewReader(os.Stdin)
-	answer, _, err := reader.ReadRune()
+	answer, _, err := inputReader().ReadRune()
 	return answer, err
 }
+
+// IsPipedInput check the Input is piped or redirected from a file, not from the terminal.
+//
+// Usage:
+// 	// cat file.txt | mytool
+// 	if cliutil.IsPipedInput() {
+// 		text, err := cliutil.ReadStdin()
+// 	}
+func IsPipedInput() bool {
+	f, ok := Input.(*os.File)
+	if !ok {
+		// custom reader, eg: for testing
+		return true
+	}
+
+	fi, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return fi.Mode()&os.ModeCharDevice == 0
+}
+
+// ReadStdin read all contents from the Input, the trailing newline will be removed.
+// returns empty string on the Input is not piped, don't block on waiting user input.
+func ReadStdin() (string, error) {
+	if !IsPipedInput() {
+		return "", nil
+	}
+
+	bs, err := ioutil.ReadAll(inputReader())
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(string(bs), "\r\n"), nil
+}
+
+// ReadStdinLines read all lines from the Input, the empty lines will be ignored.
+// returns nil on the Input is not piped. there is no limit on the line length.
+func ReadStdinLines() ([]string, error) {
+	if !IsPipedInput() {
+		return nil, nil
+	}
+
+	var lines []string
+	r := inputReader()
+	for {
+		line, err := r.ReadString('\n')
+		if line = strings.TrimRight(line, "\r\n"); line != "" {
+			lines = append(lines, line)
+		}
+
+		if err == io.EOF {
+			return lines, nil
+		}
+		if err != nil {
+			return lines, err
+		}
+	}
+}
@@ -1,7 +1,15 @@
 package cliutil_test
 
 import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"strings"
 	"testing"
+	"testing/iotest"
+
+	"github.com/gookit/goutil/cliutil"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestReadFirst(t *testing.T) {
@@ -12,4 +20,63 @@ func TestReadFirst(t *testing.T) {
 	// assert.NoError(t, err)
 	// assert.NoError(t, err1)
 	// assert.Equal(t, "haha", ans)
+	mockIO("haha\n", func(out *bytes.Buffer) {
+		ans, err := cliutil.ReadFirst("hi?")
+		assert.NoError(t, err)
+		assert.Equal(t, "h", ans)
+		assert.Equal(t, "hi?", out.String())
+	})
+}
+
+func TestReadStdin(t *testing.T) {
+	mockIO("line1\r\n\nline2\n", func(out *bytes.Buffer) {
+		assert.True(t, cliutil.IsPipedInput())
+		text, err := cliutil.ReadStdin()
+		assert.NoError(t, err)
+		assert.Equal(t, "line1\r\n\nline2", text)
+	})
+
+	mockIO("line1\r\n\nline2\n", func(out *bytes.Buffer) {
+		lines, err := cliutil.ReadStdinLines()
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"line1", "line2"}, lines)
+	})
+
+	// long line
+	long := strings.Repeat("a", 100*1024)
+	mockIO(long+"\nline2", func(out *bytes.Buffer) {
+		lines, err := cliutil.ReadStdinLines()
+		assert.NoError(t, err)
+		assert.Equal(t, []string{long, "line2"}, lines)
+	})
+
+	// redirect from file
+	f, err := ioutil.TempFile("", "cliutil")
+	assert.NoError(t, err)
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	_, err = f.WriteString("file contents\n")
+	assert.NoError(t, err)
+	_, err = f.Seek(0, 0)
+	assert.NoError(t, err)
+
+	cliutil.Input = f
+	defer func() {
+		cliutil.Input = os.Stdin
+	}()
+
+	assert.True(t, cliutil.IsPipedInput())
+	text, err := cliutil.ReadStdin()
+	assert.NoError(t, err)
+	assert.Equal(t, "file contents", text)
+
+	// read error
+	cliutil.Input = iotest.TimeoutReader(strings.NewReader("line1\nline2"))
+	_, err = cliutil.ReadStdin()
+	assert.Error(t, err)
+
+	cliutil.Input = iotest.TimeoutReader(strings.NewReader("line1\nline2"))
+	_, err = cliutil.ReadStdinLines()
+	assert.Error(t, err)
 }
@@ -0,0 +1,135 @@
+package cliutil
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/gookit/goutil/envutil"
+)
+
+// policies on a step run failed
+const (
+	// StopOnError stop run the remaining steps on a step failed. it is default.
+	StopOnError = iota
+	// ContinueOnError continue run the remaining steps on a step failed.
+	ContinueOnError
+)
+
+// StepResult the run result of a step
+type StepResult struct {
+	Name string
+	// Err the error of run the step, nil on success
+	Err error
+	// Skipped the step is not run, because a previous step failed
+	Skipped bool
+	Elapsed time.Duration
+}
+
+// Steps a multi-step workflow runner, will print the status of each step.
+//
+// Usage:
+// 	err := cliutil.NewSteps().
+// 		Add("build", buildFn).
+// 		Add("deploy", deployFn).
+// 		Run()
+//
+// Output like:
+// 	✓ build (1.203s)
+// 	✗ deploy (12ms): connect to server failed
+type Steps struct {
+	// Policy on a step failed. default is StopOnError
+	Policy int
+	// Writer for print the status, default is the Output
+	Writer io.Writer
+	// NoColor disable the color, default is disabled on the envutil.ColorLevel() is none.
+	// the color also is disabled on the Writer is not a terminal.
+	NoColor bool
+	// Results of the last run
+	Results []StepResult
+
+	names []string
+	fns   []func() error
+}
+
+// NewSteps create a steps runner
+func NewSteps(fns ...func(s *Steps)) *Steps {
+	s := &Steps{
+		NoColor: envutil.ColorLevel() == envutil.ColorLevelNone,
+	}
+
+	for _, fn := range fns {
+		fn(s)
+	}
+	return s
+}
+
+// Add a step
+func (s *Steps) Add(name string, fn func() error) *Steps {
+	s.names = append(s.names, name)
+	s.fns = append(s.fns, fn)
+	return s
+}
+
+// Len get the number of steps
+func (s *Steps) Len() int {
+	return len(s.fns)
+}
+
+// Run all steps in order. returns the error of the failed step on StopOnError,
+// or an error contains all failed step names on ContinueOnError.
+func (s *Steps) Run() error {
+	s.Results = make([]StepResult, 0, len(s.fns))
+
+	var firstErr error
+	var failed []string
+	for i, fn := range s.fns {
+		res := StepResult{Name: s.names[i]}
+		if firstErr != nil && s.Policy == StopOnError {
+			res.Skipped = true
+			s.Results = append(s.Results, res)
+			s.printResult(res)
+			continue
+		}
+
+		start := time.Now()
+		res.Err = fn()
+		res.Elapsed = time.Since(start)
+
+		if res.Err != nil {
+			failed = append(failed, res.Name)
+			if firstErr == nil {
+				firstErr = fmt.Errorf("cliutil: run step %q failed: %w", res.Name, res.Err)
+			}
+		}
+
+		s.Results = append(s.Results, res)
+		s.printResult(res)
+	}
+
+	if len(failed) > 1 {
+		return fmt.Errorf("cliutil: %d steps failed: %s", len(failed), strings.Join(failed, ", "))
+	}
+	return firstErr
+}
+
+func (s *Steps) printResult(res StepResult) {
+	w := s.Writer
+	if w == nil {
+		w = Output
+	}
+
+	var line string
+	enable := !s.NoColor && colorEnabled(w)
+	elapsed := res.Elapsed.Round(time.Millisecond).String()
+	switch {
+	case res.Skipped:
+		line = colorize("- "+res.Name+" (skipped)", "90", enable)
+	case res.Err != nil:
+		line = colorize("✗", "31", enable) + " " + res.Name + " (" + elapsed + "): " + res.Err.Error()
+	default:
+		line = colorize("✓", "32", enable) + " " + res.Name + " (" + elapsed + ")"
+	}
+	_, _ = io.WriteString(w, line+"\n")
+}
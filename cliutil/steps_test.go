@@ -0,0 +1,62 @@
+package cliutil_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/gookit/goutil/cliutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSteps_Run(t *testing.T) {
+	buf := &bytes.Buffer{}
+	var ran []string
+	newStep := func(name string, err error) func() error {
+		return func() error {
+			ran = append(ran, name)
+			return err
+		}
+	}
+
+	s := cliutil.NewSteps(func(s *cliutil.Steps) {
+		s.Writer = buf
+		s.NoColor = true
+	})
+	s.Add("build", newStep("build", nil)).
+		Add("deploy", newStep("deploy", errors.New("connect failed"))).
+		Add("notify", newStep("notify", nil))
+	assert.Equal(t, 3, s.Len())
+
+	err := s.Run()
+	assert.Error(t, err)
+	assert.Equal(t, `cliutil: run step "deploy" failed: connect failed`, err.Error())
+	assert.Equal(t, []string{"build", "deploy"}, ran)
+	assert.True(t, s.Results[2].Skipped)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	assert.Len(t, lines, 3)
+	assert.True(t, strings.HasPrefix(lines[0], "✓ build ("))
+	assert.True(t, strings.HasPrefix(lines[1], "✗ deploy ("))
+	assert.True(t, strings.HasSuffix(lines[1], "): connect failed"))
+	assert.Equal(t, "- notify (skipped)", lines[2])
+
+	// continue on error
+	ran = nil
+	buf.Reset()
+	s.Policy = cliutil.ContinueOnError
+	s.Add("test", newStep("test", errors.New("test failed")))
+
+	err = s.Run()
+	assert.Equal(t, "cliutil: 2 steps failed: deploy, test", err.Error())
+	assert.Equal(t, []string{"build", "deploy", "notify", "test"}, ran)
+	assert.Len(t, s.Results, 4)
+
+	// all success
+	s = cliutil.NewSteps(func(s *cliutil.Steps) {
+		s.Writer = buf
+	})
+	assert.NoError(t, s.Add("build", newStep("build", nil)).Run())
+	assert.NoError(t, s.Results[0].Err)
+}
@@ -0,0 +1,259 @@
+package cliutil
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/gookit/goutil/strutil"
+)
+
+// Align for the table column
+type Align uint8
+
+// table column alignments
+const (
+	AlignLeft Align = iota
+	AlignRight
+	AlignCenter
+)
+
+// TableStyle the render style of the table
+type TableStyle uint8
+
+// table styles
+const (
+	// TableBorder render with ASCII borders. it is default.
+	TableBorder TableStyle = iota
+	// TablePlain render without borders, the columns split by two spaces.
+	TablePlain
+	// TableMarkdown render as a markdown table.
+	TableMarkdown
+)
+
+// Table a simple table builder for render list data on terminal.
+// the cell width is calc by display width, support CJK chars and colored text.
+//
+// Usage:
+// 	tb := cliutil.NewTable("ID", "Name", "Score")
+// 	tb.AddRow(1, "inhere", 89.5).AddRow(2, "tom", 100)
+// 	tb.SetAlign(2, cliutil.AlignRight)
+// 	tb.Print()
+//
+// Output:
+// 	+----+--------+-------+
+// 	| ID | Name   | Score |
+// 	+----+--------+-------+
+// 	| 1  | inhere |  89.5 |
+// 	| 2  | tom    |   100 |
+// 	+----+--------+-------+
+type Table struct {
+	// Headers of the table, can be empty.
+	Headers []string
+	// Rows data of the table
+	Rows [][]string
+	// Style for render, default is TableBorder
+	Style TableStyle
+	// aligns and max widths of each column
+	aligns    map[int]Align
+	maxWidths map[int]int
+}
+
+// NewTable create a table with headers
+func NewTable(headers ...string) *Table {
+	return &Table{
+		Headers:   headers,
+		aligns:    make(map[int]Align),
+		maxWidths: make(map[int]int),
+	}
+}
+
+// AddRow add a row to the table, each cell will be converted to string by fmt.Sprint
+func (t *Table) AddRow(cells ...interface{}) *Table {
+	row := make([]string, len(cells))
+	for i, cell := range cells {
+		row[i] = fmt.Sprint(cell)
+	}
+
+	t.Rows = append(t.Rows, row)
+	return t
+}
+
+// SetAlign set the alignment of the column, col is start from 0
+func (t *Table) SetAlign(col int, align Align) *Table {
+	t.aligns[col] = align
+	return t
+}
+
+// SetMaxWidth set the max width of the column, the more contents will be truncated. 0 is no limit.
+func (t *Table) SetMaxWidth(col, width int) *Table {
+	t.maxWidths[col] = width
+	return t
+}
+
+// SetStyle set the render style
+func (t *Table) SetStyle(style TableStyle) *Table {
+	t.Style = style
+	return t
+}
+
+// Print the table to the Output
+func (t *Table) Print() {
+	t.Fprint(Output)
+}
+
+// Fprint the table to the writer
+func (t *Table) Fprint(w io.Writer) {
+	_, _ = io.WriteString(w, t.String())
+}
+
+// String render the table as string
+func (t *Table) String() string {
+	cols := len(t.Headers)
+	for _, row := range t.Rows {
+		if len(row) > cols {
+			cols = len(row)
+		}
+	}
+
+	if cols == 0 {
+		return ""
+	}
+
+	header := t.normalizeRow(t.Headers, cols)
+	rows := make([][]string, len(t.Rows))
+	for i, row := range t.Rows {
+		rows[i] = t.normalizeRow(row, cols)
+	}
+
+	widths := make([]int, cols)
+	for _, row := range append([][]string{header}, rows...) {
+		for i, cell := range row {
+			if w := cellWidth(cell); w > widths[i] {
+				widths[i] = w
+			}
+		}
+	}
+
+	var sb strings.Builder
+	hasHeader := len(t.Headers) > 0
+	switch t.Style {
+	case TablePlain:
+		if hasHeader {
+			t.writePlainRow(&sb, header, widths)
+		}
+		for _, row := range rows {
+			t.writePlainRow(&sb, row, widths)
+		}
+	case TableMarkdown:
+		// markdown table must have the header, and min width of separator is 3
+		for i, w := range widths {
+			if w < 3 {
+				widths[i] = 3
+			}
+		}
+
+		t.writeBorderRow(&sb, header, widths)
+		t.writeMarkdownSep(&sb, widths)
+		for _, row := range rows {
+			t.writeBorderRow(&sb, row, widths)
+		}
+	default:
+		writeBorderLine(&sb, widths)
+		if hasHeader {
+			t.writeBorderRow(&sb, header, widths)
+			writeBorderLine(&sb, widths)
+		}
+
+		for _, row := range rows {
+			t.writeBorderRow(&sb, row, widths)
+		}
+		if len(rows) > 0 {
+			writeBorderLine(&sb, widths)
+		}
+	}
+	return sb.String()
+}
+
+// normalizeRow fill the missing cells, replace newlines and truncate the cells.
+func (t *Table) normalizeRow(row []string, cols int) []string {
+	cells := make([]string, cols)
+	for i := range cells {
+		if i >= len(row) {
+			continue
+		}
+
+		cell := strings.Replace(row[i], "\n", " ", -1)
+		if t.Style == TableMarkdown {
+			cell = strings.Replace(cell, "|", `\|`, -1)
+		}
+
+		if max := t.maxWidths[i]; max > 0 && cellWidth(cell) > max {
+			// the colored text will lose the color on truncated
+			cell = strutil.TruncateWidth(StripANSI(cell), max, "...")
+		}
+		cells[i] = cell
+	}
+	return cells
+}
+
+func (t *Table) alignCell(cell string, col, width int) string {
+	pad := width - cellWidth(cell)
+	if pad <= 0 {
+		return cell
+	}
+
+	switch t.aligns[col] {
+	case AlignRight:
+		return strings.Repeat(" ", pad) + cell
+	case AlignCenter:
+		left := pad / 2
+		return strings.Repeat(" ", left) + cell + strings.Repeat(" ", pad-left)
+	}
+	return cell + strings.Repeat(" ", pad)
+}
+
+func (t *Table) writeBorderRow(sb *strings.Builder, row []string, widths []int) {
+	sb.WriteByte('|')
+	for i, cell := range row {
+		sb.WriteString(" " + t.alignCell(cell, i, widths[i]) + " |")
+	}
+	sb.WriteByte('\n')
+}
+
+func (t *Table) writePlainRow(sb *strings.Builder, row []string, widths []int) {
+	cells := make([]string, len(row))
+	for i, cell := range row {
+		cells[i] = t.alignCell(cell, i, widths[i])
+	}
+	sb.WriteString(strings.TrimRight(strings.Join(cells, "  "), " "))
+	sb.WriteByte('\n')
+}
+
+func (t *Table) writeMarkdownSep(sb *strings.Builder, widths []int) {
+	sb.WriteByte('|')
+	for i, w := range widths {
+		switch t.aligns[i] {
+		case AlignRight:
+			sb.WriteString(" " + strings.Repeat("-", w-1) + ": |")
+		case AlignCenter:
+			sb.WriteString(" :" + strings.Repeat("-", w-2) + ": |")
+		default:
+			sb.WriteString(" " + strings.Repeat("-", w) + " |")
+		}
+	}
+	sb.WriteByte('\n')
+}
+
+func writeBorderLine(sb *strings.Builder, widths []int) {
+	sb.WriteByte('+')
+	for _, w := range widths {
+		sb.WriteString(strings.Repeat("-", w+2) + "+")
+	}
+	sb.WriteByte('\n')
+}
+
+// cellWidth get the display width of the cell, the color codes will be ignored.
+func cellWidth(s string) int {
+	return VisibleWidth(s)
+}
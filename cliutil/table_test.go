@@ -0,0 +1,53 @@
+package cliutil_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/gookit/color"
+	"github.com/gookit/goutil/cliutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTable(t *testing.T) {
+	tb := cliutil.NewTable("ID", "Name", "Score")
+	tb.AddRow(1, "inhere", 89.5).AddRow(2, "中文名", 100).AddRow(3)
+	tb.SetAlign(2, cliutil.AlignRight)
+
+	assert.Equal(t, `+----+--------+-------+
+| ID | Name   | Score |
++----+--------+-------+
+| 1  | inhere |  89.5 |
+| 2  | 中文名 |   100 |
+| 3  |        |       |
++----+--------+-------+
+`, tb.String())
+
+	buf := &bytes.Buffer{}
+	tb.SetStyle(cliutil.TablePlain).Fprint(buf)
+	assert.Equal(t, `ID  Name    Score
+1   inhere   89.5
+2   中文名    100
+3
+`, buf.String())
+
+	tb = cliutil.NewTable("Name", "Desc")
+	tb.AddRow(color.Sprint("<info>tom</>"), "a|b")
+	tb.AddRow("x", "a very long description")
+	tb.SetMaxWidth(1, 10).SetAlign(0, cliutil.AlignCenter).SetStyle(cliutil.TableMarkdown)
+	assert.Equal(t, "| Name | Desc       |\n"+
+		"| :--: | ---------- |\n"+
+		"| "+color.Sprint("<info>tom</>")+"  | a\\|b       |\n"+
+		"|  x   | a very ... |\n", tb.String())
+}
+
+func TestTable_noHeader(t *testing.T) {
+	tb := cliutil.NewTable()
+	assert.Equal(t, "", tb.String())
+
+	tb.AddRow("a", "b")
+	assert.Equal(t, "+---+---+\n| a | b |\n+---+---+\n", tb.String())
+
+	tb = cliutil.NewTable("A")
+	assert.Equal(t, "+---+\n| A |\n+---+\n", tb.String())
+}
@@ -0,0 +1,74 @@
+package cliutil
+
+import (
+	"os"
+	"strconv"
+	"sync/atomic"
+
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+// the fallback size on get the terminal size failed.
+var (
+	DefaultTermWidth  = 80
+	DefaultTermHeight = 24
+)
+
+// the cached terminal width, 0 is not cached.
+var cachedWidth int32
+
+// TermSize get the size of the terminal. will use the Output on it is a terminal, otherwise use the stdout.
+func TermSize() (w, h int, err error) {
+	f, ok := Output.(*os.File)
+	if !ok || !IsTerminalWriter(f) {
+		f = os.Stdout
+	}
+	return terminal.GetSize(int(f.Fd()))
+}
+
+// TermWidth get the width of the terminal, the value will be cached.
+// fallback: ENV "COLUMNS", DefaultTermWidth
+func TermWidth() int {
+	if w := atomic.LoadInt32(&cachedWidth); w > 0 {
+		return int(w)
+	}
+
+	w := termWidth()
+	atomic.StoreInt32(&cachedWidth, int32(w))
+	return w
+}
+
+// ResetTermWidth clear the cached terminal width, will re-fetch it on next call TermWidth()
+func ResetTermWidth() {
+	atomic.StoreInt32(&cachedWidth, 0)
+}
+
+func termWidth() int {
+	if w, _, err := TermSize(); err == nil && w > 0 {
+		return w
+	}
+
+	if w, err := strconv.Atoi(os.Getenv("COLUMNS")); err == nil && w > 0 {
+		return w
+	}
+	return DefaultTermWidth
+}
+
+// OnResize call the fn on the terminal resized, returns a func for stop watching.
+// the cached width of the TermWidth() will be refreshed before call the fn.
+//
+// Usage:
+// 	stop := cliutil.OnResize(func(w, h int) {
+// 		// re-render ...
+// 	})
+// 	defer stop()
+func OnResize(fn func(w, h int)) (stop func()) {
+	return watchResize(func() {
+		ResetTermWidth()
+		w, h, err := TermSize()
+		if err != nil {
+			w, h = TermWidth(), DefaultTermHeight
+		}
+		fn(w, h)
+	})
+}
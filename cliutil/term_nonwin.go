@@ -0,0 +1,37 @@
+//go:build !windows
+// +build !windows
+
+package cliutil
+
+import (
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+)
+
+// watchResize by the SIGWINCH signal
+func watchResize(onResize func()) (stop func()) {
+	sigCh := make(chan os.Signal, 1)
+	done := make(chan struct{})
+	signal.Notify(sigCh, syscall.SIGWINCH)
+
+	go func() {
+		for {
+			select {
+			case <-sigCh:
+				onResize()
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			signal.Stop(sigCh)
+			close(done)
+		})
+	}
+}
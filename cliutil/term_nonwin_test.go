@@ -0,0 +1,32 @@
+//go:build !windows
+// +build !windows
+
+package cliutil_test
+
+import (
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/gookit/goutil/cliutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOnResize(t *testing.T) {
+	called := make(chan int, 1)
+	stop := cliutil.OnResize(func(w, h int) {
+		called <- w
+	})
+	defer stop()
+
+	assert.NoError(t, syscall.Kill(syscall.Getpid(), syscall.SIGWINCH))
+	select {
+	case w := <-called:
+		assert.True(t, w > 0)
+	case <-time.After(time.Second):
+		t.Fatal("the resize callback not called")
+	}
+
+	stop()
+	stop()
+}
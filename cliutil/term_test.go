@@ -0,0 +1,30 @@
+package cliutil_test
+
+import (
+	"testing"
+
+	"github.com/gookit/goutil/cliutil"
+	"github.com/gookit/goutil/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTermWidth(t *testing.T) {
+	if _, _, err := cliutil.TermSize(); err == nil {
+		t.Skip("skip on run in terminal")
+	}
+
+	cliutil.ResetTermWidth()
+	defer cliutil.ResetTermWidth()
+
+	testutil.MockEnvValue("COLUMNS", "120", func(_ string) {
+		assert.Equal(t, 120, cliutil.TermWidth())
+	})
+
+	// cached
+	assert.Equal(t, 120, cliutil.TermWidth())
+
+	cliutil.ResetTermWidth()
+	testutil.MockEnvValue("COLUMNS", "", func(_ string) {
+		assert.Equal(t, cliutil.DefaultTermWidth, cliutil.TermWidth())
+	})
+}
@@ -0,0 +1,42 @@
+//go:build windows
+// +build windows
+
+package cliutil
+
+import (
+	"sync"
+	"time"
+)
+
+// ResizePollInterval the interval for check terminal size on windows, it has no SIGWINCH signal.
+var ResizePollInterval = 500 * time.Millisecond
+
+// watchResize by polling the terminal size
+func watchResize(onResize func()) (stop func()) {
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(ResizePollInterval)
+		defer ticker.Stop()
+
+		lastW, lastH, _ := TermSize()
+		for {
+			select {
+			case <-ticker.C:
+				w, h, err := TermSize()
+				if err == nil && (w != lastW || h != lastH) {
+					lastW, lastH = w, h
+					onResize()
+				}
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			close(done)
+		})
+	}
+}
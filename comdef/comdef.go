@@ -0,0 +1,2 @@
+// Package comdef provide some common type or constraint definitions
+package comdef
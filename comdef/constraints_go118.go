@@ -0,0 +1,39 @@
+//go:build go1.18
+// +build go1.18
+
+package comdef
+
+// Int interface type
+type Int interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64
+}
+
+// Uint interface type
+type Uint interface {
+	~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Float interface type
+type Float interface {
+	~float32 | ~float64
+}
+
+// Xint interface type. all int and uint types
+type Xint interface {
+	Int | Uint
+}
+
+// IntOrFloat interface type. all int and float types
+type IntOrFloat interface {
+	Int | Float
+}
+
+// XintOrFloat interface type. all int, uint and float types
+type XintOrFloat interface {
+	Int | Uint | Float
+}
+
+// Ordered interface type. that supports the operators < <= >= >
+type Ordered interface {
+	Int | Uint | Float | ~string
+}
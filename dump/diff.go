@@ -0,0 +1,241 @@
+package dump
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Diff print the differences of two values, only the differing fields/elements will be printed.
+//
+// Usage:
+// 	dump.Diff(oldUser, newUser)
+//
+// Output like:
+// 	Name:
+// 	  - string("inhere"), #len=6
+// 	  + string("tom"), #len=3
+// 	Tags[2]:
+// 	  - string("go"), #len=2
+func Diff(a, b interface{}) {
+	std.Diff(a, b)
+}
+
+// FprintDiff print the differences of two values to the writer.
+func FprintDiff(w io.Writer, a, b interface{}) {
+	std.FprintDiff(w, a, b)
+}
+
+// DiffString get the differences of two values as string, without color.
+// will return empty string on not differences.
+func DiffString(a, b interface{}) string {
+	buf := &bytes.Buffer{}
+	d := NewWithOptions(func(opts *Options) {
+		opts.Output = buf
+		opts.NoColor = true
+		opts.ShowFlag = Fnopos
+	})
+
+	d.Diff(a, b)
+	return buf.String()
+}
+
+// Diff print the differences of two values to the Output.
+func (d *Dumper) Diff(a, b interface{}) {
+	df := &differ{visited: make(map[[2]uintptr]bool)}
+	df.diff("", reflect.ValueOf(a), reflect.ValueOf(b))
+
+	// reset some settings.
+	d.curDepth = 0
+	d.initTheme()
+
+	for _, it := range df.items {
+		path := it.path
+		if path == "" {
+			path = "(root)"
+		}
+
+		d.print(d.theme.field(strings.TrimPrefix(path, ".")), ":\n")
+		if it.a.IsValid() || !it.b.IsValid() {
+			d.printDiffLine("-", it.a)
+		}
+		if it.b.IsValid() || !it.a.IsValid() {
+			d.printDiffLine("+", it.b)
+		}
+	}
+}
+
+// FprintDiff print the differences of two values to the writer.
+func (d *Dumper) FprintDiff(w io.Writer, a, b interface{}) {
+	backup := d.Output // backup
+
+	d.Output = w
+	d.Diff(a, b)
+	d.Output = backup // restore
+}
+
+func (d *Dumper) printDiffLine(mark string, v reflect.Value) {
+	s := strings.TrimSuffix(d.renderValue(v), "\n")
+	s = strings.Replace(s, "\n", "\n    ", -1)
+
+	if mark == "-" {
+		d.print(d.theme.diffDel("  - "+s), "\n")
+	} else {
+		d.print(d.theme.diffAdd("  + "+s), "\n")
+	}
+}
+
+// renderValue render value as string by a sub dumper, without color.
+func (d *Dumper) renderValue(v reflect.Value) string {
+	if !v.IsValid() {
+		return "<nil>"
+	}
+
+	buf := &bytes.Buffer{}
+	sub := NewDumper(buf, 0)
+	*sub.Options = *d.Options
+	sub.Output = buf
+	sub.NoColor = true
+	sub.initTheme()
+
+	if v.CanInterface() {
+		sub.printOne(v.Interface())
+	} else {
+		sub.printRValue(v.Type(), v)
+	}
+	return buf.String()
+}
+
+type diffItem struct {
+	path string
+	// invalid value means not exists
+	a, b reflect.Value
+}
+
+// differ compare two values by reflect
+type differ struct {
+	items []diffItem
+	// visited pointer pairs, for avoid cyclic reference
+	visited map[[2]uintptr]bool
+}
+
+func (df *differ) add(path string, a, b reflect.Value) {
+	df.items = append(df.items, diffItem{path: path, a: a, b: b})
+}
+
+func (df *differ) diff(path string, a, b reflect.Value) {
+	if !a.IsValid() || !b.IsValid() {
+		if a.IsValid() != b.IsValid() {
+			df.add(path, a, b)
+		}
+		return
+	}
+
+	if a.Type() != b.Type() {
+		df.add(path, a, b)
+		return
+	}
+
+	switch a.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if a.IsNil() || b.IsNil() {
+			if a.IsNil() != b.IsNil() {
+				df.add(path, a, b)
+			}
+			return
+		}
+
+		if a.Kind() == reflect.Ptr {
+			key := [2]uintptr{a.Pointer(), b.Pointer()}
+			if key[0] == key[1] || df.visited[key] {
+				return
+			}
+			df.visited[key] = true
+		}
+		df.diff(path, a.Elem(), b.Elem())
+	case reflect.Struct:
+		t := a.Type()
+		for i := 0; i < a.NumField(); i++ {
+			df.diff(path+"."+t.Field(i).Name, a.Field(i), b.Field(i))
+		}
+	case reflect.Slice, reflect.Array:
+		if a.Kind() == reflect.Slice && a.IsNil() != b.IsNil() {
+			df.add(path, a, b)
+			return
+		}
+
+		maxLen := a.Len()
+		if b.Len() > maxLen {
+			maxLen = b.Len()
+		}
+
+		for i := 0; i < maxLen; i++ {
+			var av, bv reflect.Value
+			if i < a.Len() {
+				av = a.Index(i)
+			}
+			if i < b.Len() {
+				bv = b.Index(i)
+			}
+			df.diff(path+"["+strconv.Itoa(i)+"]", av, bv)
+		}
+	case reflect.Map:
+		if a.IsNil() != b.IsNil() {
+			df.add(path, a, b)
+			return
+		}
+
+		for _, key := range sortedMapKeys(a, b) {
+			keyStr := fmt.Sprintf("%#v", key)
+			if key.CanInterface() {
+				keyStr = fmt.Sprintf("%#v", key.Interface())
+			}
+			df.diff(path+"["+keyStr+"]", a.MapIndex(key), b.MapIndex(key))
+		}
+	default:
+		if !scalarEqual(a, b) {
+			df.add(path, a, b)
+		}
+	}
+}
+
+// sortedMapKeys get the union keys of two maps, sorted by sortMapKeys().
+func sortedMapKeys(a, b reflect.Value) []reflect.Value {
+	keys := a.MapKeys()
+	for _, key := range b.MapKeys() {
+		if !a.MapIndex(key).IsValid() {
+			keys = append(keys, key)
+		}
+	}
+
+	sortMapKeys(keys)
+	return keys
+}
+
+// scalarEqual compare two basic values, support unexported fields.
+func scalarEqual(a, b reflect.Value) bool {
+	switch a.Kind() {
+	case reflect.Bool:
+		return a.Bool() == b.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return a.Int() == b.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return a.Uint() == b.Uint()
+	case reflect.Float32, reflect.Float64:
+		return a.Float() == b.Float()
+	case reflect.Complex64, reflect.Complex128:
+		return a.Complex() == b.Complex()
+	case reflect.String:
+		return a.String() == b.String()
+	case reflect.Chan, reflect.Func, reflect.UnsafePointer:
+		return a.Pointer() == b.Pointer()
+	}
+
+	if a.CanInterface() && b.CanInterface() {
+		return reflect.DeepEqual(a.Interface(), b.Interface())
+	}
+	return true
+}
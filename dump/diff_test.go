@@ -0,0 +1,67 @@
+package dump
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/gookit/color"
+	"github.com/stretchr/testify/assert"
+)
+
+type diffUser struct {
+	Name  string
+	Tags  []string
+	Extra map[string]interface{}
+	Next  *diffUser
+	age   int
+}
+
+func TestDiffString(t *testing.T) {
+	a := &diffUser{
+		Name:  "inhere",
+		Tags:  []string{"go", "php", "java"},
+		Extra: map[string]interface{}{"k1": 1, "k2": "v2"},
+		age:   22,
+	}
+	b := &diffUser{
+		Name:  "tom",
+		Tags:  []string{"go", "php"},
+		Extra: map[string]interface{}{"k1": 1, "k3": true},
+		Next:  &diffUser{Name: "sub"},
+		age:   23,
+	}
+
+	s := DiffString(a, b)
+	assert.Contains(t, s, "Name:\n  - string(\"inhere\"), #len=6\n  + string(\"tom\"), #len=3\n")
+	assert.Contains(t, s, "Tags[2]:\n  - string(\"java\"), #len=4\n")
+	assert.NotContains(t, s, "Tags[0]")
+	assert.NotContains(t, s, "k1")
+	assert.Contains(t, s, "Extra[\"k2\"]:\n  - string(\"v2\"), #len=2\n")
+	assert.Contains(t, s, "Extra[\"k3\"]:\n  + bool(true),\n")
+	assert.Contains(t, s, "Next:\n  - *dump.diffUser<nil>,\n  + &dump.diffUser {\n")
+	assert.Contains(t, s, "age:\n  - int(22),\n  + int(23),\n")
+
+	// equals
+	assert.Equal(t, "", DiffString(a, a))
+	assert.Equal(t, "", DiffString([]int{1, 2}, []int{1, 2}))
+
+	// type mismatch
+	s = DiffString(1, "1")
+	assert.Equal(t, "(root):\n  - int(1),\n  + string(\"1\"), #len=1\n", s)
+
+	// cyclic reference
+	c1 := &diffUser{Name: "c"}
+	c1.Next = c1
+	c2 := &diffUser{Name: "c"}
+	c2.Next = c2
+	assert.Equal(t, "", DiffString(c1, c2))
+}
+
+func TestDumper_Diff(t *testing.T) {
+	buf := new(bytes.Buffer)
+	d := newBufDumper(buf)
+
+	d.Diff([]int{1, 2}, []int{1, 3})
+	s := buf.String()
+	assert.Equal(t, "[1]:\n  - int(2),\n  + int(3),\n", color.ClearCode(s))
+}
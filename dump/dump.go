@@ -7,6 +7,7 @@ import (
 	"os"
 
 	"github.com/gookit/color"
+	"github.com/mattn/go-isatty"
 )
 
 // These flags define which print caller information
@@ -22,17 +23,7 @@ var (
 	// valid flag for print caller info
 	callerFlags = []int{Ffunc, Ffile, Ffname, Fline}
 	// default theme
-	defaultTheme = Theme{
-		"caller": "magenta",
-		"field":  "green", // field name color of the map, struct.
-		"value":  "normal",
-		// special type
-		"msType":  "green", // for keywords map, struct type
-		"lenTip":  "gray",  // tips comments for string, slice, map len
-		"string":  "green",
-		"integer": "lightBlue",
-	}
-
+	defaultTheme = DarkTheme
 	// std dumper
 	std = NewDumper(os.Stdout, 3)
 	// no location dumper.
@@ -42,25 +33,90 @@ var (
 	})
 )
 
-// Theme color code/tag map for dump
-type Theme map[string]string
+// Theme the color tags for dump elements. empty tag for no color.
+type Theme struct {
+	// Caller the caller info color
+	Caller string
+	// Field the field name color of the map, struct
+	Field string
+	// Value the value color
+	Value string
+	// MsType the type keywords color of the map, struct
+	MsType string
+	// LenTip the tips comments color for string, slice, map len
+	LenTip string
+	// String the string value color
+	String string
+	// Integer the integer value color
+	Integer string
+	// DiffAdd the added lines color of the diff result
+	DiffAdd string
+	// DiffDel the deleted lines color of the diff result
+	DiffDel string
+}
 
-func (ct Theme) caller(s string) string  { return ct.wrap("caller", s) }
-func (ct Theme) field(s string) string   { return ct.wrap("field", s) }
-func (ct Theme) value(s string) string   { return ct.wrap("value", s) }
-func (ct Theme) msType(s string) string  { return ct.wrap("msType", s) }
-func (ct Theme) lenTip(s string) string  { return ct.wrap("lenTip", s) }
-func (ct Theme) string(s string) string  { return ct.wrap("string", s) }
-func (ct Theme) integer(s string) string { return ct.wrap("integer", s) }
+// built-in themes
+var (
+	// DarkTheme for the terminal with dark background. it is the default theme.
+	DarkTheme = &Theme{
+		Caller:  "magenta",
+		Field:   "green",
+		Value:   "normal",
+		MsType:  "green",
+		LenTip:  "gray",
+		String:  "green",
+		Integer: "lightBlue",
+		DiffAdd: "green",
+		DiffDel: "red",
+	}
+	// LightTheme for the terminal with light background.
+	LightTheme = &Theme{
+		Caller:  "magenta",
+		Field:   "blue",
+		Value:   "normal",
+		MsType:  "cyan",
+		LenTip:  "darkGray",
+		String:  "green",
+		Integer: "blue",
+		DiffAdd: "green",
+		DiffDel: "red",
+	}
+	// MonoTheme no color, only use bold for some elements.
+	MonoTheme = &Theme{
+		Caller: "bold",
+		Field:  "bold",
+		MsType: "bold",
+	}
+	// plain text, no any color tags
+	plainTheme = &Theme{}
+)
 
-// wrap color tag.
-func (ct Theme) wrap(key string, s string) string {
-	if tag := ct[key]; tag != "" {
+func (ct *Theme) caller(s string) string  { return wrapTag(s, ct.Caller) }
+func (ct *Theme) field(s string) string   { return wrapTag(s, ct.Field) }
+func (ct *Theme) value(s string) string   { return wrapTag(s, ct.Value) }
+func (ct *Theme) msType(s string) string  { return wrapTag(s, ct.MsType) }
+func (ct *Theme) lenTip(s string) string  { return wrapTag(s, ct.LenTip) }
+func (ct *Theme) string(s string) string  { return wrapTag(s, ct.String) }
+func (ct *Theme) integer(s string) string { return wrapTag(s, ct.Integer) }
+func (ct *Theme) diffAdd(s string) string { return wrapTag(s, ct.DiffAdd) }
+func (ct *Theme) diffDel(s string) string { return wrapTag(s, ct.DiffDel) }
+
+// wrapTag wrap color tag, return s on the tag is empty.
+func wrapTag(s, tag string) string {
+	if tag != "" {
 		return color.WrapTag(s, tag)
 	}
 	return s
 }
 
+// isTerminal check the writer is a terminal
+func isTerminal(w io.Writer) bool {
+	if f, ok := w.(*os.File); ok {
+		return isatty.IsTerminal(f.Fd()) || isatty.IsCygwinTerminal(f.Fd())
+	}
+	return false
+}
+
 // Std dumper
 func Std() *Dumper {
 	return std
@@ -101,6 +157,28 @@ func Fprint(w io.Writer, vs ...interface{}) {
 	std.Fprint(w, vs...)
 }
 
+// FprintNoLoc dump vars data to the writer, without location.
+func FprintNoLoc(w io.Writer, vs ...interface{}) {
+	std2.Fprint(w, vs...)
+}
+
+// ToString dump vars data to string, without location and color.
+// can be used for the fields of the logger. eg: zap, logrus
+//
+// Usage:
+// 	logger.Info("some message", zap.String("data", dump.ToString(data)))
+func ToString(vs ...interface{}) string {
+	w := &bytes.Buffer{}
+	d := NewWithOptions(func(opts *Options) {
+		opts.Output = w
+		opts.NoColor = true
+		opts.ShowFlag = Fnopos
+	})
+
+	d.Print(vs...)
+	return w.String()
+}
+
 // Format like fmt.Println, but the output is clearer and more beautiful
 func Format(vs ...interface{}) string {
 	w := &bytes.Buffer{}
@@ -342,3 +342,30 @@ func newBuffer() *bytes.Buffer {
 
 	return buf
 }
+
+func TestFprintVariants(t *testing.T) {
+	buf := new(bytes.Buffer)
+
+	Fprint(buf, "abc")
+	assert.Contains(t, buf.String(), "PRINT AT")
+	assert.Contains(t, buf.String(), `string("abc"), #len=3`)
+
+	buf.Reset()
+	FprintNoLoc(buf, 23)
+	assert.Equal(t, "int(23),\n", buf.String())
+
+	buf.Reset()
+	FprintDiff(buf, []int{1}, []int{2})
+	assert.Equal(t, "[0]:\n  - int(1),\n  + int(2),\n", buf.String())
+
+	buf.Reset()
+	d := NewDumper(nil, 2).WithOutput(buf)
+	d.ShowFlag = Fnopos
+	d.Print(true)
+	assert.Equal(t, "bool(true),\n", buf.String())
+}
+
+func TestToString(t *testing.T) {
+	s := ToString(map[string]int{"a": 1}, "abc")
+	assert.Equal(t, "map[string]int { #len=1\n  \"a\": int(1),\n},\nstring(\"abc\"), #len=3\n", s)
+}
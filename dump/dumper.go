@@ -7,6 +7,7 @@ import (
 	"path"
 	"reflect"
 	"runtime"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -28,14 +29,42 @@ type Options struct {
 	IndentChar byte
 	// MaxDepth for nested print
 	MaxDepth int
+	// MaxSliceLen max elements for print array/slice, 0 is no limit.
+	// the more elements will print as "... (N more)"
+	MaxSliceLen int
+	// MaxMapLen max elements for print map, 0 is no limit.
+	MaxMapLen int
+	// MaxStringLen max runes for print string, 0 is no limit.
+	MaxStringLen int
 	// ShowFlag for display caller position
 	ShowFlag int
 	// MoreLenNL array/slice elements length > MoreLenNL, will wrap new line
 	// MoreLenNL int
 	// CallerSkip skip for call runtime.Caller()
 	CallerSkip int
-	// ColorTheme for print result.
-	ColorTheme Theme
+	// ColorTheme for print result. see DarkTheme, LightTheme, MonoTheme
+	ColorTheme *Theme
+	// ForceColor force output with color, even if the Output is not a terminal.
+	//
+	// default will output plain text on the Output is not a terminal.
+	ForceColor bool
+	// Redact redact the sensitive struct fields and map keys, the value will print as "[REDACTED]".
+	//
+	// sensitive: struct field with tag `dump:"-"` or `sensitive:"true"`,
+	// or the field name/map key is matched one of RedactPatterns.
+	Redact bool
+	// RedactPatterns the name patterns for redact, match by case-insensitive contains.
+	// default is DefaultRedactPatterns
+	RedactPatterns []string
+	// NoCycleCheck disable check cyclic reference, the output will be limited by MaxDepth.
+	//
+	// default will print "&<cycle to #N>" on the pointer refer to a parent value,
+	// N is the depth of the parent value.
+	NoCycleCheck bool
+	// OutputFormat for print result. allow: console, json, yaml. default is console
+	//
+	// json, yaml will output machine-readable data, not contains caller position.
+	OutputFormat string
 }
 
 // printValue must keep track of already-printed pointer values to avoid
@@ -50,12 +79,17 @@ type Dumper struct {
 	*Options
 	// visited struct records
 	visited map[visit]int
+	// pointers on the current printing path. value is depth
+	ptrStack map[visit]int
 	// is value in the slice, map, struct. will not apply indent.
 	msValue bool
 	// current depth
 	curDepth int
 	// current indent string bytes
 	indentBytes []byte
+	// the theme and color mode for current dumping.
+	theme   *Theme
+	noColor bool
 	// prevDepth, nextDepth int
 	// indentStr, indentPrev, lineEnd string
 }
@@ -65,7 +99,8 @@ func NewDumper(out io.Writer, skip int) *Dumper {
 	return &Dumper{
 		Options: NewDefaultOptions(out, skip),
 		// init map
-		visited: make(map[visit]int),
+		visited:  make(map[visit]int),
+		ptrStack: make(map[visit]int),
 	}
 }
 
@@ -108,6 +143,18 @@ func (d *Dumper) WithoutColor() *Dumper {
 	return d
 }
 
+// WithOutput set the output writer for dumper
+func (d *Dumper) WithOutput(w io.Writer) *Dumper {
+	d.Output = w
+	return d
+}
+
+// WithTheme for dumper
+func (d *Dumper) WithTheme(theme *Theme) *Dumper {
+	d.ColorTheme = theme
+	return d
+}
+
 // WithOptions for dumper
 func (d *Dumper) WithOptions(fn func(opts *Options)) *Dumper {
 	fn(d.Options)
@@ -118,6 +165,7 @@ func (d *Dumper) WithOptions(fn func(opts *Options)) *Dumper {
 func (d *Dumper) ResetOptions() {
 	d.curDepth = 0
 	d.visited = make(map[visit]int)
+	d.ptrStack = make(map[visit]int)
 	d.Options = NewDefaultOptions(os.Stdout, 2)
 }
 
@@ -150,12 +198,16 @@ func (d *Dumper) dump(vs ...interface{}) {
 	// reset some settings.
 	d.curDepth = 0
 	d.visited = make(map[visit]int)
+	d.ptrStack = make(map[visit]int)
 
-	// clear all theme settings.
-	if d.NoColor {
-		d.ColorTheme = make(Theme)
+	// output machine-readable data
+	if d.OutputFormat != "" && d.OutputFormat != OutputConsole {
+		writeFormatted(d.Output, d.OutputFormat, vs)
+		return
 	}
 
+	d.initTheme()
+
 	// show print position
 	if d.ShowFlag != Fnopos {
 		// get the print position
@@ -173,6 +225,19 @@ func (d *Dumper) dump(vs ...interface{}) {
 	}
 }
 
+// initTheme check color mode and init theme for dumping.
+func (d *Dumper) initTheme() {
+	d.noColor = d.NoColor || (!d.ForceColor && !isTerminal(d.Output))
+
+	if d.noColor {
+		d.theme = plainTheme
+	} else if d.ColorTheme == nil {
+		d.theme = defaultTheme
+	} else {
+		d.theme = d.ColorTheme
+	}
+}
+
 func (d *Dumper) printCaller(pc uintptr, file string, line int) {
 	// eg: github.com/gookit/goutil/dump.ExamplePrint
 	fnName := runtime.FuncForPC(pc).Name()
@@ -210,7 +275,7 @@ func (d *Dumper) printCaller(pc uintptr, file string, line int) {
 	}
 
 	text := strings.Join(nodes, "")
-	d.print(d.ColorTheme.caller(text), "\n")
+	d.print(d.theme.caller(text), "\n")
 }
 
 func (d *Dumper) advance(step int) {
@@ -238,12 +303,31 @@ func (d *Dumper) printRValue(t reflect.Type, v reflect.Value) {
 			return
 		}
 
+		if d.printByFormatter(t, v) {
+			return
+		}
+
+		if !d.NoCycleCheck {
+			vis := visit{v.Pointer(), t}
+			if depth, ok := d.ptrStack[vis]; ok {
+				d.printf("&<cycle to #%d>,\n", depth)
+				return
+			}
+
+			d.ptrStack[vis] = d.curDepth
+			defer delete(d.ptrStack, vis)
+		}
+
 		v = v.Elem()
 		t = t.Elem()
 		// add "*" prefix
 		d.indentPrint("&")
 	}
 
+	if d.printByFormatter(t, v) {
+		return
+	}
+
 	if !v.IsValid() {
 		d.indentPrint(t.String(), "<nil>, #invalid\n")
 	}
@@ -270,15 +354,15 @@ func (d *Dumper) printRValue(t reflect.Type, v reflect.Value) {
 		d.printf("%s(%v),\n", t.String(), v.Float())
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
 		intStr := strconv.FormatInt(v.Int(), 10)
-		intStr = d.ColorTheme.integer(intStr)
+		intStr = d.theme.integer(intStr)
 		d.printf("%s(%s),\n", t.String(), intStr)
 	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
 		intStr := strconv.FormatUint(v.Uint(), 10)
-		intStr = d.ColorTheme.integer(intStr)
+		intStr = d.theme.integer(intStr)
 		d.printf("%s(%s),\n", t.String(), intStr)
 	case reflect.String:
-		strVal := d.ColorTheme.string(v.String())
-		lenTip := d.ColorTheme.lenTip("#len=" + strconv.Itoa(v.Len()))
+		strVal := d.theme.string(d.truncString(v.String()))
+		lenTip := d.theme.lenTip("#len=" + strconv.Itoa(v.Len()))
 		d.printf("%s(\"%s\"), %s\n", t.String(), strVal, lenTip)
 	case reflect.Complex64, reflect.Complex128:
 		d.printf("%#v\n", v.Complex())
@@ -288,11 +372,17 @@ func (d *Dumper) printRValue(t reflect.Type, v reflect.Value) {
 		}
 
 		eleNum := v.Len()
-		lenTip := d.ColorTheme.lenTip("#len=" + strconv.Itoa(eleNum))
+		lenTip := d.theme.lenTip("#len=" + strconv.Itoa(eleNum))
 
 		d.indentPrint(t.String(), " [ ", lenTip, "\n")
 		d.msValue = false
-		for i := 0; i < eleNum; i++ {
+
+		showNum := eleNum
+		if d.MaxSliceLen > 0 && eleNum > d.MaxSliceLen {
+			showNum = d.MaxSliceLen
+		}
+
+		for i := 0; i < showNum; i++ {
 			sv := v.Index(i)
 			d.advance(1)
 
@@ -304,13 +394,14 @@ func (d *Dumper) printRValue(t reflect.Type, v reflect.Value) {
 			d.advance(-1)
 		}
 
+		d.printMore(eleNum - showNum)
 		d.indentPrint("],\n")
 	case reflect.Struct:
 		if v.CanAddr() && !d.checkCyclicRef(t, v) {
 			break // don't print v again
 		}
 
-		d.indentPrint(d.ColorTheme.msType(t.String()), " {\n")
+		d.indentPrint(d.theme.msType(t.String()), " {\n")
 		d.msValue = false
 
 		fldNum := v.NumField()
@@ -319,7 +410,13 @@ func (d *Dumper) printRValue(t reflect.Type, v reflect.Value) {
 			d.advance(1)
 
 			fName := t.Field(i).Name
-			d.indentPrint(d.ColorTheme.field(fName), ": ")
+			d.indentPrint(d.theme.field(fName), ": ")
+
+			if d.Redact && d.isSensitiveField(t.Field(i)) {
+				d.printRedacted()
+				d.advance(-1)
+				continue
+			}
 
 			d.msValue = true
 			d.printRValue(fv.Type(), fv)
@@ -330,11 +427,19 @@ func (d *Dumper) printRValue(t reflect.Type, v reflect.Value) {
 
 		d.indentPrint("},\n")
 	case reflect.Map:
-		lenTip := d.ColorTheme.lenTip("#len=" + strconv.Itoa(v.Len()))
-		d.indentPrint(d.ColorTheme.msType(t.String()), " { ", lenTip, "\n")
+		lenTip := d.theme.lenTip("#len=" + strconv.Itoa(v.Len()))
+		d.indentPrint(d.theme.msType(t.String()), " { ", lenTip, "\n")
 		d.msValue = false
 
-		for _, key := range v.MapKeys() {
+		keys := v.MapKeys()
+		showNum := len(keys)
+		if d.MaxMapLen > 0 && showNum > d.MaxMapLen {
+			// sort keys for the shown elements are stable
+			sortMapKeys(keys)
+			showNum = d.MaxMapLen
+		}
+
+		for _, key := range keys[:showNum] {
 			mv := v.MapIndex(key)
 			d.advance(1)
 
@@ -346,6 +451,12 @@ func (d *Dumper) printRValue(t reflect.Type, v reflect.Value) {
 				d.printf("%#v: ", key.Interface())
 			}
 
+			if d.Redact && key.Kind() == reflect.String && d.isSensitiveName(key.String()) {
+				d.printRedacted()
+				d.advance(-1)
+				continue
+			}
+
 			if mv.CanAddr() && !d.checkCyclicRef(mv.Type(), mv) {
 				d.advance(-1)
 				continue // don't print mv again
@@ -359,6 +470,7 @@ func (d *Dumper) printRValue(t reflect.Type, v reflect.Value) {
 			d.advance(-1)
 		}
 
+		d.printMore(len(keys) - showNum)
 		d.indentPrint("},\n")
 	case reflect.Interface:
 		if v.CanAddr() && !d.checkCyclicRef(t, v) {
@@ -396,7 +508,47 @@ func (d *Dumper) printRValue(t reflect.Type, v reflect.Value) {
 	}
 }
 
+// printByFormatter print value by custom formatter, if has registered.
+func (d *Dumper) printByFormatter(t reflect.Type, v reflect.Value) bool {
+	// check CanInterface: the formatter maybe call v.Interface()
+	if !v.IsValid() || !v.CanInterface() {
+		return false
+	}
+
+	if fn := lookupFormatter(t); fn != nil {
+		d.printf("%s(%s),\n", t.String(), d.theme.value(fn(v)))
+		return true
+	}
+	return false
+}
+
+// truncString by the MaxStringLen
+func (d *Dumper) truncString(s string) string {
+	if d.MaxStringLen <= 0 || len(s) <= d.MaxStringLen {
+		return s
+	}
+
+	rs := []rune(s)
+	if more := len(rs) - d.MaxStringLen; more > 0 {
+		return string(rs[:d.MaxStringLen]) + "... (" + strconv.Itoa(more) + " more)"
+	}
+	return s
+}
+
+// printMore print the tips for omitted elements
+func (d *Dumper) printMore(more int) {
+	if more > 0 {
+		d.advance(1)
+		d.indentPrint(d.theme.lenTip("... ("+strconv.Itoa(more)+" more)"), "\n")
+		d.advance(-1)
+	}
+}
+
 func (d *Dumper) checkCyclicRef(t reflect.Type, v reflect.Value) (goon bool) {
+	if d.NoCycleCheck {
+		return true
+	}
+
 	addr := v.UnsafeAddr()
 	vis := visit{addr, t}
 
@@ -411,7 +563,7 @@ func (d *Dumper) checkCyclicRef(t reflect.Type, v reflect.Value) (goon bool) {
 }
 
 func (d *Dumper) print(v ...interface{}) {
-	if d.NoColor {
+	if d.noColor {
 		_, _ = fmt.Fprint(d.Output, v...)
 	} else {
 		color.Fprint(d.Output, v...)
@@ -423,7 +575,7 @@ func (d *Dumper) printf(f string, v ...interface{}) {
 		_, _ = d.Output.Write(d.indentBytes)
 	}
 
-	if d.NoColor {
+	if d.noColor {
 		_, _ = fmt.Fprintf(d.Output, f, v...)
 	} else {
 		color.Fprintf(d.Output, f, v...)
@@ -435,9 +587,29 @@ func (d *Dumper) indentPrint(v ...interface{}) {
 		_, _ = d.Output.Write(d.indentBytes)
 	}
 
-	if d.NoColor {
+	if d.noColor {
 		_, _ = fmt.Fprint(d.Output, v...)
 	} else {
 		color.Fprint(d.Output, v...)
 	}
 }
+
+// sortMapKeys sort the map keys, the number keys sort by value, others by string.
+func sortMapKeys(keys []reflect.Value) {
+	sort.Slice(keys, func(i, j int) bool {
+		a, b := keys[i], keys[j]
+		if a.Kind() == b.Kind() {
+			switch a.Kind() {
+			case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+				return a.Int() < b.Int()
+			case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+				return a.Uint() < b.Uint()
+			case reflect.Float32, reflect.Float64:
+				return a.Float() < b.Float()
+			case reflect.String:
+				return a.String() < b.String()
+			}
+		}
+		return fmt.Sprint(a) < fmt.Sprint(b)
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"reflect"
+	"strings"
 	"testing"
 	"unsafe"
 
@@ -408,3 +409,113 @@ func TestStruct_WithNested(t *testing.T) {
 	//  Github: string("https://github.com/inhere"),
 	// }
 }
+
+func TestDumper_MaxLimits(t *testing.T) {
+	buf := new(bytes.Buffer)
+	dumper := newBufDumper(buf)
+	dumper.WithoutColor()
+	dumper.WithOptions(func(opts *Options) {
+		opts.ShowFlag = Fnopos
+		opts.MaxSliceLen = 3
+		opts.MaxMapLen = 1
+		opts.MaxStringLen = 5
+	})
+
+	dumper.Print(ints2)
+	str := buf.String()
+	buf.Reset()
+	assert.Contains(t, str, "[]int [ #len=11")
+	assert.Contains(t, str, "int(3),\n  ... (8 more)\n]")
+	assert.NotContains(t, str, "int(4)")
+
+	dumper.Print(map[string]int{"a": 1, "b": 2, "c": 3})
+	str = buf.String()
+	buf.Reset()
+	assert.Contains(t, str, "#len=3")
+	assert.Contains(t, str, "... (2 more)")
+
+	// the shown keys are sorted
+	for i := 0; i < 5; i++ {
+		dumper.Print(map[int]string{10: "c", 2: "b", 1: "a"})
+		str = buf.String()
+		buf.Reset()
+		assert.Contains(t, str, "1: string(\"a\")")
+		assert.NotContains(t, str, "string(\"b\")")
+		assert.NotContains(t, str, "string(\"c\")")
+	}
+
+	dumper.Print("abcdefghi", "中文字符串测试")
+	str = buf.String()
+	buf.Reset()
+	assert.Contains(t, str, `string("abcde... (4 more)"), #len=9`)
+	assert.Contains(t, str, `string("中文字符串... (2 more)")`)
+}
+
+type treeNode struct {
+	Name     string
+	Parent   *treeNode
+	Children []*treeNode
+}
+
+func TestDumper_CycleCheck(t *testing.T) {
+	root := &treeNode{Name: "root"}
+	child := &treeNode{Name: "child", Parent: root}
+	root.Children = append(root.Children, child)
+
+	buf := new(bytes.Buffer)
+	dumper := newBufDumper(buf)
+	dumper.WithoutColor()
+	dumper.WithOptions(func(opts *Options) {
+		opts.ShowFlag = Fnopos
+		opts.MaxDepth = 50
+	})
+
+	dumper.Print(root)
+	str := buf.String()
+	buf.Reset()
+	assert.Contains(t, str, `Name: string("child")`)
+	assert.Contains(t, str, "Parent: &<cycle to #0>,\n")
+	assert.Equal(t, 1, strings.Count(str, `string("root")`))
+
+	// disable check
+	dumper.NoCycleCheck = true
+	dumper.MaxDepth = 6
+	dumper.Print(root)
+	str = buf.String()
+	assert.NotContains(t, str, "<cycle to")
+	assert.True(t, strings.Count(str, `string("root")`) > 1)
+}
+
+func TestDumper_Theme(t *testing.T) {
+	buf := new(bytes.Buffer)
+	dumper := newBufDumper(buf)
+	dumper.ShowFlag = Fnopos
+
+	// output is not a terminal, will output plain text
+	dumper.Print(user)
+	plain := buf.String()
+	buf.Reset()
+	assert.Equal(t, plain, color.ClearCode(plain))
+	assert.Contains(t, plain, `Name: string("inhere"), #len=6`)
+
+	dumper.ForceColor = true
+	dumper.WithTheme(LightTheme).Print(user)
+	str := buf.String()
+	buf.Reset()
+	assert.Equal(t, plain, color.ClearCode(str))
+	if color.SupportColor() {
+		assert.NotEqual(t, plain, str)
+		assert.Contains(t, str, color.RenderCode("0;34", "Name"))
+	}
+
+	dumper.WithTheme(MonoTheme).Print(user)
+	str = buf.String()
+	buf.Reset()
+	assert.Equal(t, plain, color.ClearCode(str))
+
+	// NoColor has higher priority
+	dumper.NoColor = true
+	dumper.Print(user)
+	assert.Equal(t, plain, buf.String())
+	assert.NotEmpty(t, DarkTheme.Field)
+}
@@ -0,0 +1,212 @@
+package dump
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// output formats for the Options.OutputFormat
+const (
+	OutputConsole = "console"
+	OutputJSON    = "json"
+	OutputYAML    = "yaml"
+)
+
+// FormatAs format the value to machine-readable string. allow format: json, yaml
+//
+// Usage:
+// 	s, err := dump.FormatAs(v, dump.OutputJSON)
+func FormatAs(v interface{}, format string) (string, error) {
+	switch strings.ToLower(format) {
+	case OutputJSON:
+		bs, err := json.MarshalIndent(v, "", "  ")
+		return string(bs), err
+	case OutputYAML, "yml":
+		return toYAML(v)
+	}
+	return "", errors.New("dump: unsupported output format " + format)
+}
+
+// yamlItem an ordered map item
+type yamlItem struct {
+	key string
+	val interface{}
+}
+
+// yamlMap ordered map, keep the order of struct fields.
+type yamlMap []yamlItem
+
+func toYAML(v interface{}) (string, error) {
+	bs, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(bs))
+	dec.UseNumber()
+
+	node, err := decodeOrdered(dec)
+	if err != nil {
+		return "", err
+	}
+
+	buf := &bytes.Buffer{}
+	writeYAML(buf, node, 0)
+	return strings.TrimRight(buf.String(), "\n"), nil
+}
+
+// decodeOrdered decode JSON data, will keep the order of object keys.
+func decodeOrdered(dec *json.Decoder) (interface{}, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	switch tok {
+	case json.Delim('{'):
+		mp := yamlMap{}
+		for dec.More() {
+			key, err := dec.Token()
+			if err != nil {
+				return nil, err
+			}
+
+			val, err := decodeOrdered(dec)
+			if err != nil {
+				return nil, err
+			}
+			mp = append(mp, yamlItem{key: key.(string), val: val})
+		}
+		_, err = dec.Token() // read '}'
+		return mp, err
+	case json.Delim('['):
+		list := make([]interface{}, 0)
+		for dec.More() {
+			val, err := decodeOrdered(dec)
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, val)
+		}
+		_, err = dec.Token() // read ']'
+		return list, err
+	}
+	return tok, nil
+}
+
+func writeYAML(w *bytes.Buffer, node interface{}, indent int) {
+	pad := strings.Repeat(" ", indent)
+
+	switch typVal := node.(type) {
+	case yamlMap:
+		if len(typVal) == 0 {
+			w.WriteString(pad + "{}\n")
+			return
+		}
+
+		for _, item := range typVal {
+			w.WriteString(pad + yamlString(item.key) + ":")
+			writeYAMLChild(w, item.val, indent+2)
+		}
+	case []interface{}:
+		if len(typVal) == 0 {
+			w.WriteString(pad + "[]\n")
+			return
+		}
+
+		for _, elem := range typVal {
+			if !isYAMLScalar(elem) && !isYAMLEmpty(elem) {
+				// render child, then replace the leading indent to "- "
+				sub := &bytes.Buffer{}
+				writeYAML(sub, elem, indent+2)
+				w.WriteString(pad + "- " + sub.String()[indent+2:])
+				continue
+			}
+
+			w.WriteString(pad + "-")
+			writeYAMLChild(w, elem, indent+2)
+		}
+	default:
+		w.WriteString(pad + yamlScalar(node) + "\n")
+	}
+}
+
+func writeYAMLChild(w *bytes.Buffer, val interface{}, indent int) {
+	if isYAMLScalar(val) {
+		w.WriteString(" " + yamlScalar(val) + "\n")
+	} else if isYAMLEmpty(val) {
+		w.WriteByte(' ')
+		writeYAML(w, val, 0)
+	} else {
+		w.WriteByte('\n')
+		writeYAML(w, val, indent)
+	}
+}
+
+func isYAMLScalar(val interface{}) bool {
+	switch val.(type) {
+	case yamlMap, []interface{}:
+		return false
+	}
+	return true
+}
+
+func isYAMLEmpty(val interface{}) bool {
+	switch typVal := val.(type) {
+	case yamlMap:
+		return len(typVal) == 0
+	case []interface{}:
+		return len(typVal) == 0
+	}
+	return false
+}
+
+func yamlScalar(val interface{}) string {
+	switch typVal := val.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return strconv.FormatBool(typVal)
+	case json.Number:
+		return typVal.String()
+	case string:
+		return yamlString(typVal)
+	}
+	return ""
+}
+
+// yamlString quote the string if need.
+func yamlString(s string) string {
+	if s == "" || strings.TrimSpace(s) != s || strings.ContainsAny(s, ":#\n\"'\\\t") {
+		return strconv.Quote(s)
+	}
+
+	switch strings.ToLower(s) {
+	case "null", "~", "true", "false", "yes", "no", "on", "off":
+		return strconv.Quote(s)
+	}
+
+	if strings.ContainsRune("-?[]{},&*!|>%@`", rune(s[0])) {
+		return strconv.Quote(s)
+	}
+
+	if _, err := strconv.ParseFloat(s, 64); err == nil {
+		return strconv.Quote(s)
+	}
+	return s
+}
+
+// write values to the writer by the output format.
+func writeFormatted(w io.Writer, format string, vs []interface{}) {
+	for _, v := range vs {
+		s, err := FormatAs(v, format)
+		if err != nil {
+			s = "ERROR: " + err.Error()
+		}
+		_, _ = io.WriteString(w, s+"\n")
+	}
+}
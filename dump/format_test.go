@@ -0,0 +1,72 @@
+package dump
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type formatUser struct {
+	Name  string            `json:"name"`
+	Age   int               `json:"age"`
+	Tags  []string          `json:"tags"`
+	Extra map[string]string `json:"extra"`
+	Subs  []formatUser      `json:"subs,omitempty"`
+}
+
+func TestFormatAs(t *testing.T) {
+	u := formatUser{
+		Name:  "inhere",
+		Age:   22,
+		Tags:  []string{"go", "true", ""},
+		Extra: map[string]string{},
+		Subs:  []formatUser{{Name: "tom: cat", Tags: nil}},
+	}
+
+	s, err := FormatAs(u, OutputJSON)
+	assert.NoError(t, err)
+	assert.Contains(t, s, `"name": "inhere",`)
+
+	s, err = FormatAs(u, OutputYAML)
+	assert.NoError(t, err)
+	assert.Equal(t, `name: inhere
+age: 22
+tags:
+  - go
+  - "true"
+  - ""
+extra: {}
+subs:
+  - name: "tom: cat"
+    age: 0
+    tags: null
+    extra: null`, s)
+
+	s, err = FormatAs([]interface{}{1, []int{2, 3}, nil}, "yml")
+	assert.NoError(t, err)
+	assert.Equal(t, "- 1\n- - 2\n  - 3\n- null", s)
+
+	_, err = FormatAs(u, "xml")
+	assert.Error(t, err)
+
+	_, err = FormatAs(make(chan int), OutputJSON)
+	assert.Error(t, err)
+}
+
+func TestDumper_OutputFormat(t *testing.T) {
+	buf := new(bytes.Buffer)
+	d := NewWithOptions(func(opts *Options) {
+		opts.Output = buf
+		opts.OutputFormat = OutputJSON
+	})
+
+	d.Print(map[string]int{"a": 1}, "abc")
+	assert.Equal(t, "{\n  \"a\": 1\n}\n\"abc\"\n", buf.String())
+	assert.NotContains(t, buf.String(), "PRINT AT")
+
+	buf.Reset()
+	d.OutputFormat = OutputYAML
+	d.Print(map[string]int{"a": 1})
+	assert.Equal(t, "a: 1\n", buf.String())
+}
@@ -0,0 +1,45 @@
+package dump
+
+import (
+	"reflect"
+	"sync"
+)
+
+// FormatterFunc custom format the value to string for dump
+type FormatterFunc func(v reflect.Value) string
+
+var (
+	fmtLock sync.RWMutex
+	// custom type formatters
+	formatters = map[reflect.Type]FormatterFunc{}
+)
+
+// RegisterFormatter register custom formatter for the type.
+// the registered formatter will also be used for the pointer of the type.
+//
+// Usage:
+// 	dump.RegisterFormatter(reflect.TypeOf(time.Time{}), func(v reflect.Value) string {
+// 		return v.Interface().(time.Time).Format(time.RFC3339)
+// 	})
+// 	// will print: time.Time(2022-06-18T10:20:30+08:00),
+func RegisterFormatter(typ reflect.Type, fn FormatterFunc) {
+	fmtLock.Lock()
+	defer fmtLock.Unlock()
+
+	if fn == nil {
+		delete(formatters, typ)
+	} else {
+		formatters[typ] = fn
+	}
+}
+
+// RemoveFormatter remove the custom formatter for the type
+func RemoveFormatter(typ reflect.Type) {
+	RegisterFormatter(typ, nil)
+}
+
+func lookupFormatter(typ reflect.Type) FormatterFunc {
+	fmtLock.RLock()
+	defer fmtLock.RUnlock()
+	return formatters[typ]
+}
@@ -0,0 +1,58 @@
+package dump
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegisterFormatter(t *testing.T) {
+	timeType := reflect.TypeOf(time.Time{})
+	RegisterFormatter(timeType, func(v reflect.Value) string {
+		return v.Interface().(time.Time).Format("2006-01-02")
+	})
+	defer RemoveFormatter(timeType)
+
+	buf := new(bytes.Buffer)
+	dumper := newBufDumper(buf)
+	dumper.WithoutColor()
+	dumper.ShowFlag = Fnopos
+
+	tt := time.Date(2022, 6, 18, 10, 20, 30, 0, time.UTC)
+	dumper.Print(tt, &tt, struct{ At time.Time }{tt})
+
+	str := buf.String()
+	assert.Contains(t, str, "time.Time(2022-06-18),\n")
+	assert.Contains(t, str, "&time.Time(2022-06-18),\n")
+	assert.Contains(t, str, "At: time.Time(2022-06-18),\n")
+	assert.NotContains(t, str, "wall")
+
+	// remove
+	RemoveFormatter(timeType)
+	buf.Reset()
+	dumper.Print(tt)
+	assert.Contains(t, buf.String(), "wall")
+}
+
+func TestRegisterFormatter_unexported(t *testing.T) {
+	timeType := reflect.TypeOf(time.Time{})
+	RegisterFormatter(timeType, func(v reflect.Value) string {
+		return v.Interface().(time.Time).Format("2006-01-02")
+	})
+	defer RemoveFormatter(timeType)
+
+	buf := new(bytes.Buffer)
+	dumper := newBufDumper(buf)
+	dumper.WithoutColor()
+	dumper.ShowFlag = Fnopos
+
+	tt := time.Date(2022, 6, 18, 10, 20, 30, 0, time.UTC)
+	assert.NotPanics(t, func() {
+		dumper.Print(struct{ at time.Time }{tt})
+	})
+	assert.Contains(t, buf.String(), "at: time.Time")
+	assert.NotContains(t, buf.String(), "2022-06-18")
+}
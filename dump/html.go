@@ -0,0 +1,223 @@
+package dump
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"io"
+	"reflect"
+	"strconv"
+)
+
+// HTMLStyle the default CSS style for HTML dump
+var HTMLStyle = `<style>
+.go-dump{font-family:Menlo,Monaco,Consolas,monospace;font-size:13px;line-height:1.5;background:#282c34;color:#abb2bf;padding:8px 12px;border-radius:4px;overflow:auto}
+.go-dump ul{list-style:none;margin:0;padding-left:18px}
+.go-dump summary{cursor:pointer}
+.go-dump .gd-type{color:#c678dd}
+.go-dump .gd-key{color:#e06c75}
+.go-dump .gd-str{color:#98c379}
+.go-dump .gd-num{color:#d19a66}
+.go-dump .gd-bool{color:#56b6c2}
+.go-dump .gd-nil,.go-dump .gd-tip{color:#7f848e}
+</style>
+`
+
+// HTML dump vars as collapsible and highlighted HTML. no any JS dependency.
+//
+// will apply the options of the std dumper, such as: MaxDepth, Max*Len, Redact.
+//
+// Usage:
+// 	http.HandleFunc("/debug/config", func(w http.ResponseWriter, r *http.Request) {
+// 		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+// 		dump.FprintHTML(w, config)
+// 	})
+func HTML(vs ...interface{}) string {
+	buf := &bytes.Buffer{}
+	FprintHTML(buf, vs...)
+	return buf.String()
+}
+
+// FprintHTML dump vars as HTML to the writer. see HTML()
+func FprintHTML(w io.Writer, vs ...interface{}) {
+	hd := &htmlDumper{
+		d:        std,
+		buf:      &bytes.Buffer{},
+		ptrStack: make(map[visit]bool),
+	}
+
+	hd.buf.WriteString(HTMLStyle)
+	for _, v := range vs {
+		hd.buf.WriteString(`<div class="go-dump">`)
+		hd.writeValue(reflect.ValueOf(v), 0)
+		hd.buf.WriteString("</div>\n")
+	}
+
+	_, _ = hd.buf.WriteTo(w)
+}
+
+// htmlDumper render value to HTML
+type htmlDumper struct {
+	// d for use the options. eg: MaxDepth, Redact
+	d   *Dumper
+	buf *bytes.Buffer
+	// pointers on the current rendering path.
+	ptrStack map[visit]bool
+}
+
+func (hd *htmlDumper) span(class, text string) {
+	hd.buf.WriteString(`<span class="` + class + `">` + html.EscapeString(text) + `</span>`)
+}
+
+func (hd *htmlDumper) writeValue(v reflect.Value, depth int) {
+	if !v.IsValid() {
+		hd.span("gd-nil", "nil")
+		return
+	}
+
+	t := v.Type()
+	if fn := lookupFormatter(t); fn != nil && v.CanInterface() {
+		hd.span("gd-type", t.String())
+		hd.buf.WriteString("(" + html.EscapeString(fn(v)) + ")")
+		return
+	}
+
+	if depth > hd.d.MaxDepth {
+		hd.span("gd-tip", t.String()+"(!OVER MAX DEPTH!)")
+		return
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			hd.span("gd-type", t.String())
+			hd.span("gd-nil", "(nil)")
+			return
+		}
+
+		vis := visit{v.Pointer(), t}
+		if hd.ptrStack[vis] {
+			hd.span("gd-tip", "&<cycle reference>")
+			return
+		}
+
+		hd.ptrStack[vis] = true
+		hd.buf.WriteString("&amp;")
+		hd.writeValue(v.Elem(), depth)
+		delete(hd.ptrStack, vis)
+	case reflect.Interface:
+		if v.IsNil() {
+			hd.span("gd-nil", "nil")
+			return
+		}
+		hd.writeValue(v.Elem(), depth)
+	case reflect.Bool:
+		hd.scalar(t, "gd-bool", strconv.FormatBool(v.Bool()))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		hd.scalar(t, "gd-num", strconv.FormatInt(v.Int(), 10))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		hd.scalar(t, "gd-num", strconv.FormatUint(v.Uint(), 10))
+	case reflect.Float32, reflect.Float64:
+		hd.scalar(t, "gd-num", fmt.Sprint(v.Float()))
+	case reflect.Complex64, reflect.Complex128:
+		hd.scalar(t, "gd-num", fmt.Sprint(v.Complex()))
+	case reflect.String:
+		hd.scalar(t, "gd-str", strconv.Quote(hd.d.truncString(v.String())))
+		hd.buf.WriteByte(' ')
+		hd.span("gd-tip", "#len="+strconv.Itoa(v.Len()))
+	case reflect.Slice, reflect.Array:
+		if t.Kind() == reflect.Slice && v.IsNil() {
+			hd.scalar(t, "gd-nil", "nil")
+			return
+		}
+
+		showNum := v.Len()
+		if hd.d.MaxSliceLen > 0 && showNum > hd.d.MaxSliceLen {
+			showNum = hd.d.MaxSliceLen
+		}
+
+		hd.openBlock(t, v.Len())
+		for i := 0; i < showNum; i++ {
+			hd.buf.WriteString("<li>")
+			hd.writeValue(v.Index(i), depth+1)
+			hd.buf.WriteString("</li>")
+		}
+		hd.writeMore(v.Len() - showNum)
+		hd.closeBlock()
+	case reflect.Map:
+		if v.IsNil() {
+			hd.scalar(t, "gd-nil", "nil")
+			return
+		}
+
+		keys := v.MapKeys()
+		sortMapKeys(keys)
+
+		showNum := len(keys)
+		if hd.d.MaxMapLen > 0 && showNum > hd.d.MaxMapLen {
+			showNum = hd.d.MaxMapLen
+		}
+
+		hd.openBlock(t, len(keys))
+		for _, key := range keys[:showNum] {
+			hd.buf.WriteString("<li>")
+			hd.span("gd-key", fmt.Sprintf("%#v", key))
+			hd.buf.WriteString(": ")
+			if hd.d.Redact && key.Kind() == reflect.String && hd.d.isSensitiveName(key.String()) {
+				hd.span("gd-tip", RedactedText)
+			} else {
+				hd.writeValue(v.MapIndex(key), depth+1)
+			}
+			hd.buf.WriteString("</li>")
+		}
+		hd.writeMore(len(keys) - showNum)
+		hd.closeBlock()
+	case reflect.Struct:
+		hd.openBlock(t, -1)
+		for i := 0; i < v.NumField(); i++ {
+			hd.buf.WriteString("<li>")
+			hd.span("gd-key", t.Field(i).Name)
+			hd.buf.WriteString(": ")
+			if hd.d.Redact && hd.d.isSensitiveField(t.Field(i)) {
+				hd.span("gd-tip", RedactedText)
+			} else {
+				hd.writeValue(v.Field(i), depth+1)
+			}
+			hd.buf.WriteString("</li>")
+		}
+		hd.closeBlock()
+	default: // chan, func, unsafe pointer
+		hd.span("gd-type", t.String())
+		hd.buf.WriteString(" {...}")
+	}
+}
+
+func (hd *htmlDumper) scalar(t reflect.Type, class, text string) {
+	hd.span("gd-type", t.String())
+	hd.buf.WriteByte('(')
+	hd.span(class, text)
+	hd.buf.WriteByte(')')
+}
+
+// openBlock start a collapsible block. length < 0 will not show length tips.
+func (hd *htmlDumper) openBlock(t reflect.Type, length int) {
+	hd.buf.WriteString("<details open><summary>")
+	hd.span("gd-type", t.String())
+	if length >= 0 {
+		hd.buf.WriteByte(' ')
+		hd.span("gd-tip", "#len="+strconv.Itoa(length))
+	}
+	hd.buf.WriteString("</summary><ul>")
+}
+
+func (hd *htmlDumper) writeMore(more int) {
+	if more > 0 {
+		hd.buf.WriteString("<li>")
+		hd.span("gd-tip", "... ("+strconv.Itoa(more)+" more)")
+		hd.buf.WriteString("</li>")
+	}
+}
+
+func (hd *htmlDumper) closeBlock() {
+	hd.buf.WriteString("</ul></details>")
+}
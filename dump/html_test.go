@@ -0,0 +1,73 @@
+package dump
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHTML(t *testing.T) {
+	type node struct {
+		Name  string
+		Attrs map[string]interface{}
+		Next  *node
+		tags  []string
+	}
+
+	n := &node{
+		Name:  "<root>",
+		Attrs: map[string]interface{}{"b": true, "a": 1.5},
+		tags:  []string{"x"},
+	}
+	n.Next = n
+
+	s := HTML(n, nil)
+	assert.True(t, strings.HasPrefix(s, "<style>"))
+	assert.Equal(t, 2, strings.Count(s, `<div class="go-dump">`))
+	assert.Contains(t, s, `<span class="gd-str">&#34;&lt;root&gt;&#34;</span>`)
+	assert.Contains(t, s, `<details open><summary><span class="gd-type">map[string]interface {}</span> <span class="gd-tip">#len=2</span></summary>`)
+	assert.Contains(t, s, `<span class="gd-key">&#34;a&#34;</span>: <span class="gd-type">float64</span>(<span class="gd-num">1.5</span>)`)
+	assert.Contains(t, s, `<span class="gd-bool">true</span>`)
+	assert.Contains(t, s, `&lt;cycle reference&gt;`)
+	assert.Contains(t, s, `<span class="gd-key">tags</span>`)
+	assert.Contains(t, s, `<div class="go-dump"><span class="gd-nil">nil</span></div>`)
+	assert.Less(t, strings.Index(s, "&#34;a&#34;"), strings.Index(s, "&#34;b&#34;"))
+	assert.NotContains(t, s, "<script")
+
+	buf := new(bytes.Buffer)
+	FprintHTML(buf, 23)
+	assert.Contains(t, buf.String(), `<span class="gd-type">int</span>(<span class="gd-num">23</span>)`)
+}
+
+func TestHTML_options(t *testing.T) {
+	bak := *std.Options
+	defer func() {
+		*std.Options = bak
+	}()
+
+	std.Redact = true
+	std.MaxSliceLen = 2
+	std.MaxMapLen = 1
+	std.MaxStringLen = 3
+
+	s := HTML(struct {
+		Password string
+		Name     string
+		List     []int
+		Meta     map[string]string
+	}{
+		Password: "pwd-value",
+		Name:     "inhere",
+		List:     []int{1, 2, 3},
+		Meta:     map[string]string{"token": "token-value"},
+	})
+
+	assert.NotContains(t, s, "pwd-value")
+	assert.NotContains(t, s, "token-value")
+	assert.Contains(t, s, RedactedText)
+	assert.Contains(t, s, "inh... (3 more)")
+	assert.Contains(t, s, "... (1 more)")
+	assert.NotContains(t, s, `<span class="gd-num">3</span>`)
+}
@@ -0,0 +1,45 @@
+package dump
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/gookit/goutil/internal/comfunc"
+)
+
+// RedactedText the replacement text for sensitive values
+const RedactedText = "[REDACTED]"
+
+// DefaultRedactPatterns default name patterns for redact sensitive fields
+var DefaultRedactPatterns = []string{"password", "passwd", "token", "secret"}
+
+func (d *Dumper) isSensitiveField(fd reflect.StructField) bool {
+	if tv, _ := comfunc.LookupTag(fd, "dump"); tv.Raw == "-" {
+		return true
+	}
+	if tv, _ := comfunc.LookupTag(fd, "sensitive"); tv.Name == "true" {
+		return true
+	}
+	return d.isSensitiveName(fd.Name)
+}
+
+func (d *Dumper) isSensitiveName(name string) bool {
+	patterns := d.RedactPatterns
+	if patterns == nil {
+		patterns = DefaultRedactPatterns
+	}
+
+	name = strings.ToLower(name)
+	for _, pattern := range patterns {
+		if strings.Contains(name, strings.ToLower(pattern)) {
+			return true
+		}
+	}
+	return false
+}
+
+func (d *Dumper) printRedacted() {
+	d.msValue = true
+	d.printf("%s,\n", d.theme.lenTip(RedactedText))
+	d.msValue = false
+}
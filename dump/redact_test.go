@@ -0,0 +1,61 @@
+package dump
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type redactConfig struct {
+	Host     string
+	Password string
+	APIToken string
+	Key      string `dump:"-"`
+	Cert     string `sensitive:"true"`
+	Extra    map[string]interface{}
+}
+
+func TestDumper_Redact(t *testing.T) {
+	cfg := &redactConfig{
+		Host:     "localhost",
+		Password: "pwd123",
+		APIToken: "tk123",
+		Key:      "key123",
+		Cert:     "cert123",
+		Extra:    map[string]interface{}{"db_secret": "sec123", "port": 3306},
+	}
+
+	buf := new(bytes.Buffer)
+	dumper := newBufDumper(buf)
+	dumper.ShowFlag = Fnopos
+
+	dumper.Print(cfg)
+	str := buf.String()
+	buf.Reset()
+	assert.Contains(t, str, "pwd123")
+	assert.NotContains(t, str, RedactedText)
+
+	dumper.Redact = true
+	dumper.Print(cfg)
+	str = buf.String()
+	buf.Reset()
+	assert.Contains(t, str, `Host: string("localhost"), #len=9`)
+	assert.Contains(t, str, "Password: [REDACTED],\n")
+	assert.Contains(t, str, "APIToken: [REDACTED],\n")
+	assert.Contains(t, str, "Key: [REDACTED],\n")
+	assert.Contains(t, str, "Cert: [REDACTED],\n")
+	assert.Contains(t, str, "\"db_secret\": [REDACTED],\n")
+	assert.Contains(t, str, `"port": int(3306),`)
+	for _, val := range []string{"pwd123", "tk123", "key123", "cert123", "sec123"} {
+		assert.NotContains(t, str, val)
+	}
+
+	// custom patterns
+	dumper.RedactPatterns = []string{"host"}
+	dumper.Print(cfg)
+	str = buf.String()
+	assert.Contains(t, str, "Host: [REDACTED],\n")
+	assert.Contains(t, str, `Password: string("pwd123")`)
+	assert.Contains(t, str, "Key: [REDACTED],\n")
+}
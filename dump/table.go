@@ -0,0 +1,216 @@
+package dump
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/gookit/goutil/strutil"
+)
+
+// TableOptions for render table
+type TableOptions struct {
+	// Columns select the columns to render, default render all columns.
+	// for struct is the field name, for map is the key.
+	Columns []string
+	// MaxWidth max width of each cell, the more contents will be truncated. 0 is no limit.
+	MaxWidth int
+}
+
+// Table print the []Struct or []map[string]any as an aligned ASCII table.
+// will fallback to dump the value on it cannot be rendered as a table.
+//
+// Usage:
+// 	dump.Table(users)
+// 	dump.Table(users, func(opt *dump.TableOptions) {
+// 		opt.Columns = []string{"ID", "Name"}
+// 		opt.MaxWidth = 20
+// 	})
+//
+// Output like:
+// 	+----+--------+
+// 	| ID | Name   |
+// 	+----+--------+
+// 	| 1  | inhere |
+// 	+----+--------+
+func Table(v interface{}, fns ...func(opt *TableOptions)) {
+	FprintTable(os.Stdout, v, fns...)
+}
+
+// FprintTable print the []Struct or []map[string]any as an aligned ASCII table to the writer.
+func FprintTable(w io.Writer, v interface{}, fns ...func(opt *TableOptions)) {
+	s, err := TableString(v, fns...)
+	if err != nil {
+		std2.Fprint(w, v)
+		return
+	}
+	_, _ = io.WriteString(w, s)
+}
+
+// TableString render the []Struct or []map[string]any as an aligned ASCII table string.
+func TableString(v interface{}, fns ...func(opt *TableOptions)) (string, error) {
+	opt := &TableOptions{}
+	for _, fn := range fns {
+		fn(opt)
+	}
+
+	cols, rows, err := tableData(reflect.ValueOf(v), opt.Columns)
+	if err != nil {
+		return "", err
+	}
+
+	// truncate and calc width of each column
+	widths := make([]int, len(cols))
+	for i, col := range cols {
+		cols[i] = truncCell(col, opt.MaxWidth)
+		widths[i] = strutil.TextWidth(cols[i])
+	}
+
+	for _, row := range rows {
+		for i := range row {
+			row[i] = truncCell(row[i], opt.MaxWidth)
+			if n := strutil.TextWidth(row[i]); n > widths[i] {
+				widths[i] = n
+			}
+		}
+	}
+
+	buf := &bytes.Buffer{}
+	writeTableBorder(buf, widths)
+	writeTableRow(buf, cols, widths)
+	writeTableBorder(buf, widths)
+	for _, row := range rows {
+		writeTableRow(buf, row, widths)
+	}
+
+	if len(rows) > 0 {
+		writeTableBorder(buf, widths)
+	}
+	return buf.String(), nil
+}
+
+func tableData(rv reflect.Value, columns []string) (cols []string, rows [][]string, err error) {
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return nil, nil, errors.New("dump: table data must be an array or slice")
+	}
+
+	elems := make([]reflect.Value, 0, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		elem := rv.Index(i)
+		for elem.Kind() == reflect.Ptr || elem.Kind() == reflect.Interface {
+			elem = elem.Elem()
+		}
+
+		if elem.Kind() == reflect.Map && elem.Type().Key().Kind() != reflect.String {
+			return nil, nil, errors.New("dump: table element map key must be string")
+		}
+
+		if elem.Kind() != reflect.Struct && elem.Kind() != reflect.Map {
+			return nil, nil, errors.New("dump: table element must be a struct or map")
+		}
+		elems = append(elems, elem)
+	}
+
+	// copy, don't modify the input columns
+	cols = append([]string(nil), columns...)
+	if len(cols) == 0 {
+		cols = tableColumns(rv.Type().Elem(), elems)
+	}
+
+	for _, elem := range elems {
+		row := make([]string, len(cols))
+		for i, col := range cols {
+			var cell reflect.Value
+			if elem.Kind() == reflect.Struct {
+				cell = elem.FieldByName(col)
+			} else {
+				cell = elem.MapIndex(reflect.ValueOf(col).Convert(elem.Type().Key()))
+			}
+			row[i] = cellString(cell)
+		}
+		rows = append(rows, row)
+	}
+	return
+}
+
+// tableColumns collect columns: the exported fields for struct, the sorted keys for map.
+func tableColumns(elemType reflect.Type, elems []reflect.Value) []string {
+	for elemType.Kind() == reflect.Ptr {
+		elemType = elemType.Elem()
+	}
+
+	var cols []string
+	if elemType.Kind() == reflect.Struct {
+		for i := 0; i < elemType.NumField(); i++ {
+			if fd := elemType.Field(i); fd.PkgPath == "" {
+				cols = append(cols, fd.Name)
+			}
+		}
+		return cols
+	}
+
+	exists := make(map[string]bool)
+	for _, elem := range elems {
+		if elem.Kind() == reflect.Struct {
+			continue
+		}
+
+		for _, key := range elem.MapKeys() {
+			name := key.String()
+			if !exists[name] {
+				exists[name] = true
+				cols = append(cols, name)
+			}
+		}
+	}
+
+	sort.Strings(cols)
+	return cols
+}
+
+func cellString(v reflect.Value) string {
+	for v.IsValid() && (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			return ""
+		}
+		v = v.Elem()
+	}
+
+	if !v.IsValid() || !v.CanInterface() {
+		return ""
+	}
+	return fmt.Sprint(v.Interface())
+}
+
+func truncCell(s string, maxWidth int) string {
+	s = strings.Replace(s, "\n", " ", -1)
+	if maxWidth <= 0 {
+		return s
+	}
+
+	return strutil.TruncateWidth(s, maxWidth, "...")
+}
+
+func writeTableBorder(buf *bytes.Buffer, widths []int) {
+	buf.WriteByte('+')
+	for _, w := range widths {
+		buf.WriteString(strings.Repeat("-", w+2))
+		buf.WriteByte('+')
+	}
+	buf.WriteByte('\n')
+}
+
+func writeTableRow(buf *bytes.Buffer, cells []string, widths []int) {
+	buf.WriteByte('|')
+	for i, cell := range cells {
+		buf.WriteString(" " + cell)
+		buf.WriteString(strings.Repeat(" ", widths[i]-strutil.TextWidth(cell)+1))
+		buf.WriteByte('|')
+	}
+	buf.WriteByte('\n')
+}
@@ -0,0 +1,78 @@
+package dump
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type tableUser struct {
+	ID   int
+	Name string
+	Bio  *string
+	age  int
+}
+
+func TestTableString(t *testing.T) {
+	bio := "some long text for the user"
+	users := []*tableUser{
+		{ID: 1, Name: "inhere", Bio: &bio},
+		{ID: 23, Name: "tom"},
+	}
+
+	s, err := TableString(users)
+	assert.NoError(t, err)
+	assert.Equal(t, `+----+--------+-----------------------------+
+| ID | Name   | Bio                         |
++----+--------+-----------------------------+
+| 1  | inhere | some long text for the user |
+| 23 | tom    |                             |
++----+--------+-----------------------------+
+`, s)
+
+	s, err = TableString(users, func(opt *TableOptions) {
+		opt.Columns = []string{"Name", "Bio"}
+		opt.MaxWidth = 10
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, `+--------+------------+
+| Name   | Bio        |
++--------+------------+
+| inhere | some lo... |
+| tom    |            |
++--------+------------+
+`, s)
+
+	// map
+	s, err = TableString([]map[string]interface{}{
+		{"name": "inhere", "city": "上海"},
+		{"name": "tom", "age": 23},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, `+-----+------+--------+
+| age | city | name   |
++-----+------+--------+
+|     | 上海 | inhere |
+| 23  |      | tom    |
++-----+------+--------+
+`, s)
+
+	_, err = TableString("abc")
+	assert.Error(t, err)
+	_, err = TableString([]int{1})
+	assert.Error(t, err)
+	_, err = TableString([]map[int]string{{1: "a"}})
+	assert.Error(t, err)
+}
+
+func TestFprintTable(t *testing.T) {
+	buf := new(bytes.Buffer)
+	FprintTable(buf, []tableUser{{ID: 1, Name: "inhere"}})
+	assert.Contains(t, buf.String(), "| 1  | inhere |     |")
+
+	// fallback to dump
+	buf.Reset()
+	FprintTable(buf, 23)
+	assert.Equal(t, "int(23),\n", buf.String())
+}
@@ -4,6 +4,7 @@ import (
 	"io"
 	"os"
 	"runtime"
+	"strconv"
 	"strings"
 
 	"github.com/gookit/goutil/sysutil"
@@ -153,3 +154,100 @@ func IsSupportTrueColor() bool {
 	// "COLORTERM=truecolor"
 	return strings.Contains(os.Getenv("COLORTERM"), "truecolor")
 }
+
+// color levels for ColorLevel()
+const (
+	ColorLevelNone = iota
+	ColorLevel16
+	ColorLevel256
+	ColorLevelTrue
+)
+
+// ColorLevel get the color level supported by current console.
+//
+// - will returns ColorLevelNone on the ENV "NO_COLOR" is not empty. see https://no-color.org
+// - can force the color level by the ENV "FORCE_COLOR", it is higher priority than "NO_COLOR".
+// "0", "false" is none, "1", "true" or empty is 16 colors, "2" is 256 colors, "3" is true color.
+func ColorLevel() int {
+	if level, ok := forceColorLevel(); ok {
+		return level
+	}
+	if os.Getenv("NO_COLOR") != "" {
+		return ColorLevelNone
+	}
+
+	switch {
+	case IsSupportTrueColor():
+		return ColorLevelTrue
+	case IsSupport256Color():
+		return ColorLevel256
+	case IsSupportColor():
+		return ColorLevel16
+	}
+	return ColorLevelNone
+}
+
+// IsForceColor check the color is forced on by the ENV "FORCE_COLOR".
+// the color should be rendered even if the output is not a terminal.
+func IsForceColor() bool {
+	level, ok := forceColorLevel()
+	return ok && level != ColorLevelNone
+}
+
+// forceColorLevel get the color level from the ENV "FORCE_COLOR"
+func forceColorLevel() (int, bool) {
+	val, ok := os.LookupEnv("FORCE_COLOR")
+	if !ok {
+		return ColorLevelNone, false
+	}
+
+	switch strings.ToLower(strings.TrimSpace(val)) {
+	case "0", "false":
+		return ColorLevelNone, true
+	case "2":
+		return ColorLevel256, true
+	case "3":
+		return ColorLevelTrue, true
+	}
+	return ColorLevel16, true
+}
+
+// hyperlinkTermPrograms the ENV "TERM_PROGRAM" values of the terminals support hyperlink.
+var hyperlinkTermPrograms = map[string]bool{
+	"iTerm.app": true,
+	"WezTerm":   true,
+	"vscode":    true,
+	"Hyper":     true,
+}
+
+// hyperlinkTerms the ENV "TERM" values of the terminals support hyperlink.
+var hyperlinkTerms = map[string]bool{
+	"xterm-kitty": true,
+	"alacritty":   true,
+	"foot":        true,
+	"wezterm":     true,
+}
+
+// IsSupportHyperlink check current console is support OSC 8 hyperlink.
+//
+// can use the ENV "FORCE_HYPERLINK" to force enable(=1) or disable(=0) it.
+func IsSupportHyperlink() bool {
+	if val, ok := os.LookupEnv("FORCE_HYPERLINK"); ok {
+		return val != "0"
+	}
+
+	// on Windows Terminal, e.g "WT_SESSION=xxx"
+	if os.Getenv("WT_SESSION") != "" || os.Getenv("KITTY_WINDOW_ID") != "" || os.Getenv("DOMTERM") != "" {
+		return true
+	}
+
+	if hyperlinkTermPrograms[os.Getenv("TERM_PROGRAM")] || hyperlinkTerms[os.Getenv("TERM")] {
+		return true
+	}
+
+	// the VTE based terminals, e.g GNOME Terminal. supported from VTE 0.50(VTE_VERSION=5000)
+	if ver, err := strconv.Atoi(os.Getenv("VTE_VERSION")); err == nil {
+		return ver >= 5000
+	}
+	return false
+}
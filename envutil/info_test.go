@@ -92,3 +92,59 @@ func TestIsSupportColor(t *testing.T) {
 		is.NoError(os.Unsetenv("TERM"))
 	}
 }
+
+func TestColorLevel(t *testing.T) {
+	testutil.MockOsEnv(map[string]string{}, func() {
+		assert.Equal(t, envutil.ColorLevelNone, envutil.ColorLevel())
+	})
+	testutil.MockOsEnv(map[string]string{"TERM": "xterm"}, func() {
+		assert.Equal(t, envutil.ColorLevel16, envutil.ColorLevel())
+	})
+	testutil.MockOsEnv(map[string]string{"TERM": "xterm-256color"}, func() {
+		assert.Equal(t, envutil.ColorLevel256, envutil.ColorLevel())
+	})
+	testutil.MockOsEnv(map[string]string{"COLORTERM": "truecolor"}, func() {
+		assert.Equal(t, envutil.ColorLevelTrue, envutil.ColorLevel())
+	})
+	testutil.MockOsEnv(map[string]string{"TERM": "xterm", "NO_COLOR": "1"}, func() {
+		assert.Equal(t, envutil.ColorLevelNone, envutil.ColorLevel())
+	})
+	// empty NO_COLOR is ignored
+	testutil.MockOsEnv(map[string]string{"TERM": "xterm", "NO_COLOR": ""}, func() {
+		assert.Equal(t, envutil.ColorLevel16, envutil.ColorLevel())
+	})
+
+	// force color
+	testutil.MockOsEnv(map[string]string{"FORCE_COLOR": ""}, func() {
+		assert.Equal(t, envutil.ColorLevel16, envutil.ColorLevel())
+		assert.True(t, envutil.IsForceColor())
+	})
+	testutil.MockOsEnv(map[string]string{"FORCE_COLOR": "3", "NO_COLOR": "1"}, func() {
+		assert.Equal(t, envutil.ColorLevelTrue, envutil.ColorLevel())
+	})
+	testutil.MockOsEnv(map[string]string{"TERM": "xterm-256color", "FORCE_COLOR": "0"}, func() {
+		assert.Equal(t, envutil.ColorLevelNone, envutil.ColorLevel())
+		assert.False(t, envutil.IsForceColor())
+	})
+}
+
+func TestIsSupportHyperlink(t *testing.T) {
+	testutil.MockOsEnv(map[string]string{}, func() {
+		assert.False(t, envutil.IsSupportHyperlink())
+	})
+	testutil.MockOsEnv(map[string]string{"TERM_PROGRAM": "iTerm.app"}, func() {
+		assert.True(t, envutil.IsSupportHyperlink())
+	})
+	testutil.MockOsEnv(map[string]string{"VTE_VERSION": "6003"}, func() {
+		assert.True(t, envutil.IsSupportHyperlink())
+	})
+	testutil.MockOsEnv(map[string]string{"VTE_VERSION": "4205"}, func() {
+		assert.False(t, envutil.IsSupportHyperlink())
+	})
+	testutil.MockOsEnv(map[string]string{"FORCE_HYPERLINK": "1"}, func() {
+		assert.True(t, envutil.IsSupportHyperlink())
+	})
+	testutil.MockOsEnv(map[string]string{"WT_SESSION": "abc", "FORCE_HYPERLINK": "0"}, func() {
+		assert.False(t, envutil.IsSupportHyperlink())
+	})
+}
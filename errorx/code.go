@@ -0,0 +1,152 @@
+package errorx
+
+import (
+	"errors"
+	"fmt"
+	"io"
+)
+
+// predefined error codes for the categories, the value is same as the gRPC codes.
+const (
+	CodeInvalid  = 3
+	CodeTimeout  = 4
+	CodeNotFound = 5
+	CodeInternal = 13
+)
+
+// Category an error category with code, it can be used as the target of errors.Is()
+//
+// Usage:
+// 	err := errorx.NotFound.Wrap(sql.ErrNoRows)
+// 	errors.Is(err, errorx.NotFound) // true
+// 	errorx.CodeOf(err) // errorx.CodeNotFound
+type Category struct {
+	code int
+	name string
+}
+
+// predefined error categories
+var (
+	Invalid  = NewCategory(CodeInvalid, "invalid")
+	Timeout  = NewCategory(CodeTimeout, "timeout")
+	NotFound = NewCategory(CodeNotFound, "not found")
+	Internal = NewCategory(CodeInternal, "internal")
+)
+
+// NewCategory create a new error category
+func NewCategory(code int, name string) *Category {
+	return &Category{code: code, name: name}
+}
+
+// Code of the category
+func (c *Category) Code() int {
+	return c.code
+}
+
+// Name of the category
+func (c *Category) Name() string {
+	return c.name
+}
+
+// Error string
+func (c *Category) Error() string {
+	return c.name
+}
+
+// New error with message and caller stacks, and with the category code.
+func (c *Category) New(msg string) error {
+	return &codeError{
+		code: c.code,
+		err: &ErrorX{
+			msg:   msg,
+			stack: callersStack(stdOpt.SkipDepth, stdOpt.TraceDepth),
+		},
+	}
+}
+
+// Newf error with format message and caller stacks, and with the category code.
+func (c *Category) Newf(tpl string, vars ...interface{}) error {
+	return &codeError{
+		code: c.code,
+		err: &ErrorX{
+			msg:   fmt.Sprintf(tpl, vars...),
+			stack: callersStack(stdOpt.SkipDepth, stdOpt.TraceDepth),
+		},
+	}
+}
+
+// Wrap the error with the category code. If err is nil, will return nil.
+func (c *Category) Wrap(err error) error {
+	return WithCode(err, c.code)
+}
+
+// codeError wrap an error with code
+type codeError struct {
+	err  error
+	code int
+}
+
+// WithCode wrap the error with code. If err is nil, will return nil.
+//
+// Usage:
+// 	err = errorx.WithCode(err, 404)
+// 	errorx.CodeOf(err) // 404
+func WithCode(err error, code int) error {
+	if err == nil {
+		return nil
+	}
+	return &codeError{err: err, code: code}
+}
+
+// Code value
+func (e *codeError) Code() int {
+	return e.code
+}
+
+// Error string
+func (e *codeError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap implements Unwrapper.
+func (e *codeError) Unwrap() error {
+	return e.err
+}
+
+// Is check the target is a Category and has same code. for errors.Is()
+func (e *codeError) Is(target error) bool {
+	if c, ok := target.(*Category); ok {
+		return c.code == e.code
+	}
+	return false
+}
+
+// Format error, will format the wrapped error.
+func (e *codeError) Format(s fmt.State, verb rune) {
+	if fm, ok := e.err.(fmt.Formatter); ok {
+		fm.Format(s, verb)
+		return
+	}
+
+	if verb == 'q' {
+		_, _ = fmt.Fprintf(s, "%q", e.Error())
+		return
+	}
+	_, _ = io.WriteString(s, e.Error())
+}
+
+// CodeOf get the error code from the first ErrorCoder in the error chain.
+// returns 0 on err is nil or not found code.
+func CodeOf(err error) int {
+	var ec ErrorCoder
+	if errors.As(err, &ec) {
+		return ec.Code()
+	}
+	return 0
+}
+
+// HasCode check the error chain has an ErrorCoder
+func HasCode(err error) bool {
+	var ec ErrorCoder
+	return errors.As(err, &ec)
+}
@@ -0,0 +1,69 @@
+package errorx_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/gookit/goutil/errorx"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithCode(t *testing.T) {
+	assert.Nil(t, errorx.WithCode(nil, 404))
+	assert.Equal(t, 0, errorx.CodeOf(nil))
+
+	err1 := errors.New("first error")
+	err2 := errorx.WithCode(err1, 404)
+	assert.Equal(t, "first error", err2.Error())
+	assert.Equal(t, 404, errorx.CodeOf(err2))
+	assert.True(t, errors.Is(err2, err1))
+	assert.True(t, errorx.HasCode(err2))
+
+	// find in the wrapped chain
+	err3 := errorx.With(err2, "second error")
+	assert.Equal(t, 404, errorx.CodeOf(err3))
+	err3 = fmt.Errorf("std wrap: %w", err3)
+	assert.Equal(t, 404, errorx.CodeOf(err3))
+
+	// the outer code first
+	assert.Equal(t, 500, errorx.CodeOf(errorx.WithCode(err3, 500)))
+
+	// ErrorR
+	assert.Equal(t, 405, errorx.CodeOf(errorx.Wrap(errorx.NewR(405, "param error"), "wrap")))
+
+	assert.Equal(t, 0, errorx.CodeOf(err1))
+	assert.False(t, errorx.HasCode(err1))
+}
+
+func TestCategory(t *testing.T) {
+	err := errorx.NotFound.New("user not found")
+	assert.Equal(t, "user not found", err.Error())
+	assert.Equal(t, errorx.CodeNotFound, errorx.CodeOf(err))
+	assert.True(t, errors.Is(err, errorx.NotFound))
+	assert.False(t, errors.Is(err, errorx.Invalid))
+	assert.Contains(t, fmt.Sprintf("%+v", err), "errorx_test.TestCategory()")
+
+	err = errorx.Invalid.Newf("invalid param %s", "name")
+	assert.Equal(t, "invalid param name", err.Error())
+	assert.True(t, errors.Is(errorx.With(err, "wrapped"), errorx.Invalid))
+
+	err = errorx.Timeout.Wrap(errors.New("read tcp: i/o timeout"))
+	assert.Equal(t, errorx.CodeTimeout, errorx.CodeOf(err))
+	assert.True(t, errors.Is(err, errorx.Timeout))
+	assert.Nil(t, errorx.Internal.Wrap(nil))
+
+	// same code
+	assert.True(t, errors.Is(errorx.WithCode(err, errorx.CodeInternal), errorx.Internal))
+
+	assert.Equal(t, "not found", errorx.NotFound.Error())
+	assert.Equal(t, "internal", errorx.Internal.Name())
+	assert.Equal(t, 13, errorx.Internal.Code())
+
+	// custom category
+	conflict := errorx.NewCategory(409, "conflict")
+	err = conflict.New("user already exists")
+	assert.Equal(t, 409, errorx.CodeOf(err))
+	assert.True(t, errors.Is(err, conflict))
+	assert.Equal(t, `"user already exists"`, fmt.Sprintf("%q", err))
+}
@@ -70,26 +70,25 @@ func (e *ErrorX) Unwrap() error {
 	return e.prev
 }
 
-// Format error
+// Format error, implements fmt.Formatter
+//
+// format verbs:
+// 	%s, %v  the error message chain, same as Error()
+// 	%+v     the error message chain with stack frames
+// 	%#v     same as %+v, the error message chain with stack frames
+// 	%q      the quoted error message chain
 func (e *ErrorX) Format(s fmt.State, verb rune) {
-	// format current error: only output on have msg
-	if len(e.msg) > 0 {
-		_, _ = io.WriteString(s, e.msg)
-		if e.stack != nil {
-			e.stack.Format(s, verb)
+	switch verb {
+	case 'v':
+		if s.Flag('+') || s.Flag('#') {
+			_, _ = e.WriteTo(s)
+			return
 		}
-	}
-
-	// format prev error
-	if e.prev == nil {
-		return
-	}
-
-	_, _ = s.Write([]byte("\nPrevious: "))
-	if ex, ok := e.prev.(*ErrorX); ok {
-		ex.Format(s, verb)
-	} else {
-		_, _ = s.Write([]byte(e.prev.Error()))
+		_, _ = io.WriteString(s, e.Error())
+	case 's':
+		_, _ = io.WriteString(s, e.Error())
+	case 'q':
+		_, _ = fmt.Fprintf(s, "%q", e.Error())
 	}
 }
 
@@ -116,27 +115,34 @@ func (e *ErrorX) String() string {
 
 // WriteTo write the error to a writer, contains stack information.
 func (e *ErrorX) WriteTo(w io.Writer) (n int64, err error) {
-	// current error: only output on have msg
+	cw := &countWriter{w: w}
+
+	// current error: only output msg on have msg
 	if len(e.msg) > 0 {
-		_, _ = w.Write([]byte(e.msg))
+		_, _ = io.WriteString(cw, e.msg)
+	}
 
-		// with stack
-		if e.stack != nil {
-			_, _ = e.stack.WriteTo(w)
-		}
+	// with stack
+	if e.stack != nil {
+		_, _ = e.stack.WriteTo(cw)
 	}
 
 	// with prev error
 	if e.prev != nil {
-		_, _ = io.WriteString(w, "\nPrevious: ")
+		if cw.n > 0 {
+			_, _ = io.WriteString(cw, "\nPrevious: ")
+		}
 
-		if ex, ok := e.prev.(*ErrorX); ok {
-			_, _ = ex.WriteTo(w)
-		} else {
-			_, _ = io.WriteString(w, e.prev.Error())
+		switch prev := e.prev.(type) {
+		case *ErrorX:
+			_, _ = prev.WriteTo(cw)
+		case fmt.Formatter:
+			_, _ = fmt.Fprintf(cw, "%+v", prev)
+		default:
+			_, _ = io.WriteString(cw, prev.Error())
 		}
 	}
-	return
+	return cw.n, cw.err
 }
 
 // Message error message of current
@@ -161,7 +167,9 @@ func (e *ErrorX) writeMsgTo(w io.Writer) {
 
 	// with prev error
 	if e.prev != nil {
-		_, _ = w.Write([]byte("; "))
+		if len(e.msg) > 0 {
+			_, _ = w.Write([]byte("; "))
+		}
 		if ex, ok := e.prev.(*ErrorX); ok {
 			ex.writeMsgTo(w)
 		} else {
@@ -189,6 +197,22 @@ func (e *ErrorX) Location() string {
 	return e.CallerFunc().Location()
 }
 
+// countWriter count the written bytes
+type countWriter struct {
+	w   io.Writer
+	n   int64
+	err error
+}
+
+func (cw *countWriter) Write(p []byte) (int, error) {
+	n, err := cw.w.Write(p)
+	cw.n += int64(n)
+	if err != nil && cw.err == nil {
+		cw.err = err
+	}
+	return n, err
+}
+
 /*************************************************************
  * new error with call stacks
  *************************************************************/
@@ -259,13 +283,14 @@ func WithPrevf(err error, tpl string, vars ...interface{}) error {
  *************************************************************/
 
 // WithStack wrap a go error with a stacked trace. If err is nil, will return nil.
+//
+// the returned error is compatible with errors.Is() and errors.As() for the err.
 func WithStack(err error) error {
 	if err == nil {
 		return nil
 	}
 	return &ErrorX{
-		msg: err.Error(),
-		// prev:  err,
+		prev:  err,
 		stack: callersStack(stdOpt.SkipDepth, stdOpt.TraceDepth),
 	}
 }
@@ -276,7 +301,7 @@ func Traced(err error) error {
 		return nil
 	}
 	return &ErrorX{
-		msg:   err.Error(),
+		prev:  err,
 		stack: callersStack(stdOpt.SkipDepth, stdOpt.TraceDepth),
 	}
 }
@@ -287,7 +312,7 @@ func Stacked(err error) error {
 		return nil
 	}
 	return &ErrorX{
-		msg:   err.Error(),
+		prev:  err,
 		stack: callersStack(stdOpt.SkipDepth, stdOpt.TraceDepth),
 	}
 }
@@ -238,3 +238,41 @@ func TestTo(t *testing.T) {
 func returnMyErr() error {
 	return &MyError{Msg: "an error"}
 }
+
+func TestErrorX_Format(t *testing.T) {
+	err1 := errors.New("first error")
+	err2 := errorx.With(err1, "second error")
+	err3 := errorx.Wrap(err2, "third error")
+
+	assert.Equal(t, "third error; second error; first error", err3.Error())
+	assert.Equal(t, err3.Error(), fmt.Sprintf("%v", err3))
+	assert.Equal(t, err3.Error(), fmt.Sprintf("%s", err3))
+	assert.Equal(t, `"third error; second error; first error"`, fmt.Sprintf("%q", err3))
+
+	// with stack frames
+	s := fmt.Sprintf("%+v", err3)
+	assert.Contains(t, s, "third error\nPrevious: second error\nSTACK:\n")
+	assert.Contains(t, s, "errorx_test.TestErrorX_Format()")
+	assert.Contains(t, s, "\nPrevious: first error")
+	assert.Equal(t, s, fmt.Sprintf("%#v", err3))
+}
+
+func TestWithStack_is(t *testing.T) {
+	err1 := returnErrL2("first error")
+	err2 := errorx.WithStack(err1)
+
+	assert.Equal(t, "first error", err2.Error())
+	assert.True(t, errors.Is(err2, err1))
+	assert.Equal(t, err1, errorx.Cause(err2))
+	assert.Contains(t, fmt.Sprintf("%+v", err2), "STACK:\n")
+	assert.Contains(t, fmt.Sprintf("%+v", err2), "\nPrevious: first error")
+	assert.Nil(t, errorx.WithStack(nil))
+
+	mye := returnMyErr()
+	err3 := errorx.Stacked(errorx.Wrap(mye, "wrapped"))
+
+	var target *MyError
+	assert.True(t, errors.As(err3, &target))
+	assert.Equal(t, "an error", target.Msg)
+	assert.Equal(t, "wrapped; an error", err3.Error())
+}
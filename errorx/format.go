@@ -0,0 +1,132 @@
+package errorx
+
+import (
+	"encoding/json"
+	"errors"
+	"runtime"
+	"strconv"
+	"strings"
+
+	"github.com/gookit/color"
+)
+
+// Frame a stack frame info
+type Frame struct {
+	Func string `json:"func"`
+	File string `json:"file"`
+	Line int    `json:"line"`
+}
+
+// String of the frame. eg: "github.com/gookit/goutil/errorx.New() /path/to/errorx.go:34"
+func (f Frame) String() string {
+	return f.Func + "() " + f.File + ":" + strconv.Itoa(f.Line)
+}
+
+// frames get the frame list of the stack, will skip the runtime frames.
+func (s *stack) frames() []Frame {
+	if s == nil || len(*s) == 0 {
+		return nil
+	}
+
+	var list []Frame
+	fs := runtime.CallersFrames(*s)
+	for {
+		fr, more := fs.Next()
+		if fr.Function != "" && !strings.HasPrefix(fr.Function, "runtime.") {
+			list = append(list, Frame{Func: fr.Function, File: fr.File, Line: fr.Line})
+		}
+
+		if !more {
+			break
+		}
+	}
+	return list
+}
+
+// Frames get the stack frames of current error, will skip the runtime frames.
+func (e *ErrorX) Frames() []Frame {
+	return e.stack.frames()
+}
+
+// MarshalJSON implements json.Marshaler, for structured logging.
+//
+// JSON like:
+// 	{
+// 		"error": "second error; first error",
+// 		"message": "second error",
+// 		"stack": [{"func": "main.main", "file": "/path/to/main.go", "line": 12}],
+// 		"previous": {"error": "first error"}
+// 	}
+func (e *ErrorX) MarshalJSON() ([]byte, error) {
+	return json.Marshal(errorJSON(e))
+}
+
+// jsonError the JSON struct of the error
+type jsonError struct {
+	Error    string     `json:"error"`
+	Message  string     `json:"message,omitempty"`
+	Stack    []Frame    `json:"stack,omitempty"`
+	Previous *jsonError `json:"previous,omitempty"`
+}
+
+func errorJSON(err error) *jsonError {
+	msg := selfMessage(err)
+	prev := errors.Unwrap(err)
+	// skip the wrapper without self message. eg: WithCode()
+	if _, ok := err.(*ErrorX); !ok && msg == "" && prev != nil {
+		return errorJSON(prev)
+	}
+
+	je := &jsonError{Error: err.Error()}
+
+	if ex, ok := err.(*ErrorX); ok {
+		je.Message = ex.msg
+		je.Stack = ex.Frames()
+		if ex.prev != nil {
+			je.Previous = errorJSON(ex.prev)
+		}
+		return je
+	}
+
+	// continue with the wrapped error. eg: fmt.Errorf("%w")
+	if prev != nil {
+		je.Message = msg
+		je.Previous = errorJSON(prev)
+	}
+	return je
+}
+
+// FormatStack format the error chain and the stack frames to a readable string,
+// the frames will be colorized on the color is enabled. will skip the runtime frames.
+//
+// Output like:
+// 	Error: query user
+// 	  at github.com/inhere/app/dao.QueryUser()
+// 	     /path/to/dao/user.go:34
+// 	Previous: connection refused
+func FormatStack(err error) string {
+	if err == nil {
+		return ""
+	}
+
+	var sb strings.Builder
+	label := "Error: "
+	for e := err; e != nil; e = errors.Unwrap(e) {
+		// will skip the wrapper without self message. eg: WithCode()
+		if msg := selfMessage(e); msg != "" {
+			sb.WriteString(color.FgRed.Render(label) + msg + "\n")
+			label = "Previous: "
+		}
+
+		ex, ok := e.(*ErrorX)
+		if !ok {
+			continue
+		}
+
+		for _, fr := range ex.Frames() {
+			sb.WriteString("  at " + color.FgCyan.Render(fr.Func+"()") + "\n")
+			sb.WriteString("     " + color.FgDarkGray.Render(fr.File+":"+strconv.Itoa(fr.Line)) + "\n")
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,75 @@
+package errorx_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/gookit/color"
+	"github.com/gookit/goutil/errorx"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatStack(t *testing.T) {
+	old := color.Enable
+	color.Enable = false
+	defer func() {
+		color.Enable = old
+	}()
+
+	assert.Equal(t, "", errorx.FormatStack(nil))
+	assert.Equal(t, "Error: an error\n", errorx.FormatStack(errors.New("an error")))
+
+	err := errorx.WithCode(errorx.With(errors.New("connection refused"), "query user"), 500)
+	s := errorx.FormatStack(err)
+
+	assert.True(t, strings.HasPrefix(s, "Error: query user\n  at github.com/gookit/goutil/errorx_test.TestFormatStack()\n"))
+	assert.Contains(t, s, "errorx/format_test.go:")
+	assert.True(t, strings.HasSuffix(s, "Previous: connection refused\n"))
+	assert.NotContains(t, s, "runtime.goexit")
+}
+
+func TestFormatStack_fmtWrapped(t *testing.T) {
+	old := color.Enable
+	color.Enable = false
+	defer func() {
+		color.Enable = old
+	}()
+
+	err := errorx.With(fmt.Errorf("ctx: %w", errors.New("inner error")), "query user")
+	s := errorx.FormatStack(err)
+	assert.Contains(t, s, "Previous: ctx\nPrevious: inner error\n")
+	assert.Equal(t, 1, strings.Count(s, "inner error"))
+
+	bs, jerr := json.Marshal(err)
+	assert.NoError(t, jerr)
+	assert.Contains(t, string(bs), `"previous":{"error":"ctx: inner error","message":"ctx","previous":{"error":"inner error"}}`)
+}
+
+func TestErrorX_MarshalJSON(t *testing.T) {
+	err := errorx.With(errorx.WithCode(errors.New("first error"), 404), "second error")
+
+	ex, ok := err.(*errorx.ErrorX)
+	assert.True(t, ok)
+	frames := ex.Frames()
+	assert.NotEmpty(t, frames)
+	assert.Equal(t, "github.com/gookit/goutil/errorx_test.TestErrorX_MarshalJSON", frames[0].Func)
+	assert.Contains(t, frames[0].String(), "format_test.go:")
+
+	bs, jerr := json.Marshal(err)
+	assert.NoError(t, jerr)
+
+	var data struct {
+		Error    string         `json:"error"`
+		Message  string         `json:"message"`
+		Stack    []errorx.Frame `json:"stack"`
+		Previous map[string]interface{} `json:"previous"`
+	}
+	assert.NoError(t, json.Unmarshal(bs, &data))
+	assert.Equal(t, "second error; first error", data.Error)
+	assert.Equal(t, "second error", data.Message)
+	assert.Equal(t, frames, data.Stack)
+	assert.Equal(t, map[string]interface{}{"error": "first error"}, data.Previous)
+}
@@ -0,0 +1,124 @@
+package errorx
+
+import (
+	"errors"
+	"net/http"
+)
+
+// HTTPStatusMap the error code to HTTP status code map. can add custom mapping.
+var HTTPStatusMap = map[int]int{
+	CodeInvalid:  http.StatusBadRequest,
+	CodeTimeout:  http.StatusGatewayTimeout,
+	CodeNotFound: http.StatusNotFound,
+	CodeInternal: http.StatusInternalServerError,
+}
+
+// ToHTTPStatus map the error to HTTP status code.
+//
+// rules:
+// 	- returns 200 on err is nil
+// 	- by the error code in HTTPStatusMap. see CodeOf()
+// 	- the error code is a valid HTTP error status(400-599), returns the code.
+// 	- returns 504 on IsTimeout(err)
+// 	- otherwise, returns 500
+func ToHTTPStatus(err error) int {
+	if err == nil {
+		return http.StatusOK
+	}
+
+	if HasCode(err) {
+		code := CodeOf(err)
+		if status, ok := HTTPStatusMap[code]; ok {
+			return status
+		}
+		if code >= 400 && code < 600 {
+			return code
+		}
+	}
+
+	if IsTimeout(err) {
+		return http.StatusGatewayTimeout
+	}
+	return http.StatusInternalServerError
+}
+
+// DebugMode on enabled, NewErrorResponse will contains the internal error details.
+var DebugMode = false
+
+// ErrorResponse an error response for API services.
+//
+// JSON like:
+// 	{"code": 5, "message": "user not found"}
+type ErrorResponse struct {
+	// Status the HTTP status code. see ToHTTPStatus()
+	Status int `json:"-"`
+	// Code the error code. will use the Status on the error without code.
+	Code int `json:"code"`
+	// Message the error message. for server error(status >= 500) will use the status text on DebugMode is false.
+	Message string `json:"message"`
+	// Details the messages of the error chain, only on DebugMode is true.
+	Details []string `json:"details,omitempty"`
+	// Stack the error stack string, only on DebugMode is true.
+	Stack string `json:"stack,omitempty"`
+}
+
+// NewErrorResponse create an ErrorResponse from the error.
+// will hide the internal details of the error on DebugMode is false,
+// the Message only contains the outermost error message.
+//
+// Usage:
+// 	resp := errorx.NewErrorResponse(err)
+// 	w.Header().Set("Content-Type", "application/json")
+// 	w.WriteHeader(resp.Status)
+// 	_ = json.NewEncoder(w).Encode(resp)
+func NewErrorResponse(err error) *ErrorResponse {
+	status := ToHTTPStatus(err)
+	resp := &ErrorResponse{Status: status, Code: status}
+	if err == nil {
+		resp.Message = http.StatusText(status)
+		return resp
+	}
+
+	if HasCode(err) {
+		resp.Code = CodeOf(err)
+	}
+
+	if DebugMode {
+		resp.Message = err.Error()
+		resp.Details = chainMessages(err)
+
+		// use the first stack in the chain
+		for e := err; e != nil && resp.Stack == ""; e = errors.Unwrap(e) {
+			if ex, ok := e.(*ErrorX); ok {
+				resp.Stack = ex.StackString()
+			}
+		}
+	} else if status >= 500 {
+		resp.Message = http.StatusText(status)
+	} else if resp.Message = outerMessage(err); resp.Message == "" {
+		resp.Message = http.StatusText(status)
+	}
+	return resp
+}
+
+// outerMessage get the first self message in the error chain,
+// will not contain the message of the wrapped errors.
+func outerMessage(err error) string {
+	for e := err; e != nil; e = errors.Unwrap(e) {
+		if msg := selfMessage(e); msg != "" {
+			return msg
+		}
+	}
+	return ""
+}
+
+// chainMessages collect the current messages of the error chain.
+func chainMessages(err error) []string {
+	var ss []string
+	for e := err; e != nil; e = errors.Unwrap(e) {
+		if msg := selfMessage(e); msg != "" {
+			ss = append(ss, msg)
+		}
+	}
+	return ss
+}
@@ -0,0 +1,99 @@
+package errorx_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/gookit/goutil/errorx"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToHTTPStatus(t *testing.T) {
+	tests := []struct {
+		err    error
+		status int
+	}{
+		{nil, 200},
+		{errors.New("an error"), 500},
+		{errorx.Invalid.New("invalid param"), 400},
+		{errorx.With(errorx.NotFound.New("user not found"), "query user"), 404},
+		{errorx.Timeout.New("timeout"), 504},
+		{errorx.Internal.New("db error"), 500},
+		{errorx.WithCode(errors.New("conflict"), 409), 409},
+		{errorx.NewR(403, "forbidden"), 403},
+		{errorx.WithCode(errors.New("custom code"), 1001), 500},
+		{errorx.Wrap(context.DeadlineExceeded, "query"), 504},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.status, errorx.ToHTTPStatus(tt.err), "error: %v", tt.err)
+	}
+}
+
+func TestNewErrorResponse(t *testing.T) {
+	resp := errorx.NewErrorResponse(errorx.NotFound.New("user not found"))
+	assert.Equal(t, 404, resp.Status)
+	assert.Equal(t, errorx.CodeNotFound, resp.Code)
+
+	bs, err := json.Marshal(resp)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"code":5,"message":"user not found"}`, string(bs))
+
+	// hide internal details
+	dbErr := errors.New("dial tcp 10.0.0.1:3306: connection refused")
+	resp = errorx.NewErrorResponse(errorx.With(dbErr, "query user"))
+	assert.Equal(t, 500, resp.Status)
+	bs, err = json.Marshal(resp)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"code":500,"message":"Internal Server Error"}`, string(bs))
+
+	resp = errorx.NewErrorResponse(nil)
+	assert.Equal(t, 200, resp.Code)
+	assert.Equal(t, "OK", resp.Message)
+}
+
+func TestNewErrorResponse_hideCause(t *testing.T) {
+	dbErr := errors.New("dial tcp 10.0.0.1:3306: connection refused")
+
+	resp := errorx.NewErrorResponse(errorx.NotFound.Wrap(errorx.Wrap(dbErr, "user not found")))
+	assert.Equal(t, 404, resp.Status)
+	assert.Equal(t, "user not found", resp.Message)
+
+	resp = errorx.NewErrorResponse(errorx.NotFound.Wrap(fmt.Errorf("load user: %w", dbErr)))
+	assert.Equal(t, "load user", resp.Message)
+
+	resp = errorx.NewErrorResponse(errorx.WithCode(errCfgNotFound.Wrap(dbErr), errorx.CodeNotFound))
+	assert.Equal(t, "config file is missing", resp.Message)
+
+	bs, err := json.Marshal(resp)
+	assert.NoError(t, err)
+	assert.NotContains(t, string(bs), "connection refused")
+}
+
+func TestNewErrorResponse_debug(t *testing.T) {
+	errorx.DebugMode = true
+	defer func() {
+		errorx.DebugMode = false
+	}()
+
+	dbErr := errors.New("connection refused")
+	err := errorx.WithCode(errorx.With(dbErr, "query user"), errorx.CodeInternal)
+
+	resp := errorx.NewErrorResponse(err)
+	assert.Equal(t, 500, resp.Status)
+	assert.Equal(t, errorx.CodeInternal, resp.Code)
+	assert.Equal(t, "query user; connection refused", resp.Message)
+	assert.Equal(t, []string{"query user", "connection refused"}, resp.Details)
+	assert.Contains(t, resp.Stack, "errorx_test.TestNewErrorResponse_debug()")
+
+	bs, err := json.Marshal(resp)
+	assert.NoError(t, err)
+	assert.Contains(t, string(bs), `"details":["query user","connection refused"]`)
+
+	resp = errorx.NewErrorResponse(fmt.Errorf("ctx: %w", dbErr))
+	assert.Equal(t, []string{"ctx", "connection refused"}, resp.Details)
+	assert.Contains(t, string(bs), `"stack":"\nSTACK:\n`)
+}
@@ -0,0 +1,129 @@
+package errorx
+
+import (
+	"errors"
+	"sort"
+	"sync"
+)
+
+// Sentinel a defined sentinel error with stable string key. create by Define()
+//
+// the sentinel errors are comparable, can be used as the target of errors.Is()
+type Sentinel struct {
+	key  string
+	desc string
+}
+
+// Key the stable string key of the error. eg: "config.not_found"
+func (e *Sentinel) Key() string {
+	return e.key
+}
+
+// Desc the description of the error
+func (e *Sentinel) Desc() string {
+	return e.desc
+}
+
+// Error string, is the description.
+func (e *Sentinel) Error() string {
+	return e.desc
+}
+
+// Wrap the error with the sentinel, the result error can match the sentinel by errors.Is()
+//
+// If err is nil, will return the sentinel.
+func (e *Sentinel) Wrap(err error) error {
+	if err == nil {
+		return e
+	}
+	return &sentinelError{sentinel: e, err: err}
+}
+
+// sentinelError wrap an error with the sentinel
+type sentinelError struct {
+	sentinel *Sentinel
+	err      error
+}
+
+// Error string. eg: "config file is missing: open app.yml: no such file"
+func (e *sentinelError) Error() string {
+	return e.sentinel.desc + ": " + e.err.Error()
+}
+
+// Unwrap implements Unwrapper.
+func (e *sentinelError) Unwrap() error {
+	return e.err
+}
+
+// Is check the target is the sentinel. for errors.Is()
+func (e *sentinelError) Is(target error) bool {
+	return target == e.sentinel
+}
+
+var (
+	sentinelMu sync.RWMutex
+	sentinels  = map[string]*Sentinel{}
+)
+
+// Define a sentinel error with stable string key and description.
+// will panic on the key is empty or already defined.
+//
+// Usage:
+// 	var ErrConfigNotFound = errorx.Define("config.not_found", "config file is missing")
+//
+// 	err := ErrConfigNotFound.Wrap(err)
+// 	errors.Is(err, ErrConfigNotFound) // true
+// 	errorx.KeyOf(err) // "config.not_found"
+func Define(key, desc string) *Sentinel {
+	if key == "" {
+		panic("errorx: the sentinel error key cannot be empty")
+	}
+
+	sentinelMu.Lock()
+	defer sentinelMu.Unlock()
+
+	if _, ok := sentinels[key]; ok {
+		panic("errorx: the sentinel error key '" + key + "' is already defined")
+	}
+
+	e := &Sentinel{key: key, desc: desc}
+	sentinels[key] = e
+	return e
+}
+
+// Lookup the defined sentinel error by key
+func Lookup(key string) (*Sentinel, bool) {
+	sentinelMu.RLock()
+	defer sentinelMu.RUnlock()
+
+	e, ok := sentinels[key]
+	return e, ok
+}
+
+// Defined get all defined sentinel errors, sorted by key. useful for generate docs or i18n messages.
+func Defined() []*Sentinel {
+	sentinelMu.RLock()
+	list := make([]*Sentinel, 0, len(sentinels))
+	for _, e := range sentinels {
+		list = append(list, e)
+	}
+	sentinelMu.RUnlock()
+
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].key < list[j].key
+	})
+	return list
+}
+
+// KeyOf get the key of the first sentinel error in the error chain. returns empty on not found.
+func KeyOf(err error) string {
+	for e := err; e != nil; e = errors.Unwrap(e) {
+		switch typErr := e.(type) {
+		case *Sentinel:
+			return typErr.key
+		case *sentinelError:
+			return typErr.sentinel.key
+		}
+	}
+	return ""
+}
@@ -0,0 +1,64 @@
+package errorx_test
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/gookit/goutil/errorx"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	errCfgNotFound = errorx.Define("test.config.not_found", "config file is missing")
+	errCfgInvalid  = errorx.Define("test.config.invalid", "config file is invalid")
+)
+
+func TestDefine(t *testing.T) {
+	assert.Equal(t, "test.config.not_found", errCfgNotFound.Key())
+	assert.Equal(t, "config file is missing", errCfgNotFound.Desc())
+	assert.Equal(t, "config file is missing", errCfgNotFound.Error())
+
+	_, err := os.Open("not-exists.yml")
+	err1 := errCfgNotFound.Wrap(err)
+	assert.Equal(t, "config file is missing: "+err.Error(), err1.Error())
+	assert.True(t, errors.Is(err1, errCfgNotFound))
+	assert.False(t, errors.Is(err1, errCfgInvalid))
+	assert.True(t, errors.Is(err1, os.ErrNotExist))
+	assert.Equal(t, "test.config.not_found", errorx.KeyOf(err1))
+
+	// in the wrap chain
+	err2 := fmt.Errorf("load config: %w", errorx.With(err1, "init app"))
+	assert.True(t, errors.Is(err2, errCfgNotFound))
+	assert.Equal(t, "test.config.not_found", errorx.KeyOf(err2))
+
+	// the sentinel self
+	assert.Equal(t, errCfgInvalid, errCfgInvalid.Wrap(nil))
+	assert.Equal(t, "test.config.invalid", errorx.KeyOf(errorx.Wrap(errCfgInvalid, "parse")))
+	assert.Equal(t, "", errorx.KeyOf(err))
+	assert.Equal(t, "", errorx.KeyOf(nil))
+
+	// duplicate key
+	assert.Panics(t, func() {
+		errorx.Define("test.config.invalid", "other")
+	})
+	assert.Panics(t, func() {
+		errorx.Define("", "empty")
+	})
+}
+
+func TestLookup_Defined(t *testing.T) {
+	e, ok := errorx.Lookup("test.config.invalid")
+	assert.True(t, ok)
+	assert.Equal(t, errCfgInvalid, e)
+
+	_, ok = errorx.Lookup("not-exists")
+	assert.False(t, ok)
+
+	var keys []string
+	for _, e := range errorx.Defined() {
+		keys = append(keys, e.Key())
+	}
+	assert.Equal(t, []string{"test.config.invalid", "test.config.not_found"}, keys)
+}
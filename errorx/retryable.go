@@ -0,0 +1,110 @@
+package errorx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// RetryableChecker interface for check the error is retryable or not
+type RetryableChecker interface {
+	Retryable() bool
+}
+
+// retryableError mark an error as retryable
+type retryableError struct {
+	err error
+}
+
+// MarkRetryable mark the error as retryable. If err is nil, will return nil.
+//
+// Usage:
+// 	if resp.StatusCode == 503 {
+// 		return errorx.MarkRetryable(errors.New("service unavailable"))
+// 	}
+func MarkRetryable(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &retryableError{err: err}
+}
+
+// Retryable always returns true
+func (e *retryableError) Retryable() bool {
+	return true
+}
+
+// Error string
+func (e *retryableError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap implements Unwrapper.
+func (e *retryableError) Unwrap() error {
+	return e.err
+}
+
+// Format error, will format the wrapped error.
+func (e *retryableError) Format(s fmt.State, verb rune) {
+	if fm, ok := e.err.(fmt.Formatter); ok {
+		fm.Format(s, verb)
+		return
+	}
+
+	if verb == 'q' {
+		_, _ = fmt.Fprintf(s, "%q", e.Error())
+		return
+	}
+	_, _ = io.WriteString(s, e.Error())
+}
+
+// Retryable check the error is retryable. will inspect the wrap chain:
+//
+// 	- the first error implements RetryableChecker decides the result. eg: by MarkRetryable()
+// 	- otherwise, returns true on IsTimeout(err) or IsTemporary(err)
+func Retryable(err error) bool {
+	for e := err; e != nil; e = errors.Unwrap(e) {
+		if rc, ok := e.(RetryableChecker); ok {
+			return rc.Retryable()
+		}
+	}
+
+	return IsTimeout(err) || IsTemporary(err)
+}
+
+// IsTimeout check the error is timeout error. will inspect the wrap chain.
+//
+// returns true on any error in the chain:
+// 	- implements Timeout() bool and returns true. eg: net.Error, *os.PathError
+// 	- is context.DeadlineExceeded
+// 	- has the Timeout category code. see WithCode(), Timeout.New()
+func IsTimeout(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, Timeout) {
+		return true
+	}
+
+	for e := err; e != nil; e = errors.Unwrap(e) {
+		if te, ok := e.(interface{ Timeout() bool }); ok && te.Timeout() {
+			return true
+		}
+	}
+	return false
+}
+
+// IsTemporary check the error is temporary error. will inspect the wrap chain.
+//
+// returns true on any error in the chain implements Temporary() bool and returns true.
+// eg: net.Error, *os.SyscallError
+func IsTemporary(err error) bool {
+	for e := err; e != nil; e = errors.Unwrap(e) {
+		if te, ok := e.(interface{ Temporary() bool }); ok && te.Temporary() {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,68 @@
+package errorx_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"syscall"
+	"testing"
+
+	"github.com/gookit/goutil/errorx"
+	"github.com/stretchr/testify/assert"
+)
+
+type notRetryErr struct{}
+
+func (notRetryErr) Error() string   { return "not retryable" }
+func (notRetryErr) Timeout() bool   { return true }
+func (notRetryErr) Retryable() bool { return false }
+
+func TestRetryable(t *testing.T) {
+	assert.Nil(t, errorx.MarkRetryable(nil))
+	assert.False(t, errorx.Retryable(nil))
+
+	err1 := errors.New("service unavailable")
+	assert.False(t, errorx.Retryable(err1))
+
+	err2 := errorx.MarkRetryable(err1)
+	assert.Equal(t, "service unavailable", err2.Error())
+	assert.True(t, errorx.Retryable(err2))
+	assert.True(t, errors.Is(err2, err1))
+	assert.True(t, errorx.Retryable(errorx.With(err2, "call api")))
+	assert.True(t, errorx.Retryable(fmt.Errorf("wrap: %w", err2)))
+
+	// by timeout and temporary
+	assert.True(t, errorx.Retryable(errorx.Wrap(context.DeadlineExceeded, "query")))
+	assert.True(t, errorx.Retryable(&net.DNSError{Err: "lookup", IsTemporary: true}))
+
+	// decided by the checker
+	assert.False(t, errorx.Retryable(notRetryErr{}))
+	assert.True(t, errorx.IsTimeout(notRetryErr{}))
+}
+
+func TestIsTimeout(t *testing.T) {
+	assert.False(t, errorx.IsTimeout(nil))
+	assert.False(t, errorx.IsTimeout(errors.New("an error")))
+
+	assert.True(t, errorx.IsTimeout(context.DeadlineExceeded))
+	assert.True(t, errorx.IsTimeout(errorx.Wrap(context.DeadlineExceeded, "query")))
+	assert.True(t, errorx.IsTimeout(errorx.Timeout.New("read timeout")))
+
+	err := &net.OpError{Op: "read", Net: "tcp", Err: &net.DNSError{Err: "timeout", IsTimeout: true}}
+	assert.True(t, errorx.IsTimeout(errorx.With(err, "request api")))
+
+	err1 := &os.PathError{Op: "read", Path: "/dev/null", Err: syscall.ETIMEDOUT}
+	assert.True(t, errorx.IsTimeout(err1))
+}
+
+func TestIsTemporary(t *testing.T) {
+	assert.False(t, errorx.IsTemporary(nil))
+	assert.False(t, errorx.IsTemporary(errors.New("an error")))
+
+	err := os.NewSyscallError("read", syscall.EAGAIN)
+	assert.True(t, errorx.IsTemporary(errorx.Wrap(err, "read file")))
+	assert.True(t, errorx.IsTemporary(&net.DNSError{Err: "lookup", IsTemporary: true}))
+	assert.False(t, errorx.IsTemporary(&net.DNSError{Err: "no such host"}))
+}
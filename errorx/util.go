@@ -3,6 +3,7 @@ package errorx
 import (
 	"errors"
 	"fmt"
+	"strings"
 )
 
 // Raw new a raw go error. alias of errors.New()
@@ -79,3 +80,27 @@ func To(err error, target interface{}) bool {
 func As(err error, target interface{}) bool {
 	return errors.As(err, target)
 }
+
+// selfMessage get the self message of the error, without the wrapped error message.
+// will return empty string on the error is a wrapper without self message. eg: WithCode()
+func selfMessage(err error) string {
+	switch e := err.(type) {
+	case *ErrorX:
+		return e.msg
+	case *sentinelError:
+		return e.sentinel.desc
+	}
+
+	msg := err.Error()
+	next := errors.Unwrap(err)
+	if next == nil {
+		return msg
+	}
+
+	sub := next.Error()
+	if msg == sub {
+		return ""
+	}
+	// eg: fmt.Errorf("ctx: %w", inner)
+	return strings.TrimSuffix(msg, ": "+sub)
+}
@@ -0,0 +1,135 @@
+package comfunc
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// FieldMeta the cached metadata of a struct field
+type FieldMeta struct {
+	reflect.StructField
+	// Tag the parsed field name and options
+	Tag FieldTag
+}
+
+// Inline the field should be inlined to parent. see FieldTag.Inline()
+func (fm *FieldMeta) Inline() bool {
+	return fm.Tag.Inline(fm.StructField)
+}
+
+// StructMeta the cached metadata of a struct type, shared by the maputil and structs packages.
+type StructMeta struct {
+	Type   reflect.Type
+	Fields []*FieldMeta
+
+	tagNames []string
+	once     sync.Once
+	// the exported field names and tag names => field index, contains the promoted fields.
+	indexes map[string][]int
+	// the exported fields in order, for find by case-insensitive name.
+	names []namedIndex
+}
+
+type namedIndex struct {
+	name  string
+	index []int
+}
+
+// metaKey the key of the metaCache
+type metaKey struct {
+	typ reflect.Type
+	// the joined tag names. eg: "map,json"
+	tags string
+}
+
+// metaCache cache the StructMeta by type and tag names, avoid re-walking the reflect.Type.
+var metaCache sync.Map
+
+// GetStructMeta get the cached metadata of the struct type, the field tags parsed by tagNames.
+func GetStructMeta(t reflect.Type, tagNames []string) *StructMeta {
+	key := metaKey{typ: t, tags: strings.Join(tagNames, ",")}
+	if sm, ok := metaCache.Load(key); ok {
+		return sm.(*StructMeta)
+	}
+
+	sm := &StructMeta{Type: t, tagNames: tagNames, Fields: make([]*FieldMeta, 0, t.NumField())}
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		sm.Fields = append(sm.Fields, &FieldMeta{
+			StructField: sf,
+			Tag:         ParseFieldTag(sf, tagNames),
+		})
+	}
+
+	actual, _ := metaCache.LoadOrStore(key, sm)
+	return actual.(*StructMeta)
+}
+
+// FieldIndex find the field index by: field name, tag name, case-insensitive field name.
+// the promoted fields of the embedded struct can be found. returns nil on not found.
+func (sm *StructMeta) FieldIndex(name string) []int {
+	sm.once.Do(sm.buildIndexes)
+	if idx, ok := sm.indexes[name]; ok {
+		return idx
+	}
+
+	for _, ni := range sm.names {
+		if strings.EqualFold(ni.name, name) {
+			return ni.index
+		}
+	}
+	return nil
+}
+
+// buildIndexes collect the index of all exists names, so the cache size is bounded.
+func (sm *StructMeta) buildIndexes() {
+	sm.indexes = make(map[string][]int)
+	tagIdx := make(map[string][]int)
+	visited := map[reflect.Type]bool{sm.Type: true}
+
+	var walk func(sm *StructMeta, prefix []int)
+	walk = func(m *StructMeta, prefix []int) {
+		for _, fm := range m.Fields {
+			if fm.Tag.Skip {
+				continue
+			}
+
+			idx := append(append([]int{}, prefix...), fm.Index...)
+			if fm.Anonymous && !fm.Tag.Tagged {
+				ft := fm.Type
+				if ft.Kind() == reflect.Ptr {
+					ft = ft.Elem()
+				}
+
+				if ft.Kind() == reflect.Struct && !visited[ft] {
+					visited[ft] = true
+					walk(GetStructMeta(ft, sm.tagNames), idx)
+				}
+				continue
+			}
+
+			if fm.PkgPath != "" {
+				continue
+			}
+
+			if _, ok := tagIdx[fm.Tag.Name]; fm.Tag.Tagged && !ok {
+				tagIdx[fm.Tag.Name] = idx
+			}
+			sm.names = append(sm.names, namedIndex{name: fm.Name, index: idx})
+		}
+	}
+	walk(sm, nil)
+
+	// the field name is higher priority than the tag name
+	for _, ni := range sm.names {
+		if sf, ok := sm.Type.FieldByName(ni.name); ok && sf.PkgPath == "" {
+			sm.indexes[ni.name] = sf.Index
+		}
+	}
+	for name, idx := range tagIdx {
+		if _, ok := sm.indexes[name]; !ok {
+			sm.indexes[name] = idx
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package comfunc
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type metaBase struct {
+	ID   int
+	Name string `json:"base_name"`
+}
+
+type metaNode struct {
+	*metaNode
+	metaBase
+	Title string `json:"title"`
+	Skip  string `json:"-"`
+	inner string
+}
+
+func TestStructMeta_FieldIndex(t *testing.T) {
+	sm := GetStructMeta(reflect.TypeOf(metaNode{}), []string{"json"})
+	assert.True(t, sm == GetStructMeta(reflect.TypeOf(metaNode{}), []string{"json"}))
+	assert.Len(t, sm.Fields, 5)
+
+	assert.Equal(t, []int{1, 0}, sm.FieldIndex("ID"))
+	assert.Equal(t, []int{1, 1}, sm.FieldIndex("base_name"))
+	assert.Equal(t, []int{1, 1}, sm.FieldIndex("name"))
+	assert.Equal(t, []int{2}, sm.FieldIndex("title"))
+	assert.Equal(t, []int{2}, sm.FieldIndex("TITLE"))
+	assert.Nil(t, sm.FieldIndex("Skip"))
+	assert.Nil(t, sm.FieldIndex("inner"))
+
+	// the not found and case-insensitive names will not be cached
+	size := len(sm.indexes)
+	for i := 0; i < 100; i++ {
+		assert.Nil(t, sm.FieldIndex("not_exist"+strconv.Itoa(i)))
+	}
+	assert.Equal(t, []int{2}, sm.FieldIndex("tItLe"))
+	assert.Equal(t, size, len(sm.indexes))
+}
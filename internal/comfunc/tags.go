@@ -0,0 +1,164 @@
+package comfunc
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// TagValue the parsed value of a tag. eg: `json:"name,omitempty"` => Name: "name", Options: ["omitempty"]
+type TagValue struct {
+	// Raw the raw tag value. eg: "name,omitempty"
+	Raw  string
+	Name string
+	// Options the options after the name
+	Options []string
+}
+
+// HasOption check the tag has the option. eg: "omitempty"
+func (tv TagValue) HasOption(opt string) bool {
+	for _, o := range tv.Options {
+		if o == opt {
+			return true
+		}
+	}
+	return false
+}
+
+// Parts split the raw value to n parts by comma, the last part contains the remaining.
+// the parts will be trimmed, and padded with empty string on not enough.
+//
+// eg: "name,n,the description, more" => ["name", "n", "the description, more"]
+func (tv TagValue) Parts(n int) []string {
+	nodes := strings.SplitN(tv.Raw, ",", n)
+	for i, node := range nodes {
+		nodes[i] = strings.TrimSpace(node)
+	}
+
+	for len(nodes) < n {
+		nodes = append(nodes, "")
+	}
+	return nodes
+}
+
+// ParseTagValue parse the tag value by the default format "name,opt1,opt2"
+func ParseTagValue(raw string) TagValue {
+	tv := TagValue{Raw: raw}
+	if raw == "" {
+		return tv
+	}
+
+	nodes := strings.Split(raw, ",")
+	tv.Name = strings.TrimSpace(nodes[0])
+	for _, opt := range nodes[1:] {
+		if opt = strings.TrimSpace(opt); opt != "" {
+			tv.Options = append(tv.Options, opt)
+		}
+	}
+	return tv
+}
+
+// LookupTag find and parse the first exists tag of the tagNames on the field.
+func LookupTag(sf reflect.StructField, tagNames ...string) (TagValue, bool) {
+	for _, tagName := range tagNames {
+		if raw, ok := sf.Tag.Lookup(tagName); ok {
+			return ParseTagValue(raw), true
+		}
+	}
+	return TagValue{}, false
+}
+
+// FieldTag the field name and common options parsed from the tags. eg: `json:"name,omitempty"`
+type FieldTag struct {
+	Name string
+	// Tagged the field name is from tag
+	Tagged    bool
+	OmitEmpty bool
+	// Squash inline the struct field to parent. tag option: squash, inline
+	Squash bool
+	// Skip the field. tag value is "-"
+	Skip bool
+}
+
+// Inline the anonymous(embedded) struct without tag name, or has squash option.
+func (ft FieldTag) Inline(sf reflect.StructField) bool {
+	return ft.Squash || sf.Anonymous && !ft.Tagged
+}
+
+// ParseFieldTag get the field name and options from the first not empty tag of the tagNames,
+// the name is field name on tag not found.
+func ParseFieldTag(sf reflect.StructField, tagNames []string) (ft FieldTag) {
+	for _, tagName := range tagNames {
+		tv := ParseTagValue(sf.Tag.Get(tagName))
+		if tv.Raw == "" {
+			continue
+		}
+		if tv.Raw == "-" {
+			ft.Skip = true
+			return
+		}
+
+		ft.Name = tv.Name
+		ft.OmitEmpty = tv.HasOption("omitempty")
+		ft.Squash = tv.HasOption("squash") || tv.HasOption("inline")
+		break
+	}
+
+	if ft.Name == "" {
+		ft.Name = sf.Name
+	} else {
+		ft.Tagged = true
+	}
+	return
+}
+
+// ParseStructTag parse the struct tag string to key-value pairs, keep the order of tags.
+func ParseStructTag(tag string) ([][2]string, error) {
+	var kvs [][2]string
+
+	// the logic is like the reflect.StructTag.Lookup()
+	for tag != "" {
+		// skip leading space.
+		i := 0
+		for i < len(tag) && tag[i] == ' ' {
+			i++
+		}
+		if tag = tag[i:]; tag == "" {
+			break
+		}
+
+		// scan to colon. a space, a quote or a control character is a syntax error.
+		i = 0
+		for i < len(tag) && tag[i] > ' ' && tag[i] != ':' && tag[i] != '"' && tag[i] != 0x7f {
+			i++
+		}
+		if i == 0 || i+1 >= len(tag) || tag[i] != ':' || tag[i+1] != '"' {
+			return nil, fmt.Errorf("invalid tag syntax at: %s", tag)
+		}
+
+		name := tag[:i]
+		tag = tag[i+1:]
+
+		// scan quoted string to find value.
+		i = 1
+		for i < len(tag) && tag[i] != '"' {
+			if tag[i] == '\\' {
+				i++
+			}
+			i++
+		}
+		if i >= len(tag) {
+			return nil, fmt.Errorf("unclosed quote of the tag %q", name)
+		}
+
+		val, err := strconv.Unquote(tag[:i+1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid value of the tag %q", name)
+		}
+
+		kvs = append(kvs, [2]string{name, val})
+		tag = tag[i+1:]
+	}
+	return kvs, nil
+}
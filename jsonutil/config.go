@@ -0,0 +1,154 @@
+package jsonutil
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/gookit/goutil/maputil"
+)
+
+// ExtendsKey the key for include base config files. the value can be a string or string list.
+// the file path is relative to the current config file.
+var ExtendsKey = "extends"
+
+// EnvGetter the ENV value getter for expand the config values.
+var EnvGetter = os.Getenv
+
+// match: ${VAR} ${VAR:-default}
+var configEnvRegex = regexp.MustCompile(`\$\{(\w+)(:-([^}]*))?}`)
+
+// LoadConfig load a JSON config file to the ptr. features:
+//
+// - allow comments and trailing commas. see CleanJSONC()
+// - expand ENV vars in string values. format: ${VAR}, ${VAR:-default}
+// - include base config files by the "extends" key, the current file will override the base.
+//
+// Usage:
+// 	// config.json: {"extends": "base.json", "db": {"host": "${DB_HOST:-localhost}"}}
+// 	err := jsonutil.LoadConfig("config.json", &conf)
+func LoadConfig(filePath string, ptr interface{}) error {
+	mp, err := loadConfigMap(filePath, make(map[string]bool))
+	if err != nil {
+		return err
+	}
+
+	expanded := ExpandEnv(mp)
+	bs, err := json.Marshal(expanded)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bs, ptr)
+}
+
+// ExpandEnv expand the ENV vars in the string values of the data, will returns new data.
+// allow var format: ${VAR}, ${VAR:-default}
+//
+// Usage:
+// 	val := jsonutil.ExpandEnv("${APP_ENV:-prod}") // "prod"
+func ExpandEnv(data interface{}) interface{} {
+	switch typVal := data.(type) {
+	case string:
+		if !strings.Contains(typVal, "${") {
+			return typVal
+		}
+
+		return configEnvRegex.ReplaceAllStringFunc(typVal, func(s string) string {
+			ss := configEnvRegex.FindStringSubmatch(s)
+			if val := EnvGetter(ss[1]); val != "" {
+				return val
+			}
+			return ss[3]
+		})
+	case map[string]interface{}:
+		mp := make(map[string]interface{}, len(typVal))
+		for key, val := range typVal {
+			mp[key] = ExpandEnv(val)
+		}
+		return mp
+	case []interface{}:
+		list := make([]interface{}, len(typVal))
+		for i, val := range typVal {
+			list[i] = ExpandEnv(val)
+		}
+		return list
+	}
+	return data
+}
+
+// loadConfigMap load the config file and its base files. loading is for check circular extends.
+func loadConfigMap(filePath string, loading map[string]bool) (map[string]interface{}, error) {
+	absPath, err := filepath.Abs(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	if loading[absPath] {
+		return nil, fmt.Errorf("jsonutil: circular extends the config file %q", filePath)
+	}
+
+	loading[absPath] = true
+	defer delete(loading, absPath)
+
+	src, err := ioutil.ReadFile(absPath)
+	if err != nil {
+		return nil, err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(CleanJSONC(src)))
+	dec.UseNumber()
+
+	mp := make(map[string]interface{})
+	if err = dec.Decode(&mp); err != nil {
+		return nil, fmt.Errorf("jsonutil: decode the config file %q error: %w", filePath, err)
+	}
+
+	extends, ok := mp[ExtendsKey]
+	if !ok {
+		return mp, nil
+	}
+
+	bases, err := extendsFiles(extends)
+	if err != nil {
+		return nil, fmt.Errorf("jsonutil: invalid extends in the config file %q: %w", filePath, err)
+	}
+	delete(mp, ExtendsKey)
+
+	// merge order: base1 <- base2 <- current
+	var merged map[string]interface{}
+	for _, base := range bases {
+		if !filepath.IsAbs(base) {
+			base = filepath.Join(filepath.Dir(absPath), base)
+		}
+
+		baseMp, err := loadConfigMap(base, loading)
+		if err != nil {
+			return nil, err
+		}
+		merged = maputil.DeepMerge(merged, baseMp, maputil.MergeOverride)
+	}
+	return maputil.DeepMerge(merged, mp, maputil.MergeOverride), nil
+}
+
+func extendsFiles(extends interface{}) ([]string, error) {
+	switch typVal := extends.(type) {
+	case string:
+		return []string{typVal}, nil
+	case []interface{}:
+		files := make([]string, 0, len(typVal))
+		for _, val := range typVal {
+			file, ok := val.(string)
+			if !ok {
+				return nil, fmt.Errorf("the extends file must be string, but got %T", val)
+			}
+			files = append(files, file)
+		}
+		return files, nil
+	}
+	return nil, fmt.Errorf("the extends must be string or string list, but got %T", extends)
+}
@@ -0,0 +1,99 @@
+package jsonutil_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gookit/goutil/jsonutil"
+	"github.com/gookit/goutil/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+type appConfig struct {
+	Name  string   `json:"name"`
+	Debug bool     `json:"debug"`
+	Tags  []string `json:"tags"`
+	Db    struct {
+		Host string `json:"host"`
+		Port int    `json:"port"`
+		User string `json:"user"`
+	} `json:"db"`
+}
+
+func writeTestFiles(t *testing.T, files map[string]string) string {
+	dir, err := ioutil.TempDir("", "jsonutil")
+	assert.NoError(t, err)
+
+	for name, text := range files {
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, name), []byte(text), 0664))
+	}
+	return dir
+}
+
+func TestLoadConfig(t *testing.T) {
+	dir := writeTestFiles(t, map[string]string{
+		"base.json": `{
+	// the base config
+	"name": "base",
+	"debug": true,
+	"tags": ["a", "b"],
+	"db": {"host": "localhost", "port": 3306, "user": "root",},
+}`,
+		"app.json": `{
+	"extends": "base.json",
+	"name": "${APP_NAME:-app}",
+	/* override */
+	"tags": ["c"],
+	"db": {"host": "${DB_HOST}", "user": "${DB_USER:-admin}"}
+}`,
+	})
+	defer os.RemoveAll(dir)
+
+	conf := &appConfig{}
+	testutil.MockEnvValues(map[string]string{"DB_HOST": "127.0.0.1"}, func() {
+		err := jsonutil.LoadConfig(filepath.Join(dir, "app.json"), conf)
+		assert.NoError(t, err)
+	})
+
+	assert.Equal(t, "app", conf.Name)
+	assert.True(t, conf.Debug)
+	assert.Equal(t, []string{"c"}, conf.Tags)
+	assert.Equal(t, "127.0.0.1", conf.Db.Host)
+	assert.Equal(t, 3306, conf.Db.Port)
+	assert.Equal(t, "admin", conf.Db.User)
+}
+
+func TestLoadConfig_error(t *testing.T) {
+	dir := writeTestFiles(t, map[string]string{
+		"a.json":   `{"extends": ["b.json"]}`,
+		"b.json":   `{"extends": "a.json"}`,
+		"c.json":   `{"extends": 23}`,
+		"d.json":   `{"extends": "not-exist.json"}`,
+		"bad.json": `{"name": }`,
+	})
+	defer os.RemoveAll(dir)
+
+	conf := &appConfig{}
+	err := jsonutil.LoadConfig(filepath.Join(dir, "a.json"), conf)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "circular extends")
+
+	assert.Error(t, jsonutil.LoadConfig(filepath.Join(dir, "c.json"), conf))
+	assert.Error(t, jsonutil.LoadConfig(filepath.Join(dir, "d.json"), conf))
+	assert.Error(t, jsonutil.LoadConfig(filepath.Join(dir, "bad.json"), conf))
+	assert.Error(t, jsonutil.LoadConfig(filepath.Join(dir, "not-exist.json"), conf))
+}
+
+func TestExpandEnv(t *testing.T) {
+	testutil.MockEnvValues(map[string]string{"APP_ENV": "dev"}, func() {
+		assert.Equal(t, "dev", jsonutil.ExpandEnv("${APP_ENV}"))
+		assert.Equal(t, "dev-", jsonutil.ExpandEnv("${APP_ENV:-prod}-${NOT_EXIST_VAR}"))
+		assert.Equal(t, "prod", jsonutil.ExpandEnv("${NOT_EXIST_VAR:-prod}"))
+		assert.Equal(t, 23, jsonutil.ExpandEnv(23))
+
+		val := jsonutil.ExpandEnv([]interface{}{"$APP_ENV", map[string]interface{}{"env": "${APP_ENV}"}})
+		assert.Equal(t, []interface{}{"$APP_ENV", map[string]interface{}{"env": "dev"}}, val)
+	})
+}
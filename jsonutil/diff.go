@@ -0,0 +1,206 @@
+package jsonutil
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	"sort"
+	"strconv"
+)
+
+// diff item kinds
+const (
+	DiffAdded   = "added"
+	DiffRemoved = "removed"
+	DiffChanged = "changed"
+)
+
+// DiffItem a difference of two JSON documents
+type DiffItem struct {
+	// Path the JSON pointer of the changed node. eg: "/db/port", the root is ""
+	Path string
+	// Kind the change kind. allow: DiffAdded, DiffRemoved, DiffChanged
+	Kind string
+	// Before and After values, the number is json.Number
+	Before, After interface{}
+}
+
+// DiffOptions for Diff
+type DiffOptions struct {
+	// StrictNumber compare numbers by the literal text. default compare by value, eg: 1.0 == 1 == 1e0
+	StrictNumber bool
+	// IgnoreArrayOrder compare arrays as unordered collections.
+	IgnoreArrayOrder bool
+}
+
+// Diff compare two JSON documents semantically, returns the changed nodes.
+// the order of object keys and whitespace are always ignored.
+//
+// Usage:
+// 	items, err := jsonutil.Diff(oldJSON, newJSON)
+// 	for _, it := range items {
+// 		fmt.Println(it.Kind, it.Path, it.Before, it.After)
+// 	}
+func Diff(a, b []byte, fns ...func(opt *DiffOptions)) ([]DiffItem, error) {
+	opt := &DiffOptions{}
+	for _, fn := range fns {
+		fn(opt)
+	}
+
+	av, err := decodeUseNumber(a)
+	if err != nil {
+		return nil, err
+	}
+
+	bv, err := decodeUseNumber(b)
+	if err != nil {
+		return nil, err
+	}
+
+	df := &jsonDiffer{opt: opt}
+	df.diff(nil, av, bv)
+	return df.items, nil
+}
+
+// Equal check two JSON documents is semantic equal. see Diff()
+func Equal(a, b []byte, fns ...func(opt *DiffOptions)) (bool, error) {
+	items, err := Diff(a, b, fns...)
+	return len(items) == 0, err
+}
+
+func decodeUseNumber(data []byte) (interface{}, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	var v interface{}
+	err := dec.Decode(&v)
+	return v, err
+}
+
+type jsonDiffer struct {
+	opt   *DiffOptions
+	items []DiffItem
+}
+
+func (df *jsonDiffer) add(path []string, kind string, before, after interface{}) {
+	df.items = append(df.items, DiffItem{
+		Path:   joinPointer(path),
+		Kind:   kind,
+		Before: before,
+		After:  after,
+	})
+}
+
+func (df *jsonDiffer) diff(path []string, a, b interface{}) {
+	switch av := a.(type) {
+	case map[string]interface{}:
+		bv, ok := b.(map[string]interface{})
+		if !ok {
+			df.add(path, DiffChanged, a, b)
+			return
+		}
+
+		keys := make([]string, 0, len(av)+len(bv))
+		for key := range av {
+			keys = append(keys, key)
+		}
+		for key := range bv {
+			if _, ok := av[key]; !ok {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			sub := appendPath(path, key)
+			aSub, aOk := av[key]
+			bSub, bOk := bv[key]
+			if !bOk {
+				df.add(sub, DiffRemoved, aSub, nil)
+			} else if !aOk {
+				df.add(sub, DiffAdded, nil, bSub)
+			} else {
+				df.diff(sub, aSub, bSub)
+			}
+		}
+	case []interface{}:
+		bv, ok := b.([]interface{})
+		if !ok {
+			df.add(path, DiffChanged, a, b)
+			return
+		}
+
+		if df.opt.IgnoreArrayOrder {
+			df.diffUnordered(path, av, bv)
+			return
+		}
+
+		for i := 0; i < len(av) || i < len(bv); i++ {
+			sub := appendPath(path, strconv.Itoa(i))
+			if i >= len(bv) {
+				df.add(sub, DiffRemoved, av[i], nil)
+			} else if i >= len(av) {
+				df.add(sub, DiffAdded, nil, bv[i])
+			} else {
+				df.diff(sub, av[i], bv[i])
+			}
+		}
+	default:
+		if !df.scalarEqual(a, b) {
+			df.add(path, DiffChanged, a, b)
+		}
+	}
+}
+
+// diffUnordered each element of a match an equal element of b, the unmatched will be reported.
+func (df *jsonDiffer) diffUnordered(path []string, a, b []interface{}) {
+	matched := make([]bool, len(b))
+	for i, av := range a {
+		found := false
+		for j, bv := range b {
+			if !matched[j] && df.equal(av, bv) {
+				matched[j], found = true, true
+				break
+			}
+		}
+
+		if !found {
+			df.add(appendPath(path, strconv.Itoa(i)), DiffRemoved, av, nil)
+		}
+	}
+
+	for j, bv := range b {
+		if !matched[j] {
+			df.add(appendPath(path, strconv.Itoa(j)), DiffAdded, nil, bv)
+		}
+	}
+}
+
+func (df *jsonDiffer) equal(a, b interface{}) bool {
+	sub := &jsonDiffer{opt: df.opt}
+	sub.diff(nil, a, b)
+	return len(sub.items) == 0
+}
+
+func (df *jsonDiffer) scalarEqual(a, b interface{}) bool {
+	an, aOk := a.(json.Number)
+	bn, bOk := b.(json.Number)
+	if !aOk || !bOk {
+		return a == b
+	}
+
+	if df.opt.StrictNumber || an == bn {
+		return an == bn
+	}
+
+	ar, aOk := new(big.Rat).SetString(an.String())
+	br, bOk := new(big.Rat).SetString(bn.String())
+	return aOk && bOk && ar.Cmp(br) == 0
+}
+
+// appendPath returns a new path, don't modify the parent path.
+func appendPath(path []string, key string) []string {
+	sub := make([]string, len(path), len(path)+1)
+	copy(sub, path)
+	return append(sub, key)
+}
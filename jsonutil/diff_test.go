@@ -0,0 +1,65 @@
+package jsonutil_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/gookit/goutil/jsonutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiff(t *testing.T) {
+	a := []byte(`{"name": "app", "port": 80, "tags": ["a", "b"], "db": {"host": "localhost", "user": "root"}}`)
+	b := []byte(`{"db": {"host": "127.0.0.1"}, "port": 80.0, "name": "app", "tags": ["a", "b", "c"], "debug": true}`)
+
+	items, err := jsonutil.Diff(a, b)
+	assert.NoError(t, err)
+	assert.Equal(t, []jsonutil.DiffItem{
+		{Path: "/db/host", Kind: jsonutil.DiffChanged, Before: "localhost", After: "127.0.0.1"},
+		{Path: "/db/user", Kind: jsonutil.DiffRemoved, Before: "root"},
+		{Path: "/debug", Kind: jsonutil.DiffAdded, After: true},
+		{Path: "/tags/2", Kind: jsonutil.DiffAdded, After: "c"},
+	}, items)
+
+	// strict number
+	items, err = jsonutil.Diff([]byte(`{"port": 80}`), []byte(`{"port": 8e1}`), func(opt *jsonutil.DiffOptions) {
+		opt.StrictNumber = true
+	})
+	assert.NoError(t, err)
+	assert.Len(t, items, 1)
+	assert.Equal(t, json.Number("80"), items[0].Before)
+	assert.Equal(t, json.Number("8e1"), items[0].After)
+
+	// root changed
+	items, err = jsonutil.Diff([]byte(`[1]`), []byte(`{"a": 1}`))
+	assert.NoError(t, err)
+	assert.Equal(t, "", items[0].Path)
+	assert.Equal(t, jsonutil.DiffChanged, items[0].Kind)
+
+	_, err = jsonutil.Diff([]byte(`{invalid`), b)
+	assert.Error(t, err)
+	_, err = jsonutil.Diff(a, []byte(`{invalid`))
+	assert.Error(t, err)
+}
+
+func TestDiff_ignoreArrayOrder(t *testing.T) {
+	a := []byte(`{"list": [1, {"id": 2}, 3, 3]}`)
+	b := []byte(`{"list": [3, 1, {"id": 2.0}, 4]}`)
+
+	ok, err := jsonutil.Equal(a, b)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	items, err := jsonutil.Diff(a, b, func(opt *jsonutil.DiffOptions) {
+		opt.IgnoreArrayOrder = true
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []jsonutil.DiffItem{
+		{Path: "/list/3", Kind: jsonutil.DiffRemoved, Before: json.Number("3")},
+		{Path: "/list/3", Kind: jsonutil.DiffAdded, After: json.Number("4")},
+	}, items)
+
+	ok, err = jsonutil.Equal([]byte(`{"a": 1, "b": [1, 2]}`), []byte(`{"b": [1, 2.00], "a": 1}`))
+	assert.NoError(t, err)
+	assert.True(t, ok)
+}
@@ -0,0 +1,90 @@
+package jsonutil
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// DecodeC decode the JSONC(JSON with comments) data to ptr.
+// allow the "//" and "/* */" comments, and the trailing commas in object and array.
+//
+// Usage:
+// 	data := []byte(`{
+// 		// the app name
+// 		"name": "app",
+// 		"tags": ["a", "b",],
+// 	}`)
+// 	err := jsonutil.DecodeC(data, &conf)
+func DecodeC(data []byte, ptr interface{}) error {
+	return json.Unmarshal(CleanJSONC(data), ptr)
+}
+
+// CleanJSONC strip the comments and trailing commas from JSONC data, returns the standard JSON.
+//
+// unlike StripComments(), it keeps the line breaks and whitespace, so the error position
+// reported by the decoder still matches the source.
+func CleanJSONC(data []byte) []byte {
+	buf := bytes.NewBuffer(make([]byte, 0, len(data)))
+	// position of the last comma which may be a trailing comma
+	commaPos := -1
+
+	for i := 0; i < len(data); i++ {
+		c := data[i]
+		switch {
+		case c == '"': // string
+			end := skipJSONString(data, i)
+			buf.Write(data[i:end])
+			i = end - 1
+			commaPos = -1
+		case c == '/' && i+1 < len(data) && data[i+1] == '/': // line comment
+			for i < len(data) && data[i] != '\n' {
+				i++
+			}
+			if i < len(data) {
+				buf.WriteByte('\n')
+			}
+		case c == '/' && i+1 < len(data) && data[i+1] == '*': // block comment
+			end := bytes.Index(data[i+2:], []byte("*/"))
+			if end < 0 {
+				return buf.Bytes()
+			}
+
+			// keep line breaks in the comment
+			comment := data[i : i+2+end+2]
+			buf.Write(bytes.Repeat([]byte{'\n'}, bytes.Count(comment, []byte{'\n'})))
+			i += len(comment) - 1
+		case c == ',':
+			commaPos = buf.Len()
+			buf.WriteByte(c)
+		case c == '}' || c == ']':
+			if commaPos >= 0 {
+				// remove the trailing comma, keep the content after it.
+				tail := append([]byte(nil), buf.Bytes()[commaPos+1:]...)
+				buf.Truncate(commaPos)
+				buf.WriteByte(' ')
+				buf.Write(tail)
+				commaPos = -1
+			}
+			buf.WriteByte(c)
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			buf.WriteByte(c)
+		default:
+			commaPos = -1
+			buf.WriteByte(c)
+		}
+	}
+	return buf.Bytes()
+}
+
+// skipJSONString returns the end position(exclusive) of the string starting at the pos
+func skipJSONString(data []byte, pos int) int {
+	for i := pos + 1; i < len(data); i++ {
+		switch data[i] {
+		case '\\':
+			i++
+		case '"':
+			return i + 1
+		}
+	}
+	return len(data)
+}
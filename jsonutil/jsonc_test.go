@@ -0,0 +1,45 @@
+package jsonutil_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/gookit/goutil/jsonutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeC(t *testing.T) {
+	data := []byte(`{
+	// the app name
+	"name": "app // not comment", /* inline */
+	"url": "http://abc.com/*not*/",
+	"escape": "a\"// b",
+	/*
+	 * multi line comments
+	 */
+	"tags": ["a", "b", ], // trailing comma
+	"map": {"k": 1,
+	},
+}
+// end`)
+
+	mp := map[string]interface{}{}
+	err := jsonutil.DecodeC(data, &mp)
+	assert.NoError(t, err)
+	assert.Equal(t, "app // not comment", mp["name"])
+	assert.Equal(t, "http://abc.com/*not*/", mp["url"])
+	assert.Equal(t, `a"// b`, mp["escape"])
+	assert.Equal(t, []interface{}{"a", "b"}, mp["tags"])
+	assert.Equal(t, map[string]interface{}{"k": float64(1)}, mp["map"])
+
+	// error line should be kept
+	err = jsonutil.DecodeC([]byte("{\n// comment\n\"a\": 1,\n\"b\": x}"), &mp)
+	assert.Error(t, err)
+	_, ok := err.(*json.SyntaxError)
+	assert.True(t, ok)
+
+	// not a trailing comma
+	assert.Equal(t, `[1, 2]`, string(jsonutil.CleanJSONC([]byte(`[1, 2]`))))
+	assert.Equal(t, "[1 \n]", string(jsonutil.CleanJSONC([]byte("[1,\n]"))))
+	assert.Equal(t, `{"a": 1}`, string(jsonutil.CleanJSONC([]byte(`{"a": 1}/* unclosed`))))
+}
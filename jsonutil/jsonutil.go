@@ -11,13 +11,28 @@ import (
 	"text/scanner"
 )
 
-// WriteFile write data to JSON file
-func WriteFile(filePath string, data interface{}) error {
-	jsonBytes, err := Encode(data)
+// WriteFile write data to JSON file. can with options, see WriteOptions
+//
+// Usage:
+// 	err := jsonutil.WriteFile("config.json", conf, jsonutil.WithIndent("  "), jsonutil.WithAtomic)
+func WriteFile(filePath string, data interface{}, fns ...func(opt *WriteOptions)) error {
+	opt := &WriteOptions{Perm: 0664}
+	for _, fn := range fns {
+		fn(opt)
+	}
+
+	var jsonBytes []byte
+	var err error
+	if opt.Indent != "" {
+		jsonBytes, err = json.MarshalIndent(data, "", opt.Indent)
+	} else {
+		jsonBytes, err = Encode(data)
+	}
+
 	if err != nil {
 		return err
 	}
-	return ioutil.WriteFile(filePath, jsonBytes, 0664)
+	return writeFile(filePath, jsonBytes, opt)
 }
 
 // ReadFile Read JSON file data
@@ -70,10 +85,77 @@ func DecodeReader(r io.Reader, ptr interface{}) error {
 	return json.NewDecoder(r).Decode(ptr)
 }
 
-// Pretty JSON string and return
+// DefaultIndent for Pretty()
+const DefaultIndent = "    "
+
+// Pretty JSON string and return. if v is []byte or json.RawMessage, will indent it as raw JSON.
 func Pretty(v interface{}) (string, error) {
-	out, err := json.MarshalIndent(v, "", "    ")
-	return string(out), err
+	return PrettyWith(v, DefaultIndent)
+}
+
+// MustPretty data to JSON string, will panic on error
+func MustPretty(v interface{}) string {
+	str, err := Pretty(v)
+	if err != nil {
+		panic(err)
+	}
+	return str
+}
+
+// PrettyWith custom indent. if v is []byte or json.RawMessage, will indent it as raw JSON.
+func PrettyWith(v interface{}, indent string) (string, error) {
+	var raw []byte
+	switch typVal := v.(type) {
+	case []byte:
+		raw = typVal
+	case json.RawMessage:
+		raw = typVal
+	default:
+		out, err := json.MarshalIndent(v, "", indent)
+		return string(out), err
+	}
+
+	buf := &bytes.Buffer{}
+	if err := json.Indent(buf, raw, "", indent); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// Minify the raw JSON data, remove insignificant whitespace.
+func Minify(raw []byte) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	if err := json.Compact(buf, raw); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// PrettyFile read the JSON file src, write the pretty JSON to the dst file.
+// dst can be same as the src. indent default is DefaultIndent.
+//
+// the existing dst file keeps its mode, the new dst file will use the mode of src.
+func PrettyFile(src, dst string, indent ...string) error {
+	fi, err := os.Stat(src)
+	if err != nil {
+		return err
+	}
+
+	raw, err := ioutil.ReadFile(src)
+	if err != nil {
+		return err
+	}
+
+	ind := DefaultIndent
+	if len(indent) > 0 {
+		ind = indent[0]
+	}
+
+	str, err := PrettyWith(raw, ind)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(dst, []byte(str+"\n"), fi.Mode().Perm())
 }
 
 // `(?s:` enable match multi line
@@ -2,6 +2,9 @@ package jsonutil_test
 
 import (
 	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
 	"testing"
 
 	"github.com/gookit/goutil/jsonutil"
@@ -32,6 +35,69 @@ func TestPretty(t *testing.T) {
 	}
 }
 
+func TestPretty_raw(t *testing.T) {
+	want := `{
+    "a": 1
+}`
+	got, err := jsonutil.Pretty([]byte(`{"a":1}`))
+	assert.NoError(t, err)
+	assert.Equal(t, want, got)
+	assert.Equal(t, want, jsonutil.MustPretty(json.RawMessage(`{"a": 1}`)))
+
+	got, err = jsonutil.PrettyWith([]byte(`[1,2]`), "\t")
+	assert.NoError(t, err)
+	assert.Equal(t, "[\n\t1,\n\t2\n]", got)
+
+	_, err = jsonutil.Pretty([]byte(`{invalid`))
+	assert.Error(t, err)
+	assert.Panics(t, func() {
+		jsonutil.MustPretty(func() {})
+	})
+}
+
+func TestMinify(t *testing.T) {
+	bs, err := jsonutil.Minify([]byte(`{
+    "a": 1,
+    "b": [1, 2, "c d"]
+}`))
+	assert.NoError(t, err)
+	assert.Equal(t, `{"a":1,"b":[1,2,"c d"]}`, string(bs))
+
+	_, err = jsonutil.Minify([]byte(`{invalid`))
+	assert.Error(t, err)
+}
+
+func TestPrettyFile(t *testing.T) {
+	src := "testdata/pretty-src.json"
+	dst := "testdata/pretty-dst.json"
+	assert.NoError(t, ioutil.WriteFile(src, []byte(`{"name":"inhere","age":200}`), 0664))
+	defer os.Remove(src)
+	defer os.Remove(dst)
+
+	err := jsonutil.PrettyFile(src, dst, "  ")
+	assert.NoError(t, err)
+
+	bs, err := ioutil.ReadFile(dst)
+	assert.NoError(t, err)
+	assert.Equal(t, "{\n  \"name\": \"inhere\",\n  \"age\": 200\n}\n", string(bs))
+
+	// keep the file mode
+	assert.NoError(t, os.Chmod(src, 0600))
+	assert.NoError(t, jsonutil.PrettyFile(src, src))
+	fi, err := os.Stat(src)
+	assert.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), fi.Mode().Perm())
+
+	// new dst file use the mode of src
+	assert.NoError(t, os.Remove(dst))
+	assert.NoError(t, jsonutil.PrettyFile(src, dst))
+	fi, err = os.Stat(dst)
+	assert.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), fi.Mode().Perm())
+
+	assert.Error(t, jsonutil.PrettyFile("testdata/not-exists.json", dst))
+}
+
 func TestEncode(t *testing.T) {
 	bts, err := jsonutil.Encode(testUser)
 	assert.NoError(t, err)
@@ -93,6 +159,44 @@ func TestWriteReadFile(t *testing.T) {
 	assert.Equal(t, 200, user.Age)
 }
 
+func TestWriteFile_options(t *testing.T) {
+	dir, err := ioutil.TempDir("", "jsonutil")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	fpath := dir + "/sub/conf.json"
+	err = jsonutil.WriteFile(fpath, map[string]int{"age": 20})
+	assert.Error(t, err)
+
+	err = jsonutil.WriteFile(fpath, map[string]int{"age": 20}, jsonutil.WithMkParentDir, jsonutil.WithBackup)
+	assert.NoError(t, err)
+	assert.NoFileExists(t, fpath+jsonutil.BackupExt)
+
+	bs, err := ioutil.ReadFile(fpath)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"age":20}`, string(bs))
+
+	err = jsonutil.WriteFile(fpath, map[string]int{"age": 22}, jsonutil.WithAtomic, jsonutil.WithBackup, jsonutil.WithIndent("  "))
+	assert.NoError(t, err)
+
+	bs, err = ioutil.ReadFile(fpath)
+	assert.NoError(t, err)
+	assert.Equal(t, "{\n  \"age\": 22\n}", string(bs))
+
+	bs, err = ioutil.ReadFile(fpath + jsonutil.BackupExt)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"age":20}`, string(bs))
+
+	// no temp files left
+	files, err := ioutil.ReadDir(dir + "/sub")
+	assert.NoError(t, err)
+	assert.Len(t, files, 2)
+
+	// encode error
+	err = jsonutil.WriteFile(fpath, make(chan int), jsonutil.WithAtomic)
+	assert.Error(t, err)
+}
+
 func TestStripComments(t *testing.T) {
 	is := assert.New(t)
 
@@ -0,0 +1,30 @@
+package jsonutil
+
+import (
+	"github.com/gookit/goutil/maputil"
+)
+
+// MapOptions for MapToStruct
+type MapOptions = maputil.StructOptions
+
+// KeysError the unused map keys or missing struct fields. see MapOptions.ErrorUnused, MapOptions.ErrorMissing
+type KeysError = maputil.KeysError
+
+// MapToStruct bind the map data(eg: decoded from JSON) to a struct pointer, use the "json" tag as field name.
+// the binding and weak type conversion are same as the maputil.ToStruct(). eg: "12" => int 12, 1/0 => bool
+//
+// - support squash embedded struct by tag option. eg: `json:",squash"`
+// - report the unknown keys on MapOptions.ErrorUnused=true
+//
+// Usage:
+// 	err := jsonutil.MapToStruct(mp, &conf, func(opt *jsonutil.MapOptions) {
+// 		opt.ErrorUnused = true
+// 	})
+func MapToStruct(mp map[string]interface{}, ptr interface{}, fns ...func(opt *MapOptions)) error {
+	return maputil.ToStructWith(mp, ptr, func(opt *MapOptions) {
+		opt.TagNames = []string{"json"}
+		for _, fn := range fns {
+			fn(opt)
+		}
+	})
+}
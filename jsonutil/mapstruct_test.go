@@ -0,0 +1,118 @@
+package jsonutil_test
+
+import (
+	"testing"
+
+	"github.com/gookit/goutil/jsonutil"
+	"github.com/stretchr/testify/assert"
+)
+
+type mapBase struct {
+	ID int `json:"id"`
+}
+
+type mapDbConf struct {
+	Host string `json:"host"`
+	Port int    `json:"port"`
+}
+
+type mapConfig struct {
+	Meta   mapBase     `json:",squash"`
+	Name   string      `json:"name"`
+	Debug  bool        `json:"debug"`
+	Rate   float64     `json:"rate"`
+	Db     mapDbConf   `json:"db"`
+	Slaves []mapDbConf `json:"slaves"`
+}
+
+func TestMapToStruct(t *testing.T) {
+	mp := map[string]interface{}{
+		"id":    "23",
+		"name":  "app",
+		"debug": 1,
+		"rate":  "0.5",
+		"db":    map[string]interface{}{"host": "localhost", "port": "3306"},
+		"slaves": []interface{}{
+			map[string]interface{}{"host": "h1", "port": float64(3307)},
+		},
+	}
+
+	conf := &mapConfig{}
+	err := jsonutil.MapToStruct(mp, conf)
+	assert.NoError(t, err)
+	assert.Equal(t, 23, conf.Meta.ID)
+	assert.Equal(t, "app", conf.Name)
+	assert.True(t, conf.Debug)
+	assert.Equal(t, 0.5, conf.Rate)
+	assert.Equal(t, 3306, conf.Db.Port)
+	assert.Len(t, conf.Slaves, 1)
+	assert.Equal(t, 3307, conf.Slaves[0].Port)
+
+	// bool from string and 0
+	conf = &mapConfig{}
+	assert.NoError(t, jsonutil.MapToStruct(map[string]interface{}{"debug": "1"}, conf))
+	assert.True(t, conf.Debug)
+	assert.NoError(t, jsonutil.MapToStruct(map[string]interface{}{"debug": 0}, conf))
+	assert.False(t, conf.Debug)
+
+	// error
+	err = jsonutil.MapToStruct(map[string]interface{}{"db": map[string]interface{}{"port": "abc"}}, conf)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "db.port")
+
+	err = jsonutil.MapToStruct(mp, *conf)
+	assert.Error(t, err)
+}
+
+func TestMapToStruct_errorUnused(t *testing.T) {
+	mp := map[string]interface{}{
+		"id":      1,
+		"name":    "app",
+		"unknown": true,
+		"db":      map[string]interface{}{"host": "localhost", "user": "root"},
+		"slaves":  []interface{}{map[string]interface{}{"name": "s1"}},
+	}
+
+	conf := &mapConfig{}
+	assert.NoError(t, jsonutil.MapToStruct(mp, conf))
+
+	err := jsonutil.MapToStruct(mp, conf, func(opt *jsonutil.MapOptions) {
+		opt.ErrorUnused = true
+	})
+	assert.Error(t, err)
+
+	kErr, ok := err.(*jsonutil.KeysError)
+	assert.True(t, ok)
+	assert.Equal(t, []string{"db.user", "slaves.0.name", "unknown"}, kErr.Unused)
+	assert.Equal(t, "localhost", conf.Db.Host)
+}
+
+func TestMapToStruct_strict(t *testing.T) {
+	strict := func(opt *jsonutil.MapOptions) {
+		opt.Strict = true
+	}
+
+	conf := &mapConfig{}
+	err := jsonutil.MapToStruct(map[string]interface{}{"id": float64(12), "rate": 2}, conf, strict)
+	assert.NoError(t, err)
+	assert.Equal(t, 12, conf.Meta.ID)
+	assert.Equal(t, float64(2), conf.Rate)
+
+	assert.Error(t, jsonutil.MapToStruct(map[string]interface{}{"id": "12"}, conf, strict))
+	assert.Error(t, jsonutil.MapToStruct(map[string]interface{}{"id": 1.5}, conf, strict))
+	assert.Error(t, jsonutil.MapToStruct(map[string]interface{}{"debug": 1}, conf, strict))
+	assert.Error(t, jsonutil.MapToStruct(map[string]interface{}{"name": 1}, conf, strict))
+}
+
+func TestMapToStruct_negativeToUint(t *testing.T) {
+	st := &struct {
+		N uint `json:"n"`
+	}{}
+
+	err := jsonutil.MapToStruct(map[string]interface{}{"n": -1}, st)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), `"n"`)
+	assert.Equal(t, uint(0), st.N)
+
+	assert.Error(t, jsonutil.MapToStruct(map[string]interface{}{"n": float64(-1)}, st))
+}
@@ -0,0 +1,218 @@
+package jsonutil
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ErrPointerNotFound the JSON pointer path not found in the document
+var ErrPointerNotFound = errors.New("jsonutil: the JSON pointer not found")
+
+// ParsePointer parse the JSON pointer(RFC 6901) to reference tokens. eg: "/a/b~1c/0" => ["a", "b/c", "0"]
+func ParsePointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return nil, nil
+	}
+	if pointer[0] != '/' {
+		return nil, fmt.Errorf("jsonutil: invalid JSON pointer %q, must start with '/'", pointer)
+	}
+
+	tokens := strings.Split(pointer[1:], "/")
+	for i, tok := range tokens {
+		tokens[i] = strings.Replace(strings.Replace(tok, "~1", "/", -1), "~0", "~", -1)
+	}
+	return tokens, nil
+}
+
+// GetPointer get the raw value from the JSON document by JSON pointer(RFC 6901).
+// only the nodes on the path will be decoded.
+//
+// Usage:
+// 	raw, err := jsonutil.GetPointer(doc, "/servers/0/host")
+// 	// raw: []byte(`"localhost"`)
+func GetPointer(doc []byte, pointer string) (json.RawMessage, error) {
+	tokens, err := ParsePointer(pointer)
+	if err != nil {
+		return nil, err
+	}
+
+	node := json.RawMessage(bytes.TrimSpace(doc))
+	for i, tok := range tokens {
+		if node, err = childNode(node, tok); err != nil {
+			return nil, fmt.Errorf("%w: %s", err, joinPointer(tokens[:i+1]))
+		}
+	}
+	return node, nil
+}
+
+// SetPointer set the value to the JSON document by JSON pointer(RFC 6901), returns the new document.
+// the last token "-" means append to the array.
+//
+// NOTE: the keys of the objects on the path will be sorted on re-encode.
+func SetPointer(doc []byte, pointer string, val interface{}) ([]byte, error) {
+	tokens, err := ParsePointer(pointer)
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := json.Marshal(val)
+	if err != nil {
+		return nil, err
+	}
+	return setNode(bytes.TrimSpace(doc), tokens, raw, tokens)
+}
+
+func setNode(node json.RawMessage, tokens []string, val json.RawMessage, all []string) (json.RawMessage, error) {
+	if len(tokens) == 0 {
+		return val, nil
+	}
+
+	tok := tokens[0]
+	pathErr := func(err error) error {
+		return fmt.Errorf("%w: %s", err, joinPointer(all[:len(all)-len(tokens)+1]))
+	}
+
+	switch firstByte(node) {
+	case '{':
+		var obj map[string]json.RawMessage
+		if err := json.Unmarshal(node, &obj); err != nil {
+			return nil, err
+		}
+
+		sub, ok := obj[tok]
+		if !ok && len(tokens) > 1 {
+			return nil, pathErr(ErrPointerNotFound)
+		}
+
+		newSub, err := setNode(sub, tokens[1:], val, all)
+		if err != nil {
+			return nil, err
+		}
+		obj[tok] = newSub
+		return json.Marshal(obj)
+	case '[':
+		var arr []json.RawMessage
+		if err := json.Unmarshal(node, &arr); err != nil {
+			return nil, err
+		}
+
+		if tok == "-" && len(tokens) == 1 {
+			return json.Marshal(append(arr, val))
+		}
+
+		idx, err := arrayIndex(tok, len(arr))
+		if err != nil {
+			return nil, pathErr(err)
+		}
+
+		newSub, err := setNode(arr[idx], tokens[1:], val, all)
+		if err != nil {
+			return nil, err
+		}
+		arr[idx] = newSub
+		return json.Marshal(arr)
+	}
+	return nil, pathErr(ErrPointerNotFound)
+}
+
+func childNode(node json.RawMessage, tok string) (json.RawMessage, error) {
+	switch firstByte(node) {
+	case '{':
+		var obj map[string]json.RawMessage
+		if err := json.Unmarshal(node, &obj); err != nil {
+			return nil, err
+		}
+
+		if sub, ok := obj[tok]; ok {
+			return sub, nil
+		}
+	case '[':
+		var arr []json.RawMessage
+		if err := json.Unmarshal(node, &arr); err != nil {
+			return nil, err
+		}
+
+		idx, err := arrayIndex(tok, len(arr))
+		if err != nil {
+			return nil, err
+		}
+		return arr[idx], nil
+	}
+	return nil, ErrPointerNotFound
+}
+
+func arrayIndex(tok string, length int) (int, error) {
+	// leading zeros are not allowed
+	if tok == "" || len(tok) > 1 && tok[0] == '0' {
+		return 0, ErrPointerNotFound
+	}
+
+	idx, err := strconv.Atoi(tok)
+	if err != nil || idx < 0 || idx >= length {
+		return 0, ErrPointerNotFound
+	}
+	return idx, nil
+}
+
+func joinPointer(tokens []string) string {
+	var sb strings.Builder
+	for _, tok := range tokens {
+		sb.WriteByte('/')
+		sb.WriteString(strings.Replace(strings.Replace(tok, "~", "~0", -1), "/", "~1", -1))
+	}
+	return sb.String()
+}
+
+func firstByte(node json.RawMessage) byte {
+	node = bytes.TrimSpace(node)
+	if len(node) == 0 {
+		return 0
+	}
+	return node[0]
+}
+
+// ApplyMergePatch apply the JSON merge patch(RFC 7386) to the document, returns the new document.
+//
+// Usage:
+// 	doc := []byte(`{"a": "b", "c": {"d": "e", "f": "g"}}`)
+// 	patch := []byte(`{"a": "z", "c": {"f": null}}`)
+// 	newDoc, err := jsonutil.ApplyMergePatch(doc, patch)
+// 	// newDoc: {"a":"z","c":{"d":"e"}}
+func ApplyMergePatch(doc, patch []byte) ([]byte, error) {
+	var target, patchVal interface{}
+	if len(bytes.TrimSpace(doc)) > 0 {
+		if err := json.Unmarshal(doc, &target); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := json.Unmarshal(patch, &patchVal); err != nil {
+		return nil, err
+	}
+	return json.Marshal(mergePatch(target, patchVal))
+}
+
+func mergePatch(target, patch interface{}) interface{} {
+	patchObj, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+
+	targetObj, ok := target.(map[string]interface{})
+	if !ok {
+		targetObj = make(map[string]interface{})
+	}
+
+	for key, val := range patchObj {
+		if val == nil {
+			delete(targetObj, key)
+		} else {
+			targetObj[key] = mergePatch(targetObj[key], val)
+		}
+	}
+	return targetObj
+}
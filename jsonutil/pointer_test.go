@@ -0,0 +1,111 @@
+package jsonutil_test
+
+import (
+	"testing"
+
+	"github.com/gookit/goutil/jsonutil"
+	"github.com/stretchr/testify/assert"
+)
+
+var pointerDoc = []byte(`{
+	"foo": ["bar", "baz"],
+	"": 0,
+	"a/b": 1,
+	"m~n": 8,
+	"servers": [{"host": "h1"}, {"host": "h2"}]
+}`)
+
+func TestGetPointer(t *testing.T) {
+	tests := map[string]string{
+		"/foo":            `["bar", "baz"]`,
+		"/foo/0":          `"bar"`,
+		"/":               `0`,
+		"/a~1b":           `1`,
+		"/m~0n":           `8`,
+		"/servers/1/host": `"h2"`,
+	}
+
+	for pointer, want := range tests {
+		raw, err := jsonutil.GetPointer(pointerDoc, pointer)
+		assert.NoError(t, err, pointer)
+		assert.Equal(t, want, string(raw), pointer)
+	}
+
+	raw, err := jsonutil.GetPointer(pointerDoc, "")
+	assert.NoError(t, err)
+	assert.Contains(t, string(raw), `"servers"`)
+
+	for _, pointer := range []string{"/not-exists", "/foo/2", "/foo/01", "/foo/-", "/servers/0/host/x"} {
+		_, err = jsonutil.GetPointer(pointerDoc, pointer)
+		assert.ErrorIs(t, err, jsonutil.ErrPointerNotFound, pointer)
+	}
+
+	_, err = jsonutil.GetPointer(pointerDoc, "foo")
+	assert.Error(t, err)
+	_, err = jsonutil.GetPointer([]byte(`{invalid`), "/a")
+	assert.Error(t, err)
+}
+
+func TestSetPointer(t *testing.T) {
+	doc, err := jsonutil.SetPointer(pointerDoc, "/servers/0/host", "localhost")
+	assert.NoError(t, err)
+	raw, _ := jsonutil.GetPointer(doc, "/servers/0/host")
+	assert.Equal(t, `"localhost"`, string(raw))
+
+	doc, err = jsonutil.SetPointer(doc, "/foo/-", map[string]int{"x": 1})
+	assert.NoError(t, err)
+	raw, _ = jsonutil.GetPointer(doc, "/foo")
+	assert.Equal(t, `["bar","baz",{"x":1}]`, string(raw))
+
+	doc, err = jsonutil.SetPointer(doc, "/new", true)
+	assert.NoError(t, err)
+	raw, _ = jsonutil.GetPointer(doc, "/new")
+	assert.Equal(t, `true`, string(raw))
+
+	doc, err = jsonutil.SetPointer([]byte(`{}`), "", []int{1})
+	assert.NoError(t, err)
+	assert.Equal(t, `[1]`, string(doc))
+
+	_, err = jsonutil.SetPointer(pointerDoc, "/not/exists", 1)
+	assert.ErrorIs(t, err, jsonutil.ErrPointerNotFound)
+	_, err = jsonutil.SetPointer(pointerDoc, "/foo/5", 1)
+	assert.ErrorIs(t, err, jsonutil.ErrPointerNotFound)
+	_, err = jsonutil.SetPointer(pointerDoc, "/a~1b/c", 1)
+	assert.ErrorIs(t, err, jsonutil.ErrPointerNotFound)
+	_, err = jsonutil.SetPointer(pointerDoc, "/a", func() {})
+	assert.Error(t, err)
+}
+
+func TestApplyMergePatch(t *testing.T) {
+	// examples from RFC 7386
+	tests := []struct {
+		doc, patch, want string
+	}{
+		{`{"a":"b"}`, `{"a":"c"}`, `{"a":"c"}`},
+		{`{"a":"b"}`, `{"b":"c"}`, `{"a":"b","b":"c"}`},
+		{`{"a":"b"}`, `{"a":null}`, `{}`},
+		{`{"a":"b","b":"c"}`, `{"a":null}`, `{"b":"c"}`},
+		{`{"a":["b"]}`, `{"a":"c"}`, `{"a":"c"}`},
+		{`{"a":"c"}`, `{"a":["b"]}`, `{"a":["b"]}`},
+		{`{"a":{"b":"c"}}`, `{"a":{"b":"d","c":null}}`, `{"a":{"b":"d"}}`},
+		{`{"a":[{"b":"c"}]}`, `{"a":[1]}`, `{"a":[1]}`},
+		{`["a","b"]`, `["c","d"]`, `["c","d"]`},
+		{`{"a":"b"}`, `["c"]`, `["c"]`},
+		{`{"a":"foo"}`, `null`, `null`},
+		{`{"e":null}`, `{"a":1}`, `{"a":1,"e":null}`},
+		{`[1,2]`, `{"a":"b","c":null}`, `{"a":"b"}`},
+		{`{}`, `{"a":{"bb":{"ccc":null}}}`, `{"a":{"bb":{}}}`},
+		{``, `{"a":1}`, `{"a":1}`},
+	}
+
+	for _, tt := range tests {
+		out, err := jsonutil.ApplyMergePatch([]byte(tt.doc), []byte(tt.patch))
+		assert.NoError(t, err)
+		assert.Equal(t, tt.want, string(out), "doc: %s patch: %s", tt.doc, tt.patch)
+	}
+
+	_, err := jsonutil.ApplyMergePatch([]byte(`{invalid`), []byte(`{}`))
+	assert.Error(t, err)
+	_, err = jsonutil.ApplyMergePatch([]byte(`{}`), []byte(`{invalid`))
+	assert.Error(t, err)
+}
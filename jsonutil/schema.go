@@ -0,0 +1,313 @@
+package jsonutil
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// SchemaError a validation error of the JSON document
+type SchemaError struct {
+	// Path the JSON pointer of the invalid node. eg: "/db/port", the root is ""
+	Path    string
+	Message string
+}
+
+// Error message
+func (e SchemaError) Error() string {
+	if e.Path == "" {
+		return "(root): " + e.Message
+	}
+	return e.Path + ": " + e.Message
+}
+
+// SchemaErrors the validation errors
+type SchemaErrors []SchemaError
+
+// Error message
+func (es SchemaErrors) Error() string {
+	ss := make([]string, len(es))
+	for i, e := range es {
+		ss[i] = e.Error()
+	}
+	return "jsonutil: validate failed: " + strings.Join(ss, "; ")
+}
+
+// Validate the JSON document by a minimal JSON Schema. returns SchemaErrors on validate failed.
+//
+// supported keywords:
+// 	type, enum, required, properties, additionalProperties(bool), items,
+// 	minimum, maximum, minLength, maxLength, pattern, minItems, maxItems
+//
+// Usage:
+// 	schema := `{"type": "object", "required": ["port"], "properties": {"port": {"type": "integer", "maximum": 65535}}}`
+// 	err := jsonutil.Validate(doc, []byte(schema))
+func Validate(doc, schema []byte) error {
+	scv, err := decodeUseNumber(schema)
+	if err != nil {
+		return fmt.Errorf("jsonutil: invalid JSON schema: %w", err)
+	}
+
+	sc, ok := scv.(map[string]interface{})
+	if !ok {
+		return errors.New("jsonutil: the JSON schema must be an object")
+	}
+
+	dv, err := decodeUseNumber(doc)
+	if err != nil {
+		return err
+	}
+
+	v := &schemaValidator{}
+	if err := v.validate(nil, dv, sc); err != nil {
+		return err
+	}
+
+	if len(v.errs) > 0 {
+		return v.errs
+	}
+	return nil
+}
+
+type schemaValidator struct {
+	errs SchemaErrors
+}
+
+func (v *schemaValidator) addf(path []string, format string, args ...interface{}) {
+	v.errs = append(v.errs, SchemaError{Path: joinPointer(path), Message: fmt.Sprintf(format, args...)})
+}
+
+// validate the value by schema, returns error on the schema is invalid.
+func (v *schemaValidator) validate(path []string, val interface{}, sc map[string]interface{}) error {
+	if typ, ok := sc["type"]; ok {
+		types, err := schemaStrings(typ)
+		if err != nil {
+			return err
+		}
+
+		if !matchTypes(val, types) {
+			v.addf(path, "must be %s, but got %s", strings.Join(types, " or "), jsonType(val))
+			// skip other checks on type mismatch
+			return nil
+		}
+	}
+
+	if enum, ok := sc["enum"].([]interface{}); ok {
+		df := &jsonDiffer{opt: &DiffOptions{}}
+		found := false
+		for _, ev := range enum {
+			if df.equal(val, ev) {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			bs, _ := json.Marshal(enum)
+			v.addf(path, "must be one of %s", bs)
+		}
+	}
+
+	switch typVal := val.(type) {
+	case json.Number:
+		return v.checkNumber(path, typVal, sc)
+	case string:
+		return v.checkString(path, typVal, sc)
+	case []interface{}:
+		return v.checkArray(path, typVal, sc)
+	case map[string]interface{}:
+		return v.checkObject(path, typVal, sc)
+	}
+	return nil
+}
+
+func (v *schemaValidator) checkNumber(path []string, num json.Number, sc map[string]interface{}) error {
+	val, _ := new(big.Rat).SetString(num.String())
+	if min, ok, err := schemaNumber(sc, "minimum"); err != nil {
+		return err
+	} else if ok && val.Cmp(min) < 0 {
+		v.addf(path, "must be >= %v", sc["minimum"])
+	}
+
+	if max, ok, err := schemaNumber(sc, "maximum"); err != nil {
+		return err
+	} else if ok && val.Cmp(max) > 0 {
+		v.addf(path, "must be <= %v", sc["maximum"])
+	}
+	return nil
+}
+
+func (v *schemaValidator) checkString(path []string, str string, sc map[string]interface{}) error {
+	length := utf8.RuneCountInString(str)
+	if min, ok, err := schemaInt(sc, "minLength"); err != nil {
+		return err
+	} else if ok && length < min {
+		v.addf(path, "length must be >= %d", min)
+	}
+
+	if max, ok, err := schemaInt(sc, "maxLength"); err != nil {
+		return err
+	} else if ok && length > max {
+		v.addf(path, "length must be <= %d", max)
+	}
+
+	if pattern, ok := sc["pattern"].(string); ok {
+		reg, err := regexp.Compile(pattern)
+		if err != nil {
+			return fmt.Errorf("jsonutil: invalid pattern %q in JSON schema: %w", pattern, err)
+		}
+
+		if !reg.MatchString(str) {
+			v.addf(path, "must match the pattern %q", pattern)
+		}
+	}
+	return nil
+}
+
+func (v *schemaValidator) checkArray(path []string, list []interface{}, sc map[string]interface{}) error {
+	if min, ok, err := schemaInt(sc, "minItems"); err != nil {
+		return err
+	} else if ok && len(list) < min {
+		v.addf(path, "must have at least %d items", min)
+	}
+
+	if max, ok, err := schemaInt(sc, "maxItems"); err != nil {
+		return err
+	} else if ok && len(list) > max {
+		v.addf(path, "must have at most %d items", max)
+	}
+
+	if items, ok := sc["items"].(map[string]interface{}); ok {
+		for i, elem := range list {
+			if err := v.validate(appendPath(path, strconv.Itoa(i)), elem, items); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (v *schemaValidator) checkObject(path []string, obj map[string]interface{}, sc map[string]interface{}) error {
+	if required, ok := sc["required"]; ok {
+		keys, err := schemaStrings(required)
+		if err != nil {
+			return err
+		}
+
+		for _, key := range keys {
+			if _, ok := obj[key]; !ok {
+				v.addf(appendPath(path, key), "is required")
+			}
+		}
+	}
+
+	props, _ := sc["properties"].(map[string]interface{})
+	keys := make([]string, 0, len(obj))
+	for key := range obj {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		prop, ok := props[key]
+		if !ok {
+			if sc["additionalProperties"] == false {
+				v.addf(appendPath(path, key), "is not allowed")
+			}
+			continue
+		}
+
+		propSc, ok := prop.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("jsonutil: the schema of property %q must be an object", key)
+		}
+
+		if err := v.validate(appendPath(path, key), obj[key], propSc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// jsonType get the JSON Schema type name of the value
+func jsonType(val interface{}) string {
+	switch typVal := val.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case string:
+		return "string"
+	case json.Number:
+		if r, ok := new(big.Rat).SetString(typVal.String()); ok && r.IsInt() {
+			return "integer"
+		}
+		return "number"
+	case []interface{}:
+		return "array"
+	}
+	return "object"
+}
+
+func matchTypes(val interface{}, types []string) bool {
+	typ := jsonType(val)
+	for _, t := range types {
+		// integer is also a number
+		if t == typ || t == "number" && typ == "integer" {
+			return true
+		}
+	}
+	return false
+}
+
+func schemaStrings(val interface{}) ([]string, error) {
+	switch typVal := val.(type) {
+	case string:
+		return []string{typVal}, nil
+	case []interface{}:
+		ss := make([]string, 0, len(typVal))
+		for _, elem := range typVal {
+			s, ok := elem.(string)
+			if !ok {
+				return nil, fmt.Errorf("jsonutil: invalid JSON schema value %v, must be string", elem)
+			}
+			ss = append(ss, s)
+		}
+		return ss, nil
+	}
+	return nil, fmt.Errorf("jsonutil: invalid JSON schema value %v, must be string or string list", val)
+}
+
+func schemaNumber(sc map[string]interface{}, key string) (*big.Rat, bool, error) {
+	val, ok := sc[key]
+	if !ok {
+		return nil, false, nil
+	}
+
+	if num, ok := val.(json.Number); ok {
+		if r, ok := new(big.Rat).SetString(num.String()); ok {
+			return r, true, nil
+		}
+	}
+	return nil, false, fmt.Errorf("jsonutil: the %q in JSON schema must be a number", key)
+}
+
+func schemaInt(sc map[string]interface{}, key string) (int, bool, error) {
+	val, ok := sc[key]
+	if !ok {
+		return 0, false, nil
+	}
+
+	if num, ok := val.(json.Number); ok {
+		if i64, err := num.Int64(); err == nil {
+			return int(i64), true, nil
+		}
+	}
+	return 0, false, fmt.Errorf("jsonutil: the %q in JSON schema must be an integer", key)
+}
@@ -0,0 +1,84 @@
+package jsonutil_test
+
+import (
+	"testing"
+
+	"github.com/gookit/goutil/jsonutil"
+	"github.com/stretchr/testify/assert"
+)
+
+var testSchema = []byte(`{
+	"type": "object",
+	"required": ["name", "port"],
+	"additionalProperties": false,
+	"properties": {
+		"name": {"type": "string", "minLength": 2, "maxLength": 10, "pattern": "^[a-z]+$"},
+		"port": {"type": "integer", "minimum": 1, "maximum": 65535},
+		"rate": {"type": "number", "minimum": 0.5},
+		"env": {"enum": ["dev", "prod"]},
+		"tags": {"type": "array", "minItems": 1, "maxItems": 2, "items": {"type": "string"}},
+		"db": {
+			"type": ["object", "null"],
+			"required": ["host"],
+			"properties": {"host": {"type": "string"}}
+		}
+	}
+}`)
+
+func TestValidate(t *testing.T) {
+	doc := []byte(`{"name": "app", "port": 8080, "rate": 1, "env": "dev", "tags": ["a"], "db": {"host": "localhost"}}`)
+	assert.NoError(t, jsonutil.Validate(doc, testSchema))
+	assert.NoError(t, jsonutil.Validate([]byte(`{"name": "app", "port": 80.0, "db": null}`), testSchema))
+
+	doc = []byte(`{
+	"name": "A",
+	"port": 70000,
+	"rate": 0.2,
+	"env": "test",
+	"tags": [1, "b", "c"],
+	"db": {},
+	"other": true
+}`)
+	err := jsonutil.Validate(doc, testSchema)
+	assert.Error(t, err)
+
+	es, ok := err.(jsonutil.SchemaErrors)
+	assert.True(t, ok)
+
+	var paths []string
+	for _, e := range es {
+		paths = append(paths, e.Path)
+	}
+	assert.Equal(t, []string{
+		"/db/host",
+		"/env",
+		"/name", "/name",
+		"/other",
+		"/port",
+		"/rate",
+		"/tags", "/tags/0",
+	}, paths)
+	assert.Contains(t, err.Error(), "/port: must be <= 65535")
+	assert.Contains(t, err.Error(), "/tags/0: must be string, but got integer")
+	assert.Contains(t, err.Error(), `/env: must be one of ["dev","prod"]`)
+
+	err = jsonutil.Validate([]byte(`{"port": 1.5}`), testSchema)
+	assert.Contains(t, err.Error(), "/name: is required")
+	assert.Contains(t, err.Error(), "/port: must be integer, but got number")
+
+	err = jsonutil.Validate([]byte(`[]`), testSchema)
+	assert.Equal(t, "jsonutil: validate failed: (root): must be object, but got array", err.Error())
+}
+
+func TestValidate_invalidSchema(t *testing.T) {
+	doc := []byte(`{"name": "app"}`)
+	assert.Error(t, jsonutil.Validate(doc, []byte(`{invalid`)))
+	assert.Error(t, jsonutil.Validate(doc, []byte(`[]`)))
+	assert.Error(t, jsonutil.Validate([]byte(`{invalid`), testSchema))
+	assert.Error(t, jsonutil.Validate(doc, []byte(`{"type": 1}`)))
+	assert.Error(t, jsonutil.Validate(doc, []byte(`{"required": [1]}`)))
+	assert.Error(t, jsonutil.Validate(doc, []byte(`{"properties": {"name": {"pattern": "[a-"}}}`)))
+	assert.Error(t, jsonutil.Validate(doc, []byte(`{"properties": {"name": {"minLength": "a"}}}`)))
+	assert.Error(t, jsonutil.Validate(doc, []byte(`{"properties": {"name": 1}}`)))
+	assert.Error(t, jsonutil.Validate([]byte(`1`), []byte(`{"minimum": "a"}`)))
+}
@@ -0,0 +1,39 @@
+package jsonutil
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// MarshalSorted encode data to stable JSON bytes, the keys of all objects are sorted.
+//
+// different from json.Marshal(), the struct fields and the output of custom
+// json.Marshaler(eg: json.RawMessage) are also sorted by key.
+// useful for content hashing and golden file tests.
+func MarshalSorted(v interface{}) ([]byte, error) {
+	bs, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	// decode to map[string]interface{}, then encode again, the map keys will be sorted.
+	node, err := decodeUseNumber(bs)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(node)
+}
+
+// MarshalSortedIndent like the MarshalSorted, but with indent.
+func MarshalSortedIndent(v interface{}, indent string) ([]byte, error) {
+	bs, err := MarshalSorted(v)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := &bytes.Buffer{}
+	if err := json.Indent(buf, bs, "", indent); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
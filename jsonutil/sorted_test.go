@@ -0,0 +1,39 @@
+package jsonutil_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/gookit/goutil/jsonutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarshalSorted(t *testing.T) {
+	data := struct {
+		Name  string                 `json:"name"`
+		Age   int                    `json:"age"`
+		Extra map[string]interface{} `json:"extra"`
+		Raw   json.RawMessage        `json:"raw"`
+	}{
+		Name: "inhere",
+		Age:  20,
+		Extra: map[string]interface{}{
+			"z": 1,
+			"a": []interface{}{map[string]interface{}{"y": 2, "b": 1.5}},
+		},
+		Raw: json.RawMessage(`{"z": 1, "a": 12345678901234567890}`),
+	}
+
+	bs, err := jsonutil.MarshalSorted(data)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"age":20,"extra":{"a":[{"b":1.5,"y":2}],"z":1},"name":"inhere","raw":{"a":12345678901234567890,"z":1}}`, string(bs))
+
+	bs, err = jsonutil.MarshalSortedIndent(map[string]int{"b": 2, "a": 1}, "  ")
+	assert.NoError(t, err)
+	assert.Equal(t, "{\n  \"a\": 1,\n  \"b\": 2\n}", string(bs))
+
+	_, err = jsonutil.MarshalSorted(make(chan int))
+	assert.Error(t, err)
+	_, err = jsonutil.MarshalSortedIndent(make(chan int), "  ")
+	assert.Error(t, err)
+}
@@ -0,0 +1,67 @@
+package jsonutil
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// ErrStopStream return it in the stream callback func for stop the stream, it will not be returned.
+var ErrStopStream = errors.New("jsonutil: stop the stream")
+
+// StreamArray decode the large JSON array from the reader, the elements are processed one by one.
+// the fn must decode exactly one element by the dec.Decode() on each call.
+//
+// Usage:
+// 	err := jsonutil.StreamArray(file, func(dec *json.Decoder) error {
+// 		var u User
+// 		if err := dec.Decode(&u); err != nil {
+// 			return err
+// 		}
+// 		return handleUser(u)
+// 	})
+func StreamArray(r io.Reader, fn func(dec *json.Decoder) error) error {
+	dec := json.NewDecoder(r)
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+
+	if tok != json.Delim('[') {
+		return fmt.Errorf("jsonutil: the stream data must be an array, but got %v", tok)
+	}
+
+	for dec.More() {
+		if err := fn(dec); err != nil {
+			if err == ErrStopStream {
+				return nil
+			}
+			return err
+		}
+	}
+
+	// read the ']'
+	_, err = dec.Token()
+	return err
+}
+
+// DecodeEach decode the large JSON array from the reader, each element will be decoded to
+// a new value created by the newFn, then call the fn with it.
+//
+// Usage:
+// 	err := jsonutil.DecodeEach(file, func() interface{} {
+// 		return &User{}
+// 	}, func(v interface{}) error {
+// 		u := v.(*User)
+// 		return handleUser(u)
+// 	})
+func DecodeEach(r io.Reader, newFn func() interface{}, fn func(v interface{}) error) error {
+	return StreamArray(r, func(dec *json.Decoder) error {
+		ptr := newFn()
+		if err := dec.Decode(ptr); err != nil {
+			return err
+		}
+		return fn(ptr)
+	})
+}
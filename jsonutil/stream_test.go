@@ -0,0 +1,68 @@
+package jsonutil_test
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/gookit/goutil/jsonutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStreamArray(t *testing.T) {
+	data := `[{"name":"u1","age":1}, {"name":"u2","age":2}, {"name":"u3","age":3}]`
+
+	var names []string
+	err := jsonutil.StreamArray(strings.NewReader(data), func(dec *json.Decoder) error {
+		u := user{}
+		if err := dec.Decode(&u); err != nil {
+			return err
+		}
+
+		names = append(names, u.Name)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"u1", "u2", "u3"}, names)
+
+	// stop
+	names = names[:0]
+	err = jsonutil.DecodeEach(strings.NewReader(data), func() interface{} {
+		return &user{}
+	}, func(v interface{}) error {
+		names = append(names, v.(*user).Name)
+		if len(names) == 2 {
+			return jsonutil.ErrStopStream
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"u1", "u2"}, names)
+
+	// error
+	testErr := errors.New("test error")
+	err = jsonutil.DecodeEach(strings.NewReader(data), func() interface{} {
+		return &user{}
+	}, func(v interface{}) error {
+		return testErr
+	})
+	assert.Equal(t, testErr, err)
+
+	err = jsonutil.StreamArray(strings.NewReader(`{"a": 1}`), func(dec *json.Decoder) error {
+		return nil
+	})
+	assert.Error(t, err)
+
+	err = jsonutil.DecodeEach(strings.NewReader(`[{"name": 1}]`), func() interface{} {
+		return &user{}
+	}, func(v interface{}) error {
+		return nil
+	})
+	assert.Error(t, err)
+
+	err = jsonutil.StreamArray(strings.NewReader(``), func(dec *json.Decoder) error {
+		return nil
+	})
+	assert.Error(t, err)
+}
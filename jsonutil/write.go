@@ -0,0 +1,122 @@
+package jsonutil
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// BackupExt the file ext for backup the old contents. see WriteOptions.Backup
+var BackupExt = ".bak"
+
+// WriteOptions for WriteFile
+type WriteOptions struct {
+	// Indent for pretty output. default is compact
+	Indent string
+	// Atomic write to a temp file and then rename to the target file,
+	// the target file will not be broken on write failed.
+	Atomic bool
+	// MkParentDir create the parent dirs on not exists.
+	MkParentDir bool
+	// Backup keep the previous contents of the file to the "FILE.bak"
+	Backup bool
+	// Perm the file perm on create. default is 0664
+	Perm os.FileMode
+}
+
+// WithIndent set the indent for write JSON file
+func WithIndent(indent string) func(opt *WriteOptions) {
+	return func(opt *WriteOptions) {
+		opt.Indent = indent
+	}
+}
+
+// WithAtomic set write JSON file by atomic rename
+func WithAtomic(opt *WriteOptions) {
+	opt.Atomic = true
+}
+
+// WithMkParentDir set create parent dirs on write JSON file
+func WithMkParentDir(opt *WriteOptions) {
+	opt.MkParentDir = true
+}
+
+// WithBackup set keep the previous contents on write JSON file
+func WithBackup(opt *WriteOptions) {
+	opt.Backup = true
+}
+
+func writeFile(filePath string, data []byte, opt *WriteOptions) error {
+	if opt.MkParentDir {
+		if err := os.MkdirAll(filepath.Dir(filePath), 0775); err != nil {
+			return err
+		}
+	}
+
+	if opt.Backup {
+		if err := backupFile(filePath); err != nil {
+			return err
+		}
+	}
+
+	if !opt.Atomic {
+		return ioutil.WriteFile(filePath, data, opt.Perm)
+	}
+	return atomicWriteFile(filePath, data, opt.Perm)
+}
+
+// backupFile copy the file contents to FILE.bak, skip on the file not exists.
+func backupFile(filePath string) error {
+	fi, err := os.Stat(filePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	old, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filePath+BackupExt, old, fi.Mode().Perm())
+}
+
+// atomicWriteFile write data to a temp file in the same dir, then rename it to the file.
+func atomicWriteFile(filePath string, data []byte, perm os.FileMode) (err error) {
+	// keep the perm of the exists file
+	if fi, err := os.Stat(filePath); err == nil {
+		perm = fi.Mode().Perm()
+	}
+
+	dir, name := filepath.Split(filePath)
+	if dir == "" {
+		dir = "."
+	}
+
+	tmp, err := ioutil.TempFile(dir, "."+name+".tmp*")
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tmp.Close()
+			_ = os.Remove(tmp.Name())
+		}
+	}()
+
+	if _, err = tmp.Write(data); err != nil {
+		return err
+	}
+	if err = tmp.Sync(); err != nil {
+		return err
+	}
+	if err = tmp.Chmod(perm); err != nil {
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), filePath)
+}
@@ -0,0 +1,60 @@
+//go:build go1.18
+// +build go1.18
+
+package maputil
+
+import (
+	"sort"
+
+	"github.com/gookit/goutil/comdef"
+)
+
+// KV a key-value pair
+type KV[K comparable, V any] struct {
+	Key   K
+	Value V
+}
+
+// CountValues count the occurrences of each value in the slice.
+//
+// Usage:
+// 	counts := maputil.CountValues([]string{"a", "b", "a"}) // {"a": 2, "b": 1}
+func CountValues[T comparable](list []T) map[T]int {
+	counts := make(map[T]int)
+	for _, val := range list {
+		counts[val]++
+	}
+	return counts
+}
+
+// TopN get the top n entries by count in descending. the entries with same count are sorted by key.
+// n <= 0 will return all entries.
+func TopN[K comdef.Ordered](counts map[K]int, n int) []KV[K, int] {
+	list := make([]KV[K, int], 0, len(counts))
+	for key, val := range counts {
+		list = append(list, KV[K, int]{Key: key, Value: val})
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].Value != list[j].Value {
+			return list[i].Value > list[j].Value
+		}
+		return list[i].Key < list[j].Key
+	})
+
+	if n > 0 && n < len(list) {
+		list = list[:n]
+	}
+	return list
+}
+
+// MergeCounts merge multi count maps to a new map, the counts of same key will be summed.
+func MergeCounts[K comparable](ms ...map[K]int) map[K]int {
+	counts := make(map[K]int)
+	for _, mp := range ms {
+		for key, val := range mp {
+			counts[key] += val
+		}
+	}
+	return counts
+}
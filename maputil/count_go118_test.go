@@ -0,0 +1,36 @@
+//go:build go1.18
+// +build go1.18
+
+package maputil_test
+
+import (
+	"testing"
+
+	"github.com/gookit/goutil/maputil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCountValues(t *testing.T) {
+	counts := maputil.CountValues([]string{"a", "b", "a", "c", "a", "b"})
+	assert.Equal(t, map[string]int{"a": 3, "b": 2, "c": 1}, counts)
+	assert.Empty(t, maputil.CountValues([]int(nil)))
+
+	top := maputil.TopN(counts, 2)
+	assert.Equal(t, []maputil.KV[string, int]{{Key: "a", Value: 3}, {Key: "b", Value: 2}}, top)
+	assert.Len(t, maputil.TopN(counts, 0), 3)
+	assert.Len(t, maputil.TopN(counts, 10), 3)
+
+	// same count sorted by key
+	top2 := maputil.TopN(map[int]int{3: 1, 1: 1, 2: 5}, -1)
+	assert.Equal(t, []maputil.KV[int, int]{{2, 5}, {1, 1}, {3, 1}}, top2)
+}
+
+func TestMergeCounts(t *testing.T) {
+	counts := maputil.MergeCounts(
+		map[string]int{"a": 1, "b": 2},
+		map[string]int{"a": 2, "c": 1},
+		nil,
+	)
+	assert.Equal(t, map[string]int{"a": 3, "b": 2, "c": 1}, counts)
+	assert.Empty(t, maputil.MergeCounts[string]())
+}
@@ -1,6 +1,7 @@
 package maputil
 
 import (
+	"github.com/gookit/goutil/arrutil"
 	"github.com/gookit/goutil/mathutil"
 	"github.com/gookit/goutil/strutil"
 )
@@ -8,9 +9,19 @@ import (
 // Data an map data type
 type Data map[string]interface{}
 
-// Get value from the data map
+// Get value from the data map. support get value by key path. eg: "top.sub"
 func (d Data) Get(key string) interface{} {
-	return d[key]
+	val, _ := d.Value(key)
+	return val
+}
+
+// Value get value from the data map, support key path. eg: "top.sub", "top.list.0"
+func (d Data) Value(key string) (interface{}, bool) {
+	val, ok := d[key]
+	if ok {
+		return val, true
+	}
+	return GetByPath(key, d)
 }
 
 // Set value to the data map
@@ -18,60 +29,138 @@ func (d Data) Set(key string, val interface{}) {
 	d[key] = val
 }
 
-// Has value on the data map
+// SetByPath set value to the data map by key path. eg: "top.sub"
+func (d Data) SetByPath(path string, val interface{}) error {
+	return SetByPath(path, d, val)
+}
+
+// Has value on the data map, support key path. eg: "top.sub"
 func (d Data) Has(key string) bool {
-	_, ok := d[key]
+	_, ok := d.Value(key)
 	return ok
 }
 
 // func (d Data) HasValue(val interface{}) bool {
 
-// Int value get
-func (d Data) Int(key string) int {
-	val, ok := d[key]
-	if !ok {
-		return 0
+// Int value get, will return the def value on not exists or convert fail.
+func (d Data) Int(key string, def ...int) int {
+	val, ok := d.Value(key)
+	if ok {
+		if iv, err := mathutil.ToInt(val); err == nil {
+			return iv
+		}
 	}
 
-	return mathutil.MustInt(val)
+	if len(def) > 0 {
+		return def[0]
+	}
+	return 0
 }
 
-// Int64 value get
-func (d Data) Int64(key string) int64 {
-	val, ok := d[key]
-	if !ok {
-		return 0
+// Int64 value get, will return the def value on not exists or convert fail.
+func (d Data) Int64(key string, def ...int64) int64 {
+	val, ok := d.Value(key)
+	if ok {
+		if iv, err := mathutil.ToInt64(val); err == nil {
+			return iv
+		}
 	}
 
-	return mathutil.MustInt64(val)
+	if len(def) > 0 {
+		return def[0]
+	}
+	return 0
 }
 
-// Str value get by key
-func (d Data) Str(key string) string {
-	val, ok := d[key]
-	if !ok {
-		return ""
+// Str value get by key, will return the def value on not exists.
+func (d Data) Str(key string, def ...string) string {
+	val, ok := d.Value(key)
+	if ok {
+		if str, err := strutil.ToString(val); err == nil {
+			return str
+		}
 	}
-	return strutil.MustString(val)
+
+	if len(def) > 0 {
+		return def[0]
+	}
+	return ""
 }
 
-// Bool value get
-func (d Data) Bool(key string) bool {
-	val, ok := d[key]
+// Bool value get, will return the def value on not exists or convert fail.
+func (d Data) Bool(key string, def ...bool) bool {
+	val, ok := d.Value(key)
+	if ok {
+		if bl, ok := val.(bool); ok {
+			return bl
+		}
+
+		if str, err := strutil.ToString(val); err == nil {
+			if bl, err := strutil.ToBool(str); err == nil {
+				return bl
+			}
+		}
+	}
+
+	return len(def) > 0 && def[0]
+}
+
+// StrSlice get value as []string. if value is string, will split by ","
+func (d Data) StrSlice(key string) []string {
+	val, ok := d.Value(key)
 	if !ok {
-		return false
+		return nil
 	}
-	if bl, ok := val.(bool); ok {
-		return bl
+
+	switch typVal := val.(type) {
+	case []string:
+		return typVal
+	case string:
+		return strutil.ToSlice(typVal, ",")
 	}
 
-	str := strutil.MustString(val)
-	return strutil.MustBool(str)
+	ss, err := arrutil.ToStrings(val)
+	if err != nil {
+		return nil
+	}
+	return ss
+}
+
+// Sub get the sub value as Data. will return nil on not exists or not a map value.
+//
+// Usage:
+// 	dbConf := data.Sub("db")
+// 	host := dbConf.Str("host", "localhost")
+func (d Data) Sub(key string) Data {
+	val, ok := d.Value(key)
+	if !ok {
+		return nil
+	}
+
+	switch typVal := val.(type) {
+	case Data:
+		return typVal
+	case map[string]interface{}:
+		return typVal
+	case map[string]string:
+		sub := make(Data, len(typVal))
+		for k, v := range typVal {
+			sub[k] = v
+		}
+		return sub
+	case map[interface{}]interface{}: // decode from yaml
+		sub := make(Data, len(typVal))
+		for k, v := range typVal {
+			sub[strutil.MustString(k)] = v
+		}
+		return sub
+	}
+	return nil
 }
 
 // Default get value from the data map with default value
 func (d Data) Default(key string, def interface{}) interface{} {
-	val, ok := d[key]
+	val, ok := d.Value(key)
 	if ok {
 		return val
 	}
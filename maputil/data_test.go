@@ -46,6 +46,51 @@ func TestData(t *testing.T) {
 	dump.P(mp.StringMap())
 }
 
+func TestData_pathAndDefault(t *testing.T) {
+	mp := maputil.Data{
+		"name": "app",
+		"db": map[string]interface{}{
+			"host":  "localhost",
+			"port":  "3306",
+			"debug": "on",
+			"hosts": []interface{}{"h1", "h2"},
+		},
+		"tags":   "a,b",
+		"labels": map[string]string{"env": "dev"},
+	}
+
+	assert.True(t, mp.Has("db.host"))
+	assert.False(t, mp.Has("db.user"))
+	assert.Equal(t, "localhost", mp.Get("db.host"))
+	assert.Equal(t, "localhost", mp.Str("db.host", "127.0.0.1"))
+	assert.Equal(t, "root", mp.Str("db.user", "root"))
+	assert.Equal(t, 3306, mp.Int("db.port", 80))
+	assert.Equal(t, int64(3306), mp.Int64("db.port"))
+	assert.Equal(t, 80, mp.Int("name", 80))
+	assert.Equal(t, 80, mp.Int("db.notExists", 80))
+	assert.True(t, mp.Bool("db.debug"))
+	assert.True(t, mp.Bool("db.notExists", true))
+	assert.Equal(t, "h2", mp.Str("db.hosts.1"))
+
+	// StrSlice
+	assert.Equal(t, []string{"h1", "h2"}, mp.StrSlice("db.hosts"))
+	assert.Equal(t, []string{"a", "b"}, mp.StrSlice("tags"))
+	assert.Nil(t, mp.StrSlice("notExists"))
+
+	// Sub
+	db := mp.Sub("db")
+	assert.NotNil(t, db)
+	assert.Equal(t, "localhost", db.Str("host"))
+	assert.Equal(t, "dev", mp.Sub("labels").Str("env"))
+	assert.Nil(t, mp.Sub("name"))
+	assert.Nil(t, mp.Sub("notExists"))
+
+	// SetByPath
+	assert.NoError(t, mp.SetByPath("db.user", "root"))
+	assert.Equal(t, "root", mp.Str("db.user"))
+	assert.Equal(t, "root", db.Str("user"))
+}
+
 func TestSMap(t *testing.T) {
 	mp := maputil.SMap{
 		"k1": "23",
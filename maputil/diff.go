@@ -0,0 +1,116 @@
+package maputil
+
+import (
+	"reflect"
+	"sort"
+	"strconv"
+)
+
+// MapDiff the differences of two maps, the key is key path. eg: "db.host", "hosts.0"
+type MapDiff struct {
+	// Added values in the new map
+	Added map[string]interface{}
+	// Removed values in the new map, value is the old value
+	Removed map[string]interface{}
+	// Changed values, value is: [old, new]
+	Changed map[string][2]interface{}
+}
+
+// IsEmpty check there is no changes
+func (d *MapDiff) IsEmpty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// Paths get all changed key paths, sorted by ascending.
+func (d *MapDiff) Paths() []string {
+	paths := make([]string, 0, len(d.Added)+len(d.Removed)+len(d.Changed))
+	for path := range d.Added {
+		paths = append(paths, path)
+	}
+	for path := range d.Removed {
+		paths = append(paths, path)
+	}
+	for path := range d.Changed {
+		paths = append(paths, path)
+	}
+
+	sort.Strings(paths)
+	return paths
+}
+
+// Diff compare two maps recursive. a is old, b is new.
+// will recursive compare the nested map[string]interface{} and []interface{} values.
+//
+// Usage:
+// 	diff := maputil.Diff(oldConf, newConf)
+// 	for path, vs := range diff.Changed {
+// 		log.Printf("config %s changed: %v => %v", path, vs[0], vs[1])
+// 	}
+func Diff(a, b map[string]interface{}) *MapDiff {
+	d := &MapDiff{
+		Added:   make(map[string]interface{}),
+		Removed: make(map[string]interface{}),
+		Changed: make(map[string][2]interface{}),
+	}
+
+	d.diffMap("", a, b)
+	return d
+}
+
+func (d *MapDiff) diffMap(prefix string, a, b map[string]interface{}) {
+	for key, old := range a {
+		path := joinPath(prefix, key)
+		if val, ok := b[key]; ok {
+			d.diffValue(path, old, val)
+		} else {
+			d.Removed[path] = old
+		}
+	}
+
+	for key, val := range b {
+		if _, ok := a[key]; !ok {
+			d.Added[joinPath(prefix, key)] = val
+		}
+	}
+}
+
+func (d *MapDiff) diffValue(path string, old, val interface{}) {
+	switch oldVal := old.(type) {
+	case map[string]interface{}:
+		if newVal, ok := val.(map[string]interface{}); ok {
+			d.diffMap(path, oldVal, newVal)
+			return
+		}
+	case []interface{}:
+		if newVal, ok := val.([]interface{}); ok {
+			d.diffSlice(path, oldVal, newVal)
+			return
+		}
+	}
+
+	if !reflect.DeepEqual(old, val) {
+		d.Changed[path] = [2]interface{}{old, val}
+	}
+}
+
+func (d *MapDiff) diffSlice(prefix string, a, b []interface{}) {
+	for i, old := range a {
+		path := joinPath(prefix, strconv.Itoa(i))
+		if i < len(b) {
+			d.diffValue(path, old, b[i])
+		} else {
+			d.Removed[path] = old
+		}
+	}
+
+	for i := len(a); i < len(b); i++ {
+		d.Added[joinPath(prefix, strconv.Itoa(i))] = b[i]
+	}
+}
+
+func joinPath(prefix, key string) string {
+	if prefix == "" {
+		return key
+	}
+	return prefix + PathSep + key
+}
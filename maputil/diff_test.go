@@ -0,0 +1,52 @@
+package maputil_test
+
+import (
+	"testing"
+
+	"github.com/gookit/goutil/maputil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiff(t *testing.T) {
+	old := map[string]interface{}{
+		"name": "app",
+		"port": 8080,
+		"db": map[string]interface{}{
+			"host": "localhost",
+			"user": "root",
+		},
+		"hosts": []interface{}{"h1", "h2"},
+		"tags":  []string{"a"},
+	}
+	cur := map[string]interface{}{
+		"name": "app",
+		"port": 8090,
+		"db": map[string]interface{}{
+			"host": "127.0.0.1",
+			"pass": "123",
+		},
+		"hosts": []interface{}{"h1", "h3", "h4"},
+		"tags":  []string{"a"},
+		"debug": true,
+	}
+
+	diff := maputil.Diff(old, cur)
+	assert.False(t, diff.IsEmpty())
+	assert.Equal(t, map[string]interface{}{"db.pass": "123", "hosts.2": "h4", "debug": true}, diff.Added)
+	assert.Equal(t, map[string]interface{}{"db.user": "root"}, diff.Removed)
+	assert.Equal(t, map[string][2]interface{}{
+		"port":    {8080, 8090},
+		"db.host": {"localhost", "127.0.0.1"},
+		"hosts.1": {"h2", "h3"},
+	}, diff.Changed)
+	assert.Equal(t, []string{"db.host", "db.pass", "db.user", "debug", "hosts.1", "hosts.2", "port"}, diff.Paths())
+
+	// type changed
+	diff = maputil.Diff(old, map[string]interface{}{"db": "dsn"})
+	assert.Equal(t, old["db"], diff.Changed["db"][0])
+	assert.Contains(t, diff.Removed, "name")
+
+	diff = maputil.Diff(old, old)
+	assert.True(t, diff.IsEmpty())
+	assert.Empty(t, diff.Paths())
+}
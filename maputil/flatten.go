@@ -0,0 +1,132 @@
+package maputil
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Flatten convert the nested map to a flat map, the key is joined by PathSep.
+//
+// Example:
+// 	{"a": {"b": 1}, "c": [{"d": 2}]} => {"a.b": 1, "c.0.d": 2}
+func Flatten(mp map[string]interface{}) map[string]interface{} {
+	return FlattenWith(mp, PathSep)
+}
+
+// FlattenWith convert the nested map to a flat map, the key is joined by the sep.
+// the empty map and slice will be kept as the value.
+func FlattenWith(mp map[string]interface{}, sep string) map[string]interface{} {
+	flatMp := make(map[string]interface{}, len(mp))
+	flattenValue(flatMp, "", reflect.ValueOf(mp), sep)
+	return flatMp
+}
+
+func flattenValue(flatMp map[string]interface{}, prefix string, rv reflect.Value, sep string) {
+	if rv.Kind() == reflect.Interface && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+
+	joinKey := func(key string) string {
+		if prefix == "" {
+			return key
+		}
+		return prefix + sep + key
+	}
+
+	switch rv.Kind() {
+	case reflect.Map:
+		if rv.Len() > 0 {
+			for _, key := range rv.MapKeys() {
+				flattenValue(flatMp, joinKey(toKeyString(key)), rv.MapIndex(key), sep)
+			}
+			return
+		}
+	case reflect.Slice, reflect.Array:
+		// []byte is a scalar value
+		if rv.Len() > 0 && rv.Type().Elem().Kind() != reflect.Uint8 {
+			for i := 0; i < rv.Len(); i++ {
+				flattenValue(flatMp, joinKey(strconv.Itoa(i)), rv.Index(i), sep)
+			}
+			return
+		}
+	}
+
+	if prefix == "" { // top map is empty
+		return
+	}
+
+	if rv.IsValid() {
+		flatMp[prefix] = rv.Interface()
+	} else {
+		flatMp[prefix] = nil
+	}
+}
+
+func toKeyString(key reflect.Value) string {
+	if key.Kind() == reflect.String {
+		return key.String()
+	}
+	return fmt.Sprint(key.Interface())
+}
+
+// Unflatten convert the flat map to a nested map, the key is split by PathSep.
+// the map node that all keys are index(0 ~ n-1) will be converted to []interface{}.
+//
+// Example:
+// 	{"a.b": 1, "c.0.d": 2} => {"a": {"b": 1}, "c": [{"d": 2}]}
+func Unflatten(flatMp map[string]interface{}) map[string]interface{} {
+	return UnflattenWith(flatMp, PathSep)
+}
+
+// UnflattenWith convert the flat map to a nested map, the key is split by the sep.
+func UnflattenWith(flatMp map[string]interface{}, sep string) map[string]interface{} {
+	mp := make(map[string]interface{}, len(flatMp))
+	for key, val := range flatMp {
+		keys := strings.Split(key, sep)
+		node := mp
+		for _, k := range keys[:len(keys)-1] {
+			sub, ok := node[k].(map[string]interface{})
+			if !ok {
+				sub = make(map[string]interface{})
+				node[k] = sub
+			}
+			node = sub
+		}
+
+		// don't override the exists sub node. eg: {"a.b": 1, "a": 2}
+		lastKey := keys[len(keys)-1]
+		if _, ok := node[lastKey].(map[string]interface{}); !ok {
+			node[lastKey] = val
+		}
+	}
+
+	for key, val := range mp {
+		mp[key] = indexMapToSlice(val)
+	}
+	return mp
+}
+
+// indexMapToSlice convert the map that all keys are exactly index(0 ~ n-1) to slice, recursive.
+// the empty map will be kept.
+func indexMapToSlice(val interface{}) interface{} {
+	mp, ok := val.(map[string]interface{})
+	if !ok || len(mp) == 0 {
+		return val
+	}
+
+	for key, sub := range mp {
+		mp[key] = indexMapToSlice(sub)
+	}
+
+	list := make([]interface{}, len(mp))
+	for key, sub := range mp {
+		idx, err := strconv.Atoi(key)
+		if err != nil || idx < 0 || idx >= len(mp) || strconv.Itoa(idx) != key {
+			return mp
+		}
+		list[idx] = sub
+	}
+	return list
+}
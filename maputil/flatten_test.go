@@ -0,0 +1,87 @@
+package maputil_test
+
+import (
+	"testing"
+
+	"github.com/gookit/goutil/maputil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlatten(t *testing.T) {
+	mp := map[string]interface{}{
+		"name": "app",
+		"db": map[string]interface{}{
+			"host":  "localhost",
+			"ports": []int{3306, 3307},
+		},
+		"users": []interface{}{
+			map[string]interface{}{"name": "inhere"},
+			map[string]string{"name": "tom"},
+		},
+		"empty": map[string]interface{}{},
+		"nil":   nil,
+	}
+
+	flatMp := maputil.Flatten(mp)
+	assert.Equal(t, map[string]interface{}{
+		"name":         "app",
+		"db.host":      "localhost",
+		"db.ports.0":   3306,
+		"db.ports.1":   3307,
+		"users.0.name": "inhere",
+		"users.1.name": "tom",
+		"empty":        map[string]interface{}{},
+		"nil":          nil,
+	}, flatMp)
+
+	flatMp = maputil.FlattenWith(map[string]interface{}{
+		"top": map[interface{}]interface{}{"sub": 1, 2: "two"},
+	}, "_")
+	assert.Equal(t, map[string]interface{}{"top_sub": 1, "top_2": "two"}, flatMp)
+
+	assert.Empty(t, maputil.Flatten(nil))
+}
+
+func TestUnflatten(t *testing.T) {
+	mp := maputil.Unflatten(map[string]interface{}{
+		"name":         "app",
+		"db.host":      "localhost",
+		"db.ports.0":   3306,
+		"db.ports.1":   3307,
+		"users.0.name": "inhere",
+		"users.1.name": "tom",
+		"codes.0":      "a",
+		"codes.2":      "c",
+	})
+
+	assert.Equal(t, map[string]interface{}{
+		"name": "app",
+		"db": map[string]interface{}{
+			"host":  "localhost",
+			"ports": []interface{}{3306, 3307},
+		},
+		"users": []interface{}{
+			map[string]interface{}{"name": "inhere"},
+			map[string]interface{}{"name": "tom"},
+		},
+		// not continuous index, keep as map
+		"codes": map[string]interface{}{"0": "a", "2": "c"},
+	}, mp)
+
+	mp = maputil.UnflattenWith(map[string]interface{}{"top_sub": 1}, "_")
+	assert.Equal(t, map[string]interface{}{"top": map[string]interface{}{"sub": 1}}, mp)
+
+	// round trip
+	src := map[string]interface{}{
+		"a": map[string]interface{}{"b": []interface{}{"c", map[string]interface{}{"d": 1}}},
+	}
+	assert.Equal(t, src, maputil.Unflatten(maputil.Flatten(src)))
+
+	// empty map and slice
+	src = map[string]interface{}{
+		"a": map[string]interface{}{},
+		"b": []interface{}{},
+		"c": map[string]interface{}{"d": map[string]interface{}{}},
+	}
+	assert.Equal(t, src, maputil.Unflatten(maputil.Flatten(src)))
+}
@@ -0,0 +1,59 @@
+//go:build go1.18
+// +build go1.18
+
+package maputil
+
+import (
+	"sort"
+
+	"github.com/gookit/goutil/comdef"
+)
+
+// KeysOf get all keys of the map. the order is random.
+func KeysOf[K comparable, V any](mp map[K]V) []K {
+	keys := make([]K, 0, len(mp))
+	for key := range mp {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// ValuesOf get all values of the map. the order is random.
+func ValuesOf[K comparable, V any](mp map[K]V) []V {
+	values := make([]V, 0, len(mp))
+	for _, val := range mp {
+		values = append(values, val)
+	}
+	return values
+}
+
+// SortedKeys get all keys of the map, sorted by ascending.
+func SortedKeys[K comdef.Ordered, V any](mp map[K]V) []K {
+	return KeysBy(mp, func(a, b K) bool {
+		return a < b
+	})
+}
+
+// KeysBy get all keys of the map, sorted by the less func.
+func KeysBy[K comparable, V any](mp map[K]V, less func(a, b K) bool) []K {
+	keys := KeysOf(mp)
+	sort.Slice(keys, func(i, j int) bool {
+		return less(keys[i], keys[j])
+	})
+	return keys
+}
+
+// EachSorted iterate the map by the sorted keys. will stop on fn returns false.
+//
+// Usage:
+// 	maputil.EachSorted(mp, func(key string, val int) bool {
+// 		fmt.Println(key, val)
+// 		return true
+// 	})
+func EachSorted[K comdef.Ordered, V any](mp map[K]V, fn func(key K, val V) bool) {
+	for _, key := range SortedKeys(mp) {
+		if !fn(key, mp[key]) {
+			break
+		}
+	}
+}
@@ -0,0 +1,54 @@
+//go:build go1.18
+// +build go1.18
+
+package maputil_test
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/gookit/goutil/maputil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeysOf(t *testing.T) {
+	mp := map[string]int{"b": 2, "a": 1, "c": 3}
+
+	keys := maputil.KeysOf(mp)
+	sort.Strings(keys)
+	assert.Equal(t, []string{"a", "b", "c"}, keys)
+
+	values := maputil.ValuesOf(mp)
+	sort.Ints(values)
+	assert.Equal(t, []int{1, 2, 3}, values)
+
+	assert.Equal(t, []string{"a", "b", "c"}, maputil.SortedKeys(mp))
+	assert.Equal(t, []int{1, 3, 5}, maputil.SortedKeys(map[int]bool{5: true, 1: false, 3: true}))
+
+	keys = maputil.KeysBy(mp, func(a, b string) bool {
+		return mp[a] > mp[b]
+	})
+	assert.Equal(t, []string{"c", "b", "a"}, keys)
+	assert.Empty(t, maputil.KeysOf(map[string]int(nil)))
+}
+
+func TestEachSorted(t *testing.T) {
+	mp := map[string]int{"b": 2, "a": 1, "c": 3}
+
+	var keys []string
+	var sum int
+	maputil.EachSorted(mp, func(key string, val int) bool {
+		keys = append(keys, key)
+		sum += val
+		return true
+	})
+	assert.Equal(t, []string{"a", "b", "c"}, keys)
+	assert.Equal(t, 6, sum)
+
+	keys = keys[:0]
+	maputil.EachSorted(mp, func(key string, val int) bool {
+		keys = append(keys, key)
+		return key != "b"
+	})
+	assert.Equal(t, []string{"a", "b"}, keys)
+}
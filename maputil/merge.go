@@ -0,0 +1,72 @@
+package maputil
+
+import "reflect"
+
+// MergeStrategy for DeepMerge. can be combined by "|". eg: MergeKeepExisting|MergeAppendSlice
+type MergeStrategy uint8
+
+// merge strategies
+const (
+	// MergeOverride the src value will override the dst value, and replace the dst slice. it is default.
+	MergeOverride MergeStrategy = 0
+	// MergeKeepExisting keep the exists value in the dst, only add the not exists keys.
+	MergeKeepExisting MergeStrategy = 1 << 0
+	// MergeAppendSlice append the src slice to the dst slice, instead of replace it.
+	MergeAppendSlice MergeStrategy = 1 << 1
+)
+
+// DeepMerge merge the src map to the dst map, will recursive merge the nested map[string]interface{}.
+// will create new map on the dst is nil. returns the merged dst map.
+//
+// Usage:
+// 	// defaults <- file config <- env config
+// 	conf := maputil.DeepMerge(defaults, fileConf, maputil.MergeOverride)
+// 	conf = maputil.DeepMerge(conf, envConf, maputil.MergeOverride)
+func DeepMerge(dst, src map[string]interface{}, strategy MergeStrategy) map[string]interface{} {
+	if dst == nil {
+		dst = make(map[string]interface{}, len(src))
+	}
+
+	for key, sv := range src {
+		dv, ok := dst[key]
+		if !ok {
+			dst[key] = copyMergeValue(sv)
+			continue
+		}
+
+		dst[key] = mergeValue(dv, sv, strategy)
+	}
+	return dst
+}
+
+func mergeValue(dv, sv interface{}, strategy MergeStrategy) interface{} {
+	// both is map, merge them
+	if dm, ok := dv.(map[string]interface{}); ok {
+		if sm, ok := sv.(map[string]interface{}); ok {
+			return DeepMerge(dm, sm, strategy)
+		}
+	}
+
+	if strategy&MergeAppendSlice > 0 {
+		drv, srv := reflect.ValueOf(dv), reflect.ValueOf(sv)
+		if drv.Kind() == reflect.Slice && srv.Kind() == reflect.Slice && drv.Type() == srv.Type() {
+			// make new slice, don't modify the underlying array of the dst
+			newSl := reflect.MakeSlice(drv.Type(), 0, drv.Len()+srv.Len())
+			newSl = reflect.AppendSlice(newSl, drv)
+			return reflect.AppendSlice(newSl, srv).Interface()
+		}
+	}
+
+	if strategy&MergeKeepExisting > 0 {
+		return dv
+	}
+	return copyMergeValue(sv)
+}
+
+// copyMergeValue copy the map value, avoid to modify the src map on merge later.
+func copyMergeValue(val interface{}) interface{} {
+	if mp, ok := val.(map[string]interface{}); ok {
+		return DeepMerge(nil, mp, MergeOverride)
+	}
+	return val
+}
@@ -0,0 +1,62 @@
+package maputil_test
+
+import (
+	"testing"
+
+	"github.com/gookit/goutil/maputil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeepMerge(t *testing.T) {
+	newDst := func() map[string]interface{} {
+		return map[string]interface{}{
+			"name": "app",
+			"tags": []string{"a"},
+			"db": map[string]interface{}{
+				"host": "localhost",
+				"port": 3306,
+			},
+		}
+	}
+	src := map[string]interface{}{
+		"name":  "new-app",
+		"debug": true,
+		"tags":  []string{"b"},
+		"db": map[string]interface{}{
+			"port": 3307,
+			"user": "root",
+		},
+		"log": map[string]interface{}{"level": "info"},
+	}
+
+	// override
+	mp := maputil.DeepMerge(newDst(), src, maputil.MergeOverride)
+	assert.Equal(t, "new-app", mp["name"])
+	assert.Equal(t, true, mp["debug"])
+	assert.Equal(t, []string{"b"}, mp["tags"])
+	assert.Equal(t, map[string]interface{}{"host": "localhost", "port": 3307, "user": "root"}, mp["db"])
+
+	// the src map should not be modified
+	assert.NoError(t, maputil.SetByPath("log.level", mp, "debug"))
+	assert.Equal(t, map[string]interface{}{"level": "info"}, src["log"])
+
+	// keep existing
+	mp = maputil.DeepMerge(newDst(), src, maputil.MergeKeepExisting)
+	assert.Equal(t, "app", mp["name"])
+	assert.Equal(t, true, mp["debug"])
+	assert.Equal(t, []string{"a"}, mp["tags"])
+	assert.Equal(t, map[string]interface{}{"host": "localhost", "port": 3306, "user": "root"}, mp["db"])
+
+	// append slice
+	mp = maputil.DeepMerge(newDst(), src, maputil.MergeAppendSlice)
+	assert.Equal(t, "new-app", mp["name"])
+	assert.Equal(t, []string{"a", "b"}, mp["tags"])
+
+	mp = maputil.DeepMerge(newDst(), src, maputil.MergeKeepExisting|maputil.MergeAppendSlice)
+	assert.Equal(t, "app", mp["name"])
+	assert.Equal(t, []string{"a", "b"}, mp["tags"])
+
+	// nil dst
+	mp = maputil.DeepMerge(nil, src, maputil.MergeOverride)
+	assert.Len(t, mp, len(src))
+}
@@ -0,0 +1,168 @@
+//go:build go1.18
+// +build go1.18
+
+package maputil
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// OrderedMap an insertion-ordered map. NOTE: it is not concurrency-safe.
+//
+// Usage:
+// 	om := maputil.NewOrderedMap[string, int]()
+// 	om.Set("b", 2)
+// 	om.Set("a", 1)
+// 	bs, err := json.Marshal(om) // {"b":2,"a":1}
+type OrderedMap[K comparable, V any] struct {
+	keys   []K
+	values map[K]V
+}
+
+// NewOrderedMap create a new OrderedMap
+func NewOrderedMap[K comparable, V any]() *OrderedMap[K, V] {
+	return &OrderedMap[K, V]{values: make(map[K]V)}
+}
+
+// Set value by key. if the key exists, will update the value and keep the position.
+func (om *OrderedMap[K, V]) Set(key K, val V) {
+	if om.values == nil {
+		om.values = make(map[K]V)
+	}
+
+	if _, ok := om.values[key]; !ok {
+		om.keys = append(om.keys, key)
+	}
+	om.values[key] = val
+}
+
+// Get value by key
+func (om *OrderedMap[K, V]) Get(key K) (V, bool) {
+	val, ok := om.values[key]
+	return val, ok
+}
+
+// Has key check
+func (om *OrderedMap[K, V]) Has(key K) bool {
+	_, ok := om.values[key]
+	return ok
+}
+
+// Delete value by key, returns false on key not exists.
+func (om *OrderedMap[K, V]) Delete(key K) bool {
+	if _, ok := om.values[key]; !ok {
+		return false
+	}
+
+	delete(om.values, key)
+	for i, k := range om.keys {
+		if k == key {
+			om.keys = append(om.keys[:i], om.keys[i+1:]...)
+			break
+		}
+	}
+	return true
+}
+
+// Len of the map
+func (om *OrderedMap[K, V]) Len() int {
+	return len(om.keys)
+}
+
+// Keys get all keys by insertion order
+func (om *OrderedMap[K, V]) Keys() []K {
+	return append([]K(nil), om.keys...)
+}
+
+// Values get all values by insertion order
+func (om *OrderedMap[K, V]) Values() []V {
+	values := make([]V, 0, len(om.keys))
+	for _, key := range om.keys {
+		values = append(values, om.values[key])
+	}
+	return values
+}
+
+// Each iterate the map by insertion order. will stop on fn returns false.
+func (om *OrderedMap[K, V]) Each(fn func(key K, val V) bool) {
+	for _, key := range om.keys {
+		if !fn(key, om.values[key]) {
+			break
+		}
+	}
+}
+
+// MarshalJSON to JSON object, will keep the keys order.
+func (om *OrderedMap[K, V]) MarshalJSON() ([]byte, error) {
+	buf := &bytes.Buffer{}
+	buf.WriteByte('{')
+
+	for i, key := range om.keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+
+		// JSON object key must be a string
+		keyStr := fmt.Sprint(key)
+		if rv := reflect.ValueOf(key); rv.Kind() == reflect.String {
+			keyStr = rv.String()
+		}
+
+		kb, err := json.Marshal(keyStr)
+		if err != nil {
+			return nil, err
+		}
+
+		vb, err := json.Marshal(om.values[key])
+		if err != nil {
+			return nil, err
+		}
+
+		buf.Write(kb)
+		buf.WriteByte(':')
+		buf.Write(vb)
+	}
+
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON from JSON object, will keep the keys order. only support the key type is string.
+func (om *OrderedMap[K, V]) UnmarshalJSON(bs []byte) error {
+	var zeroKey K
+	keyTyp := reflect.TypeOf(zeroKey)
+	if keyTyp.Kind() != reflect.String {
+		return errors.New("maputil: OrderedMap only support unmarshal JSON for string key type")
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(bs))
+	if tok, err := dec.Token(); err != nil {
+		return err
+	} else if tok != json.Delim('{') {
+		return errors.New("maputil: OrderedMap JSON data must be an object")
+	}
+
+	om.keys = om.keys[:0]
+	om.values = make(map[K]V)
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+
+		var val V
+		if err := dec.Decode(&val); err != nil {
+			return err
+		}
+
+		key := reflect.ValueOf(tok).Convert(keyTyp).Interface().(K)
+		om.Set(key, val)
+	}
+
+	_, err := dec.Token() // read '}'
+	return err
+}
@@ -0,0 +1,71 @@
+//go:build go1.18
+// +build go1.18
+
+package maputil_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/gookit/goutil/maputil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrderedMap(t *testing.T) {
+	om := maputil.NewOrderedMap[string, int]()
+	om.Set("c", 3)
+	om.Set("a", 1)
+	om.Set("b", 2)
+	om.Set("a", 10)
+
+	assert.Equal(t, 3, om.Len())
+	assert.Equal(t, []string{"c", "a", "b"}, om.Keys())
+	assert.Equal(t, []int{3, 10, 2}, om.Values())
+	assert.True(t, om.Has("a"))
+
+	val, ok := om.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, 10, val)
+	_, ok = om.Get("not-exists")
+	assert.False(t, ok)
+
+	var keys []string
+	om.Each(func(key string, val int) bool {
+		keys = append(keys, key)
+		return len(keys) < 2
+	})
+	assert.Equal(t, []string{"c", "a"}, keys)
+
+	assert.True(t, om.Delete("a"))
+	assert.False(t, om.Delete("a"))
+	assert.Equal(t, []string{"c", "b"}, om.Keys())
+
+	// zero value is usable
+	var om2 maputil.OrderedMap[int, string]
+	om2.Set(2, "two")
+	om2.Set(1, "one")
+	bs, err := json.Marshal(&om2)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"2":"two","1":"one"}`, string(bs))
+	assert.Error(t, json.Unmarshal(bs, &om2))
+}
+
+func TestOrderedMap_JSON(t *testing.T) {
+	om := maputil.NewOrderedMap[string, interface{}]()
+	om.Set("name", "app")
+	om.Set("port", 8080)
+	om.Set("hosts", []string{"h1"})
+
+	bs, err := json.Marshal(om)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"name":"app","port":8080,"hosts":["h1"]}`, string(bs))
+
+	om2 := maputil.NewOrderedMap[string, int]()
+	err = json.Unmarshal([]byte(`{"z": 1, "y": 2, "x": 3}`), om2)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"z", "y", "x"}, om2.Keys())
+	assert.Equal(t, []int{1, 2, 3}, om2.Values())
+
+	assert.Error(t, json.Unmarshal([]byte(`[1]`), om2))
+	assert.Error(t, json.Unmarshal([]byte(`{"a": "not-int"}`), om2))
+}
@@ -0,0 +1,257 @@
+package maputil
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// PathSep the separator for key path. eg: "top.sub"
+const PathSep = "."
+
+// SetByPath set value to a map[string]interface{} by key path. eg: "top" "top.sub" "top.list.0.name"
+//
+// will create the intermediate map[string]interface{} on the node not exists.
+// for slice node, the key must be an index, and index == len will append value.
+//
+// Usage:
+// 	mp := map[string]interface{}{}
+// 	err := maputil.SetByPath("db.hosts.0.name", mp, "localhost")
+func SetByPath(path string, mp map[string]interface{}, val interface{}) error {
+	if mp == nil {
+		return errors.New("maputil: cannot set value to nil map")
+	}
+
+	if path == "" {
+		return errors.New("maputil: the key path cannot be empty")
+	}
+
+	_, err := setByKeys(mp, strings.Split(path, PathSep), val)
+	return err
+}
+
+// DeleteByPath delete value from a map[string]interface{} by key path. eg: "top.sub" "top.list.0"
+//
+// returns false on the key path not found.
+func DeleteByPath(path string, mp map[string]interface{}) bool {
+	if mp == nil || path == "" {
+		return false
+	}
+
+	_, ok := deleteByKeys(mp, strings.Split(path, PathSep))
+	return ok
+}
+
+// setByKeys set value to the node, returns the new node(maybe changed on append to slice)
+func setByKeys(node interface{}, keys []string, val interface{}) (interface{}, error) {
+	if len(keys) == 0 {
+		return val, nil
+	}
+
+	key, rest := keys[0], keys[1:]
+	switch typNode := node.(type) {
+	case nil: // create intermediate map
+		child, err := setByKeys(nil, rest, val)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{key: child}, nil
+	case map[string]interface{}:
+		child, err := setByKeys(typNode[key], rest, val)
+		if err != nil {
+			return nil, err
+		}
+		typNode[key] = child
+		return typNode, nil
+	case map[interface{}]interface{}: // decode from yaml
+		child, err := setByKeys(typNode[key], rest, val)
+		if err != nil {
+			return nil, err
+		}
+		typNode[key] = child
+		return typNode, nil
+	case []interface{}:
+		idx, err := sliceIndex(key, len(typNode))
+		if err != nil {
+			return nil, err
+		}
+
+		if idx == len(typNode) {
+			typNode = append(typNode, nil)
+		}
+
+		child, err := setByKeys(typNode[idx], rest, val)
+		if err != nil {
+			return nil, err
+		}
+		typNode[idx] = child
+		return typNode, nil
+	}
+
+	// other typed map or slice. eg: map[string]string, []string
+	rv := reflect.ValueOf(node)
+	switch rv.Kind() {
+	case reflect.Map:
+		if rv.Type().Key().Kind() != reflect.String {
+			return nil, fmt.Errorf("maputil: cannot set key %q on the %T", key, node)
+		}
+
+		mk := reflect.ValueOf(key).Convert(rv.Type().Key())
+		var sub interface{}
+		if mv := rv.MapIndex(mk); mv.IsValid() {
+			sub = mv.Interface()
+		}
+
+		child, err := setByKeys(sub, rest, val)
+		if err != nil {
+			return nil, err
+		}
+
+		cv, err := convertTo(child, rv.Type().Elem())
+		if err != nil {
+			return nil, err
+		}
+		rv.SetMapIndex(mk, cv)
+		return node, nil
+	case reflect.Slice:
+		idx, err := sliceIndex(key, rv.Len())
+		if err != nil {
+			return nil, err
+		}
+
+		if idx == rv.Len() {
+			rv = reflect.Append(rv, reflect.Zero(rv.Type().Elem()))
+		}
+
+		child, err := setByKeys(rv.Index(idx).Interface(), rest, val)
+		if err != nil {
+			return nil, err
+		}
+
+		cv, err := convertTo(child, rv.Type().Elem())
+		if err != nil {
+			return nil, err
+		}
+		rv.Index(idx).Set(cv)
+		return rv.Interface(), nil
+	}
+
+	return nil, fmt.Errorf("maputil: cannot set key %q on the %T value", key, node)
+}
+
+// deleteByKeys delete value from the node, returns the new node(maybe changed on delete from slice)
+func deleteByKeys(node interface{}, keys []string) (interface{}, bool) {
+	key, rest := keys[0], keys[1:]
+
+	switch typNode := node.(type) {
+	case map[string]interface{}:
+		sub, ok := typNode[key]
+		if !ok {
+			return node, false
+		}
+
+		if len(rest) == 0 {
+			delete(typNode, key)
+			return typNode, true
+		}
+
+		if sub, ok = deleteByKeys(sub, rest); ok {
+			typNode[key] = sub
+		}
+		return typNode, ok
+	case map[interface{}]interface{}:
+		sub, ok := typNode[key]
+		if !ok {
+			return node, false
+		}
+
+		if len(rest) == 0 {
+			delete(typNode, key)
+			return typNode, true
+		}
+
+		if sub, ok = deleteByKeys(sub, rest); ok {
+			typNode[key] = sub
+		}
+		return typNode, ok
+	}
+
+	rv := reflect.ValueOf(node)
+	switch rv.Kind() {
+	case reflect.Map:
+		if rv.Type().Key().Kind() != reflect.String {
+			return node, false
+		}
+
+		mk := reflect.ValueOf(key).Convert(rv.Type().Key())
+		mv := rv.MapIndex(mk)
+		if !mv.IsValid() {
+			return node, false
+		}
+
+		if len(rest) == 0 {
+			rv.SetMapIndex(mk, reflect.Value{})
+			return node, true
+		}
+
+		sub, ok := deleteByKeys(mv.Interface(), rest)
+		if ok {
+			if cv, err := convertTo(sub, rv.Type().Elem()); err == nil {
+				rv.SetMapIndex(mk, cv)
+			}
+		}
+		return node, ok
+	case reflect.Slice:
+		idx, err := strconv.Atoi(key)
+		if err != nil || idx < 0 || idx >= rv.Len() {
+			return node, false
+		}
+
+		if len(rest) == 0 {
+			newSl := reflect.AppendSlice(rv.Slice(0, idx), rv.Slice(idx+1, rv.Len()))
+			return newSl.Interface(), true
+		}
+
+		sub, ok := deleteByKeys(rv.Index(idx).Interface(), rest)
+		if ok {
+			if cv, err := convertTo(sub, rv.Type().Elem()); err == nil {
+				rv.Index(idx).Set(cv)
+			}
+		}
+		return node, ok
+	}
+	return node, false
+}
+
+func sliceIndex(key string, length int) (int, error) {
+	idx, err := strconv.Atoi(key)
+	if err != nil {
+		return 0, fmt.Errorf("maputil: invalid slice index %q", key)
+	}
+
+	if idx < 0 || idx > length {
+		return 0, fmt.Errorf("maputil: slice index %d out of range(len: %d)", idx, length)
+	}
+	return idx, nil
+}
+
+// convertTo convert value to the reflect.Value of the type
+func convertTo(val interface{}, typ reflect.Type) (reflect.Value, error) {
+	if val == nil {
+		return reflect.Zero(typ), nil
+	}
+
+	rv := reflect.ValueOf(val)
+	if rv.Type().AssignableTo(typ) {
+		return rv, nil
+	}
+
+	// disallow convert number to string. eg: string(65) => "A"
+	isNumToStr := typ.Kind() == reflect.String && rv.Kind() != reflect.String
+	if rv.Type().ConvertibleTo(typ) && !isNumToStr {
+		return rv.Convert(typ), nil
+	}
+	return rv, fmt.Errorf("maputil: cannot use %T value as the %s", val, typ.String())
+}
@@ -0,0 +1,85 @@
+package maputil_test
+
+import (
+	"testing"
+
+	"github.com/gookit/goutil/maputil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetByPath(t *testing.T) {
+	is := assert.New(t)
+	mp := map[string]interface{}{
+		"key0": "val0",
+		"key1": map[string]string{"sk0": "sv0"},
+		"key2": []string{"sv1", "sv2"},
+		"key3": []interface{}{map[string]interface{}{"name": "n0"}},
+	}
+
+	is.NoError(maputil.SetByPath("key0", mp, "new-val"))
+	is.Equal("new-val", mp["key0"])
+
+	// create intermediate maps
+	is.NoError(maputil.SetByPath("db.hosts.main", mp, "localhost"))
+	v, ok := maputil.GetByPath("db.hosts.main", mp)
+	is.True(ok)
+	is.Equal("localhost", v)
+
+	// typed map and slice
+	is.NoError(maputil.SetByPath("key1.sk1", mp, "sv1"))
+	is.Equal(map[string]string{"sk0": "sv0", "sk1": "sv1"}, mp["key1"])
+	is.NoError(maputil.SetByPath("key2.1", mp, "new"))
+	is.NoError(maputil.SetByPath("key2.2", mp, "append"))
+	is.Equal([]string{"sv1", "new", "append"}, mp["key2"])
+	is.Error(maputil.SetByPath("key2.0", mp, 23))
+	is.Error(maputil.SetByPath("key2.5", mp, "val"))
+	is.Error(maputil.SetByPath("key2.abc", mp, "val"))
+
+	// slice of maps
+	is.NoError(maputil.SetByPath("key3.0.name", mp, "n1"))
+	is.NoError(maputil.SetByPath("key3.1.name", mp, "n2"))
+	v, ok = maputil.GetByPath("key3.1.name", mp)
+	is.True(ok)
+	is.Equal("n2", v)
+	v, _ = maputil.GetByPath("key3.0.name", mp)
+	is.Equal("n1", v)
+
+	// cannot set on scalar value
+	is.Error(maputil.SetByPath("key0.sub", mp, "val"))
+	is.Error(maputil.SetByPath("key0", nil, "val"))
+	is.Error(maputil.SetByPath("", mp, "val"))
+}
+
+func TestDeleteByPath(t *testing.T) {
+	is := assert.New(t)
+	mp := map[string]interface{}{
+		"key0": "val0",
+		"key1": map[string]string{"sk0": "sv0", "sk1": "sv1"},
+		"key2": []string{"sv1", "sv2"},
+		"key3": map[string]interface{}{
+			"list": []interface{}{"a", map[string]interface{}{"k": "v", "k1": "v1"}},
+		},
+	}
+
+	is.True(maputil.DeleteByPath("key0", mp))
+	is.NotContains(mp, "key0")
+	is.False(maputil.DeleteByPath("key0", mp))
+
+	is.True(maputil.DeleteByPath("key1.sk0", mp))
+	is.Equal(map[string]string{"sk1": "sv1"}, mp["key1"])
+
+	is.True(maputil.DeleteByPath("key2.0", mp))
+	is.Equal([]string{"sv2"}, mp["key2"])
+	is.False(maputil.DeleteByPath("key2.3", mp))
+
+	is.True(maputil.DeleteByPath("key3.list.1.k", mp))
+	v, _ := maputil.GetByPath("key3.list.1", mp)
+	is.Equal(map[string]interface{}{"k1": "v1"}, v)
+
+	is.True(maputil.DeleteByPath("key3.list.0", mp))
+	v, _ = maputil.GetByPath("key3.list", mp)
+	is.Equal([]interface{}{map[string]interface{}{"k1": "v1"}}, v)
+
+	is.False(maputil.DeleteByPath("not-exists.sub", mp))
+	is.False(maputil.DeleteByPath("key", nil))
+}
@@ -0,0 +1,74 @@
+//go:build go1.18
+// +build go1.18
+
+package maputil
+
+import "fmt"
+
+// Invert swap the keys and values of the map.
+//
+// NOTE: on has duplicate values, which key will be kept is not determined.
+// use InvertStrict or InvertMulti for handle duplicate values.
+func Invert[K, V comparable](mp map[K]V) map[V]K {
+	newMp := make(map[V]K, len(mp))
+	for key, val := range mp {
+		newMp[val] = key
+	}
+	return newMp
+}
+
+// InvertStrict swap the keys and values of the map, will return error on has duplicate values.
+func InvertStrict[K, V comparable](mp map[K]V) (map[V]K, error) {
+	newMp := make(map[V]K, len(mp))
+	for key, val := range mp {
+		if oldKey, ok := newMp[val]; ok {
+			return nil, fmt.Errorf("maputil: duplicate value %v of the keys %v and %v", val, oldKey, key)
+		}
+		newMp[val] = key
+	}
+	return newMp, nil
+}
+
+// InvertMulti swap the keys and values of the map, collect the keys of duplicate values to a slice.
+//
+// NOTE: the order of the keys in the slice is not determined.
+func InvertMulti[K, V comparable](mp map[K]V) map[V][]K {
+	newMp := make(map[V][]K, len(mp))
+	for key, val := range mp {
+		newMp[val] = append(newMp[val], key)
+	}
+	return newMp
+}
+
+// Pick returns a new map only contains the given keys.
+//
+// Usage:
+// 	user := maputil.Pick(payload, "id", "name")
+func Pick[K comparable, V any](mp map[K]V, keys ...K) map[K]V {
+	newMp := make(map[K]V, len(keys))
+	for _, key := range keys {
+		if val, ok := mp[key]; ok {
+			newMp[key] = val
+		}
+	}
+	return newMp
+}
+
+// Omit returns a new map without the given keys.
+//
+// Usage:
+// 	safeData := maputil.Omit(payload, "password", "token")
+func Omit[K comparable, V any](mp map[K]V, keys ...K) map[K]V {
+	omits := make(map[K]struct{}, len(keys))
+	for _, key := range keys {
+		omits[key] = struct{}{}
+	}
+
+	newMp := make(map[K]V, len(mp))
+	for key, val := range mp {
+		if _, ok := omits[key]; !ok {
+			newMp[key] = val
+		}
+	}
+	return newMp
+}
@@ -0,0 +1,42 @@
+//go:build go1.18
+// +build go1.18
+
+package maputil_test
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/gookit/goutil/maputil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInvert(t *testing.T) {
+	mp := map[string]int{"a": 1, "b": 2}
+	assert.Equal(t, map[int]string{1: "a", 2: "b"}, maputil.Invert(mp))
+
+	newMp, err := maputil.InvertStrict(mp)
+	assert.NoError(t, err)
+	assert.Equal(t, map[int]string{1: "a", 2: "b"}, newMp)
+
+	mp["c"] = 1
+	assert.Len(t, maputil.Invert(mp), 2)
+
+	newMp, err = maputil.InvertStrict(mp)
+	assert.Error(t, err)
+	assert.Nil(t, newMp)
+
+	multi := maputil.InvertMulti(mp)
+	sort.Strings(multi[1])
+	assert.Equal(t, map[int][]string{1: {"a", "c"}, 2: {"b"}}, multi)
+}
+
+func TestPick_Omit(t *testing.T) {
+	mp := map[string]interface{}{"id": 1, "name": "inhere", "password": "123"}
+
+	assert.Equal(t, map[string]interface{}{"id": 1, "name": "inhere"}, maputil.Pick(mp, "id", "name", "not-exist"))
+	assert.Equal(t, map[string]interface{}{"id": 1, "name": "inhere"}, maputil.Omit(mp, "password", "not-exist"))
+	assert.Empty(t, maputil.Pick(mp))
+	assert.Equal(t, mp, maputil.Omit(mp))
+	assert.Len(t, mp, 3)
+}
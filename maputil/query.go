@@ -0,0 +1,118 @@
+package maputil
+
+import (
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// PathValue the value and its full key path
+type PathValue struct {
+	Path  string
+	Value interface{}
+}
+
+// QueryPath find all values matched the key path, like a minimal JSONPath.
+//
+// Path syntax:
+// 	"top.sub"          normal key path
+// 	"servers.*.host"   "*" match any map key or slice index
+// 	"servers.[].host"  "[]" match any slice index, same as "servers[].host"
+// 	"servers.0.host"   slice index
+//
+// The results are sorted by map keys and slice index.
+//
+// Usage:
+// 	for _, pv := range maputil.QueryPath(conf, "servers.*.host") {
+// 		fmt.Println(pv.Path, pv.Value)
+// 	}
+func QueryPath(mp map[string]interface{}, path string) []PathValue {
+	if path == "" {
+		return nil
+	}
+
+	var rs []PathValue
+	queryNode(mp, "", parseQueryPath(path), &rs)
+	return rs
+}
+
+func parseQueryPath(path string) []string {
+	var keys []string
+	for _, key := range strings.Split(path, PathSep) {
+		// eg: "servers[]" => "servers", "[]"
+		if len(key) > 2 && strings.HasSuffix(key, "[]") {
+			keys = append(keys, key[:len(key)-2], "[]")
+		} else {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+func queryNode(node interface{}, prefix string, keys []string, rs *[]PathValue) {
+	if len(keys) == 0 {
+		*rs = append(*rs, PathValue{Path: prefix, Value: node})
+		return
+	}
+
+	key, rest := keys[0], keys[1:]
+	rv := reflect.ValueOf(node)
+	switch rv.Kind() {
+	case reflect.Map:
+		if key == "[]" {
+			return
+		}
+
+		if key != "*" {
+			if sub, ok := mapValue(rv, key); ok {
+				queryNode(sub, joinPath(prefix, key), rest, rs)
+			}
+			return
+		}
+
+		mapKeys := rv.MapKeys()
+		names := make([]string, len(mapKeys))
+		values := make(map[string]interface{}, len(mapKeys))
+		for i, mk := range mapKeys {
+			names[i] = toKeyString(mk)
+			values[names[i]] = rv.MapIndex(mk).Interface()
+		}
+
+		sort.Strings(names)
+		for _, name := range names {
+			queryNode(values[name], joinPath(prefix, name), rest, rs)
+		}
+	case reflect.Slice, reflect.Array:
+		if key == "*" || key == "[]" {
+			for i := 0; i < rv.Len(); i++ {
+				queryNode(rv.Index(i).Interface(), joinPath(prefix, strconv.Itoa(i)), rest, rs)
+			}
+			return
+		}
+
+		idx, err := strconv.Atoi(key)
+		if err == nil && idx >= 0 && idx < rv.Len() {
+			queryNode(rv.Index(idx).Interface(), joinPath(prefix, key), rest, rs)
+		}
+	}
+}
+
+// mapValue get value from map by string key, support the map key type is string or interface{}
+func mapValue(rv reflect.Value, key string) (interface{}, bool) {
+	var mk reflect.Value
+	switch rv.Type().Key().Kind() {
+	case reflect.String:
+		mk = reflect.ValueOf(key).Convert(rv.Type().Key())
+	case reflect.Interface:
+		mk = reflect.ValueOf(key)
+	default:
+		return nil, false
+	}
+
+	mv := rv.MapIndex(mk)
+	if !mv.IsValid() {
+		return nil, false
+	}
+	return mv.Interface(), true
+}
@@ -0,0 +1,163 @@
+package maputil
+
+import (
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gookit/goutil/strutil"
+)
+
+// ToQueryString convert map data to URL query string, the nested key will use bracket notation.
+// the keys will be sorted.
+//
+// Example:
+// 	{"a": {"b": 1}, "tags": ["x", "y"], "list": [{"id": 2}]}
+// 	=> "a[b]=1&list[0][id]=2&tags[]=x&tags[]=y" (will be url encoded)
+func ToQueryString(mp map[string]interface{}) string {
+	return ToURLValues(mp).Encode()
+}
+
+// ToURLValues convert map data to url.Values, the nested key will use bracket notation.
+func ToURLValues(mp map[string]interface{}) url.Values {
+	values := make(url.Values, len(mp))
+	for key, val := range mp {
+		addQueryValue(values, key, reflect.ValueOf(val))
+	}
+	return values
+}
+
+func addQueryValue(values url.Values, key string, rv reflect.Value) {
+	if rv.Kind() == reflect.Interface || rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			values.Add(key, "")
+			return
+		}
+		rv = rv.Elem()
+	}
+
+	switch rv.Kind() {
+	case reflect.Invalid:
+		values.Add(key, "")
+	case reflect.Map:
+		for _, mk := range rv.MapKeys() {
+			addQueryValue(values, key+"["+toKeyString(mk)+"]", rv.MapIndex(mk))
+		}
+	case reflect.Slice, reflect.Array:
+		// []byte as string
+		if rv.Kind() == reflect.Slice && rv.Type().Elem().Kind() == reflect.Uint8 {
+			values.Add(key, string(rv.Bytes()))
+			return
+		}
+
+		if !hasComplexElem(rv) {
+			for i := 0; i < rv.Len(); i++ {
+				addQueryValue(values, key+"[]", rv.Index(i))
+			}
+			return
+		}
+
+		for i := 0; i < rv.Len(); i++ {
+			addQueryValue(values, key+"["+strconv.Itoa(i)+"]", rv.Index(i))
+		}
+	default:
+		str, _ := strutil.AnyToString(rv.Interface(), false)
+		values.Add(key, str)
+	}
+}
+
+// hasComplexElem check the slice has map or slice element
+func hasComplexElem(rv reflect.Value) bool {
+	for i := 0; i < rv.Len(); i++ {
+		elem := rv.Index(i)
+		for elem.Kind() == reflect.Interface || elem.Kind() == reflect.Ptr {
+			elem = elem.Elem()
+		}
+
+		switch elem.Kind() {
+		case reflect.Map, reflect.Slice, reflect.Array:
+			return true
+		}
+	}
+	return false
+}
+
+// ParseQueryMap parse the URL query string to map data, it is the inverse of ToQueryString.
+//
+// - "a[b]=1" => {"a": {"b": "1"}}
+// - "tags[]=x&tags[]=y" => {"tags": ["x", "y"]}
+// - "list[0][id]=2" => {"list": [{"id": "2"}]}
+// - "a=1&a=2" => {"a": ["1", "2"]}
+func ParseQueryMap(query string) (map[string]interface{}, error) {
+	values, err := url.ParseQuery(strings.TrimPrefix(query, "?"))
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	mp := make(map[string]interface{}, len(values))
+	for _, key := range keys {
+		vs := values[key]
+		nodes := parseQueryKey(key)
+
+		var val interface{}
+		if last := len(nodes) - 1; nodes[last] == "" || len(vs) > 1 {
+			if nodes[last] == "" && last > 0 {
+				nodes = nodes[:last]
+			}
+
+			list := make([]interface{}, len(vs))
+			for i, v := range vs {
+				list[i] = v
+			}
+			val = list
+		} else {
+			val = vs[0]
+		}
+
+		node := mp
+		for _, k := range nodes[:len(nodes)-1] {
+			sub, ok := node[k].(map[string]interface{})
+			if !ok {
+				sub = make(map[string]interface{})
+				node[k] = sub
+			}
+			node = sub
+		}
+		node[nodes[len(nodes)-1]] = val
+	}
+
+	for key, val := range mp {
+		mp[key] = indexMapToSlice(val)
+	}
+	return mp, nil
+}
+
+// parseQueryKey parse the query key. eg: "a[b][c]" => ["a", "b", "c"], "tags[]" => ["tags", ""]
+func parseQueryKey(key string) []string {
+	pos := strings.IndexByte(key, '[')
+	if pos <= 0 || !strings.HasSuffix(key, "]") {
+		return []string{key}
+	}
+
+	nodes := []string{key[:pos]}
+	rest := key[pos:]
+	for len(rest) > 0 {
+		end := strings.IndexByte(rest, ']')
+		if rest[0] != '[' || end < 0 {
+			// invalid format, use the raw key
+			return []string{key}
+		}
+
+		nodes = append(nodes, rest[1:end])
+		rest = rest[end+1:]
+	}
+	return nodes
+}
@@ -0,0 +1,62 @@
+package maputil_test
+
+import (
+	"testing"
+
+	"github.com/gookit/goutil/maputil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToQueryString(t *testing.T) {
+	mp := map[string]interface{}{
+		"name": "inhere",
+		"age":  23,
+		"a":    map[string]interface{}{"b": 1, "c": map[string]string{"d": "v"}},
+		"tags": []string{"x", "y"},
+		"list": []interface{}{map[string]interface{}{"id": 2}},
+		"nil":  nil,
+	}
+
+	values := maputil.ToURLValues(mp)
+	assert.Equal(t, "inhere", values.Get("name"))
+	assert.Equal(t, "23", values.Get("age"))
+	assert.Equal(t, "1", values.Get("a[b]"))
+	assert.Equal(t, "v", values.Get("a[c][d]"))
+	assert.Equal(t, []string{"x", "y"}, values["tags[]"])
+	assert.Equal(t, "2", values.Get("list[0][id]"))
+	assert.Contains(t, values, "nil")
+
+	str := maputil.ToQueryString(map[string]interface{}{"b": 2, "a": map[string]int{"x": 1}})
+	assert.Equal(t, "a%5Bx%5D=1&b=2", str)
+}
+
+func TestParseQueryMap(t *testing.T) {
+	mp, err := maputil.ParseQueryMap("?name=inhere&a[b]=1&a[c][d]=v&tags[]=x&tags[]=y&list[0][id]=2&list[1][id]=3&multi=1&multi=2&bad[=3")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"name": "inhere",
+		"a": map[string]interface{}{
+			"b": "1",
+			"c": map[string]interface{}{"d": "v"},
+		},
+		"tags": []interface{}{"x", "y"},
+		"list": []interface{}{
+			map[string]interface{}{"id": "2"},
+			map[string]interface{}{"id": "3"},
+		},
+		"multi": []interface{}{"1", "2"},
+		"bad[":  "3",
+	}, mp)
+
+	// round trip
+	src := map[string]interface{}{
+		"a":    map[string]interface{}{"b": "1"},
+		"tags": []interface{}{"x", "y"},
+	}
+	mp, err = maputil.ParseQueryMap(maputil.ToQueryString(src))
+	assert.NoError(t, err)
+	assert.Equal(t, src, mp)
+
+	_, err = maputil.ParseQueryMap("a=%zz")
+	assert.Error(t, err)
+}
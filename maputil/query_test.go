@@ -0,0 +1,52 @@
+package maputil_test
+
+import (
+	"testing"
+
+	"github.com/gookit/goutil/maputil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueryPath(t *testing.T) {
+	mp := map[string]interface{}{
+		"servers": []interface{}{
+			map[string]interface{}{"host": "h1", "port": 80},
+			map[string]interface{}{"host": "h2"},
+			map[interface{}]interface{}{"host": "h3"},
+		},
+		"db": map[string]interface{}{
+			"master": map[string]string{"host": "m1"},
+			"slave":  map[string]string{"host": "s1"},
+			"name":   "app",
+		},
+		"tags": []string{"a", "b"},
+	}
+
+	rs := maputil.QueryPath(mp, "servers.*.host")
+	assert.Equal(t, []maputil.PathValue{
+		{Path: "servers.0.host", Value: "h1"},
+		{Path: "servers.1.host", Value: "h2"},
+		{Path: "servers.2.host", Value: "h3"},
+	}, rs)
+	assert.Equal(t, rs, maputil.QueryPath(mp, "servers.[].host"))
+	assert.Equal(t, rs, maputil.QueryPath(mp, "servers[].host"))
+
+	rs = maputil.QueryPath(mp, "db.*.host")
+	assert.Equal(t, []maputil.PathValue{
+		{Path: "db.master.host", Value: "m1"},
+		{Path: "db.slave.host", Value: "s1"},
+	}, rs)
+
+	rs = maputil.QueryPath(mp, "servers.*.port")
+	assert.Equal(t, []maputil.PathValue{{Path: "servers.0.port", Value: 80}}, rs)
+
+	rs = maputil.QueryPath(mp, "tags.1")
+	assert.Equal(t, []maputil.PathValue{{Path: "tags.1", Value: "b"}}, rs)
+	assert.Len(t, maputil.QueryPath(mp, "tags.*"), 2)
+
+	// not match
+	assert.Empty(t, maputil.QueryPath(mp, "db.[].host"))
+	assert.Empty(t, maputil.QueryPath(mp, "tags.5"))
+	assert.Empty(t, maputil.QueryPath(mp, "not-exists.*"))
+	assert.Empty(t, maputil.QueryPath(mp, ""))
+}
@@ -0,0 +1,294 @@
+//go:build go1.18
+// +build go1.18
+
+package maputil
+
+import (
+	"encoding/binary"
+	"hash/maphash"
+	"math"
+	"reflect"
+	"sync"
+)
+
+// SafeMap a concurrency-safe map, backed by sync.RWMutex.
+type SafeMap[K comparable, V any] struct {
+	mu   sync.RWMutex
+	data map[K]V
+}
+
+// NewSafeMap create a new SafeMap
+func NewSafeMap[K comparable, V any]() *SafeMap[K, V] {
+	return &SafeMap[K, V]{data: make(map[K]V)}
+}
+
+// Get value by key
+func (m *SafeMap[K, V]) Get(key K) (V, bool) {
+	m.mu.RLock()
+	val, ok := m.data[key]
+	m.mu.RUnlock()
+	return val, ok
+}
+
+// Set value by key
+func (m *SafeMap[K, V]) Set(key K, val V) {
+	m.mu.Lock()
+	if m.data == nil {
+		m.data = make(map[K]V)
+	}
+	m.data[key] = val
+	m.mu.Unlock()
+}
+
+// GetOrCompute get value by key, if not exists will call the fn to compute and save the value.
+// the fn is called with the lock held, so it must not access the map.
+//
+// Usage:
+// 	val := sm.GetOrCompute("key", func() int {
+// 		return loadFromDB("key")
+// 	})
+func (m *SafeMap[K, V]) GetOrCompute(key K, fn func() V) V {
+	if val, ok := m.Get(key); ok {
+		return val
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// double check, maybe set by other goroutine
+	if val, ok := m.data[key]; ok {
+		return val
+	}
+
+	if m.data == nil {
+		m.data = make(map[K]V)
+	}
+
+	val := fn()
+	m.data[key] = val
+	return val
+}
+
+// Update the value by the fn with the lock held. useful for counter. eg: n+1
+func (m *SafeMap[K, V]) Update(key K, fn func(old V, exists bool) V) V {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.data == nil {
+		m.data = make(map[K]V)
+	}
+
+	old, ok := m.data[key]
+	val := fn(old, ok)
+	m.data[key] = val
+	return val
+}
+
+// Delete value by key, returns false on key not exists.
+func (m *SafeMap[K, V]) Delete(key K) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.data[key]; !ok {
+		return false
+	}
+	delete(m.data, key)
+	return true
+}
+
+// Len of the map
+func (m *SafeMap[K, V]) Len() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return len(m.data)
+}
+
+// Range iterate the map with the read lock held. will stop on fn returns false.
+//
+// NOTE: the fn must not modify the map, otherwise will deadlock.
+func (m *SafeMap[K, V]) Range(fn func(key K, val V) bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	for key, val := range m.data {
+		if !fn(key, val) {
+			break
+		}
+	}
+}
+
+// ToMap get a copy of the map data
+func (m *SafeMap[K, V]) ToMap() map[K]V {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	mp := make(map[K]V, len(m.data))
+	for key, val := range m.data {
+		mp[key] = val
+	}
+	return mp
+}
+
+// ShardedMap a concurrency-safe map, split the data to multi SafeMap shards by key hash.
+// it can reduce lock contention on high concurrent write. eg: counters, caches
+type ShardedMap[K comparable, V any] struct {
+	shards []*SafeMap[K, V]
+	hashFn func(key K) uint64
+}
+
+// NewShardedMap create a new ShardedMap. shards <= 0 will use the default 32.
+//
+// Usage:
+// 	counter := maputil.NewShardedMap[string, int](16)
+// 	counter.Update("hits", func(n int, _ bool) int { return n + 1 })
+func NewShardedMap[K comparable, V any](shards int) *ShardedMap[K, V] {
+	return NewShardedMapWith[K, V](shards, hashKey[K])
+}
+
+// NewShardedMapWith create a new ShardedMap with custom key hash func.
+func NewShardedMapWith[K comparable, V any](shards int, hashFn func(key K) uint64) *ShardedMap[K, V] {
+	if shards <= 0 {
+		shards = 32
+	}
+
+	sm := &ShardedMap[K, V]{
+		shards: make([]*SafeMap[K, V], shards),
+		hashFn: hashFn,
+	}
+	for i := range sm.shards {
+		sm.shards[i] = NewSafeMap[K, V]()
+	}
+	return sm
+}
+
+// Shard get the shard map of the key
+func (sm *ShardedMap[K, V]) Shard(key K) *SafeMap[K, V] {
+	return sm.shards[sm.hashFn(key)%uint64(len(sm.shards))]
+}
+
+// Get value by key
+func (sm *ShardedMap[K, V]) Get(key K) (V, bool) {
+	return sm.Shard(key).Get(key)
+}
+
+// Set value by key
+func (sm *ShardedMap[K, V]) Set(key K, val V) {
+	sm.Shard(key).Set(key, val)
+}
+
+// GetOrCompute get value by key, if not exists will call the fn to compute and save the value.
+func (sm *ShardedMap[K, V]) GetOrCompute(key K, fn func() V) V {
+	return sm.Shard(key).GetOrCompute(key, fn)
+}
+
+// Update the value by the fn with the shard lock held.
+func (sm *ShardedMap[K, V]) Update(key K, fn func(old V, exists bool) V) V {
+	return sm.Shard(key).Update(key, fn)
+}
+
+// Delete value by key
+func (sm *ShardedMap[K, V]) Delete(key K) bool {
+	return sm.Shard(key).Delete(key)
+}
+
+// Len of all shards
+func (sm *ShardedMap[K, V]) Len() int {
+	var n int
+	for _, shard := range sm.shards {
+		n += shard.Len()
+	}
+	return n
+}
+
+// Range iterate all shards. will stop on fn returns false.
+func (sm *ShardedMap[K, V]) Range(fn func(key K, val V) bool) {
+	goon := true
+	for _, shard := range sm.shards {
+		shard.Range(func(key K, val V) bool {
+			goon = fn(key, val)
+			return goon
+		})
+
+		if !goon {
+			break
+		}
+	}
+}
+
+// hashSeed the seed for the default key hash func, it is random per process.
+var hashSeed = maphash.MakeSeed()
+
+// hashKey the default key hash func, write the key to maphash by its type.
+func hashKey[K comparable](key K) uint64 {
+	var h maphash.Hash
+	h.SetSeed(hashSeed)
+
+	switch typKey := any(key).(type) {
+	case string:
+		_, _ = h.WriteString(typKey)
+	case int:
+		writeUint64(&h, uint64(typKey))
+	case int64:
+		writeUint64(&h, uint64(typKey))
+	case uint:
+		writeUint64(&h, uint64(typKey))
+	case uint64:
+		writeUint64(&h, typKey)
+	default:
+		writeHashValue(&h, reflect.ValueOf(key))
+	}
+	return h.Sum64()
+}
+
+// writeHashValue write the comparable value to the hash by its kind.
+// the equal values always write the same bytes.
+func writeHashValue(h *maphash.Hash, rv reflect.Value) {
+	switch rv.Kind() {
+	case reflect.String:
+		_, _ = h.WriteString(rv.String())
+	case reflect.Bool:
+		if rv.Bool() {
+			_ = h.WriteByte(1)
+		} else {
+			_ = h.WriteByte(0)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		writeUint64(h, uint64(rv.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		writeUint64(h, rv.Uint())
+	case reflect.Float32, reflect.Float64:
+		writeFloat64(h, rv.Float())
+	case reflect.Complex64, reflect.Complex128:
+		c := rv.Complex()
+		writeFloat64(h, real(c))
+		writeFloat64(h, imag(c))
+	case reflect.Ptr, reflect.Chan, reflect.UnsafePointer:
+		writeUint64(h, uint64(rv.Pointer()))
+	case reflect.Interface:
+		if !rv.IsNil() {
+			writeHashValue(h, rv.Elem())
+		}
+	case reflect.Array:
+		for i := 0; i < rv.Len(); i++ {
+			writeHashValue(h, rv.Index(i))
+		}
+	case reflect.Struct:
+		for i := 0; i < rv.NumField(); i++ {
+			writeHashValue(h, rv.Field(i))
+		}
+	}
+}
+
+func writeUint64(h *maphash.Hash, u uint64) {
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], u)
+	_, _ = h.Write(buf[:])
+}
+
+// writeFloat64 the -0 is equal to +0, so use the same bits for them.
+func writeFloat64(h *maphash.Hash, f float64) {
+	if f == 0 {
+		f = 0
+	}
+	writeUint64(h, math.Float64bits(f))
+}
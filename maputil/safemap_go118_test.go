@@ -0,0 +1,137 @@
+//go:build go1.18
+// +build go1.18
+
+package maputil_test
+
+import (
+	"math"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/gookit/goutil/maputil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSafeMap(t *testing.T) {
+	sm := maputil.NewSafeMap[string, int]()
+	sm.Set("a", 1)
+
+	val, ok := sm.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, 1, val)
+
+	var calls int
+	fn := func() int {
+		calls++
+		return 2
+	}
+	assert.Equal(t, 2, sm.GetOrCompute("b", fn))
+	assert.Equal(t, 2, sm.GetOrCompute("b", fn))
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, 2, sm.Len())
+
+	assert.Equal(t, map[string]int{"a": 1, "b": 2}, sm.ToMap())
+	assert.True(t, sm.Delete("a"))
+	assert.False(t, sm.Delete("a"))
+
+	var n int
+	sm.Range(func(key string, val int) bool {
+		n++
+		return true
+	})
+	assert.Equal(t, 1, n)
+
+	// zero value is usable
+	var sm2 maputil.SafeMap[int, string]
+	sm2.Set(1, "one")
+	assert.Equal(t, 1, sm2.Len())
+}
+
+func TestSafeMap_concurrent(t *testing.T) {
+	sm := maputil.NewSafeMap[string, int]()
+	wg := sync.WaitGroup{}
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			sm.Update("count", func(old int, _ bool) int {
+				return old + 1
+			})
+			sm.Set(strconv.Itoa(i), i)
+		}(i)
+	}
+	wg.Wait()
+
+	val, _ := sm.Get("count")
+	assert.Equal(t, 50, val)
+	assert.Equal(t, 51, sm.Len())
+}
+
+func TestShardedMap(t *testing.T) {
+	sm := maputil.NewShardedMap[string, int](4)
+	wg := sync.WaitGroup{}
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			sm.Update("key"+strconv.Itoa(i%10), func(old int, _ bool) int {
+				return old + 1
+			})
+		}(i)
+	}
+	wg.Wait()
+
+	assert.Equal(t, 10, sm.Len())
+	val, ok := sm.Get("key3")
+	assert.True(t, ok)
+	assert.Equal(t, 10, val)
+
+	sm.Set("new", 1)
+	assert.Equal(t, 1, sm.GetOrCompute("new", func() int { return 2 }))
+	assert.True(t, sm.Delete("new"))
+
+	var sum, n int
+	sm.Range(func(key string, val int) bool {
+		sum += val
+		return true
+	})
+	assert.Equal(t, 100, sum)
+	sm.Range(func(key string, val int) bool {
+		n++
+		return false
+	})
+	assert.Equal(t, 1, n)
+
+	// custom hash and struct key
+	type point struct{ x, y int }
+	pm := maputil.NewShardedMap[point, string](0)
+	pm.Set(point{1, 2}, "p1")
+	val2, _ := pm.Get(point{1, 2})
+	assert.Equal(t, "p1", val2)
+
+	// the equal keys in same shard. eg: -0 == +0
+	fm := maputil.NewShardedMap[float64, int](64)
+	fm.Set(0, 1)
+	val3, ok := fm.Get(math.Copysign(0, -1))
+	assert.True(t, ok)
+	assert.Equal(t, 1, val3)
+
+	type objKey struct {
+		name string
+		ptr  *int
+		any  interface{}
+	}
+	num := 1
+	om := maputil.NewShardedMap[objKey, int](64)
+	om.Set(objKey{"a", &num, 1.5}, 1)
+	val3, ok = om.Get(objKey{"a", &num, 1.5})
+	assert.True(t, ok)
+	assert.Equal(t, 1, val3)
+	_, ok = om.Get(objKey{"a", nil, 1.5})
+	assert.False(t, ok)
+
+	im := maputil.NewShardedMapWith[int, int](2, func(key int) uint64 { return uint64(key) })
+	im.Set(3, 3)
+	assert.Equal(t, 1, im.Shard(1).Len())
+}
@@ -0,0 +1,604 @@
+package maputil
+
+import (
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gookit/goutil/internal/comfunc"
+	"github.com/gookit/goutil/mathutil"
+	"github.com/gookit/goutil/strutil"
+)
+
+// StructTagNames the tag names for get field name on convert map <=> struct. will use the first found tag.
+var StructTagNames = []string{"map", "json"}
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// FromStruct convert struct to map[string]interface{}, the key is field name or tag name.
+//
+// - support tags: `map:"name"`, `json:"name,omitempty"`, `json:"-"`
+// - the nested struct will be converted to map[string]interface{}
+// - the anonymous(embedded) struct fields will be inlined to the parent map
+func FromStruct(v interface{}) (map[string]interface{}, error) {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+
+	if rv.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("maputil: FromStruct the input must be a struct, but got %T", v)
+	}
+
+	mp := make(map[string]interface{}, rv.NumField())
+	structToMap(rv, mp)
+	return mp, nil
+}
+
+func structToMap(sv reflect.Value, mp map[string]interface{}) {
+	for i, fm := range comfunc.GetStructMeta(sv.Type(), StructTagNames).Fields {
+		sf, tag := fm.StructField, fm.Tag
+		if tag.Skip {
+			continue
+		}
+
+		fv := sv.Field(i)
+		if fm.Inline() {
+			if ev := reflect.Indirect(fv); ev.Kind() == reflect.Struct {
+				structToMap(ev, mp)
+				continue
+			}
+		}
+
+		// unexported field
+		if sf.PkgPath != "" {
+			continue
+		}
+
+		if tag.OmitEmpty && fv.IsZero() {
+			continue
+		}
+		mp[tag.Name] = toMapValue(fv)
+	}
+}
+
+// toMapValue convert the struct field value. struct will be converted to map.
+func toMapValue(fv reflect.Value) interface{} {
+	if isMarshaler(fv) {
+		return fv.Interface()
+	}
+
+	switch fv.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if fv.IsNil() {
+			return nil
+		}
+		return toMapValue(fv.Elem())
+	case reflect.Struct:
+		sub := make(map[string]interface{}, fv.NumField())
+		structToMap(fv, sub)
+		return sub
+	case reflect.Slice, reflect.Array:
+		if fv.Kind() == reflect.Slice && fv.IsNil() || !hasStructElem(fv.Type()) {
+			return fv.Interface()
+		}
+
+		list := make([]interface{}, fv.Len())
+		for i := 0; i < fv.Len(); i++ {
+			list[i] = toMapValue(fv.Index(i))
+		}
+		return list
+	case reflect.Map:
+		if fv.IsNil() || fv.Type().Key().Kind() != reflect.String || !hasStructElem(fv.Type()) {
+			return fv.Interface()
+		}
+
+		sub := make(map[string]interface{}, fv.Len())
+		for _, key := range fv.MapKeys() {
+			sub[key.String()] = toMapValue(fv.MapIndex(key))
+		}
+		return sub
+	}
+	return fv.Interface()
+}
+
+func isMarshaler(fv reflect.Value) bool {
+	if !fv.CanInterface() {
+		return false
+	}
+
+	switch fv.Interface().(type) {
+	case json.Marshaler, encoding.TextMarshaler:
+		return true
+	}
+	return false
+}
+
+func hasStructElem(typ reflect.Type) bool {
+	elemTyp := typ.Elem()
+	for elemTyp.Kind() == reflect.Ptr {
+		elemTyp = elemTyp.Elem()
+	}
+	return elemTyp.Kind() == reflect.Struct
+}
+
+// StructOptions for ToStructWith
+type StructOptions struct {
+	// TagNames the tag names for get field name. default use the StructTagNames
+	TagNames []string
+	// Strict disable the weak type conversion. eg: string "1" => int 1
+	Strict bool
+	// ErrorUnused return a *KeysError on the map has keys that not used by struct fields.
+	ErrorUnused bool
+	// ErrorMissing return a *KeysError on the struct fields not found in the map.
+	ErrorMissing bool
+}
+
+// KeysError the unused map keys or the missing struct fields on bind map to struct.
+type KeysError struct {
+	// Unused the key paths of map that not used by struct fields. eg: "db.user", "servers.0.name"
+	Unused []string
+	// Missing the key paths of struct fields that not found in map. eg: "db.password"
+	Missing []string
+}
+
+// Error message
+func (e *KeysError) Error() string {
+	var ss []string
+	if len(e.Unused) > 0 {
+		ss = append(ss, "has unused keys: "+strings.Join(e.Unused, ", "))
+	}
+	if len(e.Missing) > 0 {
+		ss = append(ss, "has missing keys: "+strings.Join(e.Missing, ", "))
+	}
+	return "maputil: " + strings.Join(ss, "; ")
+}
+
+// FieldError the error on set the field value
+type FieldError struct {
+	// Path the key path of the field. eg: "db.port"
+	Path string
+	Err  error
+}
+
+// Error message
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("maputil: set the field value of %q error: %s", e.Path, e.Err.Error())
+}
+
+// Unwrap the error
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// ToStruct bind the map data to a struct pointer. it is a light version of mapstructure.
+//
+// - find value by: tag name, field name, case-insensitive field name
+// - support nested struct, pointer, slice and map fields
+// - weak type conversion. eg: string "1" => int 1, int 1 => string "1", "true" => bool true
+// - the time.Duration allow string "3s" and number as nanoseconds, same as the JSON encoded value.
+//
+// Usage:
+// 	conf := &Config{}
+// 	err := maputil.ToStruct(mp, conf)
+func ToStruct(mp map[string]interface{}, ptr interface{}) error {
+	return ToStructWith(mp, ptr)
+}
+
+// ToStructWith bind the map data to a struct pointer with options. see ToStruct()
+//
+// Usage:
+// 	err := maputil.ToStructWith(mp, conf, func(opt *maputil.StructOptions) {
+// 		opt.ErrorUnused = true
+// 	})
+func ToStructWith(mp map[string]interface{}, ptr interface{}, fns ...func(opt *StructOptions)) error {
+	rv := reflect.ValueOf(ptr)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return errors.New("maputil: ToStruct the ptr must be a non-nil pointer to struct")
+	}
+
+	if rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("maputil: ToStruct the ptr must be a pointer to struct, but got %T", ptr)
+	}
+
+	opt := &StructOptions{TagNames: StructTagNames}
+	for _, fn := range fns {
+		fn(opt)
+	}
+
+	sd := &structDecoder{opt: opt}
+	if err := sd.mapToStruct("", mp, rv.Elem()); err != nil {
+		return err
+	}
+
+	if len(sd.unused) > 0 || len(sd.missing) > 0 {
+		sort.Strings(sd.unused)
+		return &KeysError{Unused: sd.unused, Missing: sd.missing}
+	}
+	return nil
+}
+
+// SetReflectValue set the val to the settable reflect.Value, will weak convert the value type same as ToStruct()
+//
+// Usage:
+// 	fv := reflect.ValueOf(conf).Elem().FieldByName("Port")
+// 	err := maputil.SetReflectValue(fv, "8080")
+func SetReflectValue(rv reflect.Value, val interface{}) error {
+	sd := &structDecoder{opt: &StructOptions{TagNames: StructTagNames}}
+	return sd.setValue("", rv, val)
+}
+
+// structDecoder decode map to struct
+type structDecoder struct {
+	opt *StructOptions
+	// unused and missing key paths
+	unused, missing []string
+}
+
+func (sd *structDecoder) mapToStruct(prefix string, mp map[string]interface{}, sv reflect.Value) error {
+	used := make(map[string]bool, len(mp))
+	if err := sd.bindFields(prefix, mp, sv, used); err != nil {
+		return err
+	}
+
+	if sd.opt.ErrorUnused {
+		for key := range mp {
+			if !used[key] {
+				sd.unused = append(sd.unused, joinPath(prefix, key))
+			}
+		}
+	}
+	return nil
+}
+
+// bindFields bind the map values to the struct fields, the used keys will be recorded.
+func (sd *structDecoder) bindFields(prefix string, mp map[string]interface{}, sv reflect.Value, used map[string]bool) error {
+	// the struct metadata is cached and shared with the structs package.
+	for i, fm := range comfunc.GetStructMeta(sv.Type(), sd.opt.TagNames).Fields {
+		sf, tag := fm.StructField, fm.Tag
+		if tag.Skip {
+			continue
+		}
+
+		fv := sv.Field(i)
+		if fm.Inline() {
+			ev := fv
+			if ev.Kind() == reflect.Ptr && ev.Type().Elem().Kind() == reflect.Struct {
+				if ev.IsNil() {
+					if !ev.CanSet() {
+						continue
+					}
+					ev.Set(reflect.New(ev.Type().Elem()))
+				}
+				ev = ev.Elem()
+			}
+
+			if ev.Kind() == reflect.Struct {
+				if err := sd.bindFields(prefix, mp, ev, used); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+
+		if !fv.CanSet() {
+			continue
+		}
+
+		key, ok := lookupField(mp, tag.Name, sf.Name)
+		if !ok {
+			if sd.opt.ErrorMissing {
+				sd.missing = append(sd.missing, joinPath(prefix, tag.Name))
+			}
+			continue
+		}
+
+		used[key] = true
+		if err := sd.setValue(joinPath(prefix, key), fv, mp[key]); err != nil {
+			if _, ok := err.(*FieldError); ok {
+				return err
+			}
+			return &FieldError{Path: joinPath(prefix, key), Err: err}
+		}
+	}
+	return nil
+}
+
+// lookupField find the key in the map by: tag name, field name, case-insensitive name
+func lookupField(mp map[string]interface{}, name, fieldName string) (string, bool) {
+	if _, ok := mp[name]; ok {
+		return name, true
+	}
+
+	if _, ok := mp[fieldName]; ok {
+		return fieldName, true
+	}
+
+	for key := range mp {
+		if strings.EqualFold(key, name) {
+			return key, true
+		}
+	}
+	return "", false
+}
+
+// setValue set the val to field, will weak convert the value type on not strict.
+func (sd *structDecoder) setValue(path string, fv reflect.Value, val interface{}) error {
+	if val == nil {
+		fv.Set(reflect.Zero(fv.Type()))
+		return nil
+	}
+
+	rv := reflect.ValueOf(val)
+	if rv.Type().AssignableTo(fv.Type()) {
+		fv.Set(rv)
+		return nil
+	}
+
+	if fv.Type() == durationType {
+		return setDuration(fv, rv, val)
+	}
+
+	// eg: time.Time, net.IP
+	if str, ok := val.(string); ok && fv.CanAddr() {
+		if tu, ok := fv.Addr().Interface().(encoding.TextUnmarshaler); ok {
+			return tu.UnmarshalText([]byte(str))
+		}
+	}
+
+	switch fv.Kind() {
+	case reflect.Ptr:
+		elem := reflect.New(fv.Type().Elem())
+		if err := sd.setValue(path, elem.Elem(), val); err != nil {
+			return err
+		}
+		fv.Set(elem)
+		return nil
+	case reflect.Struct:
+		sub, ok := toStringKeyMap(val)
+		if !ok {
+			break
+		}
+		return sd.mapToStruct(path, sub, fv)
+	case reflect.Slice:
+		if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+			// eg: string => []byte
+			if !sd.opt.Strict && rv.Type().ConvertibleTo(fv.Type()) {
+				fv.Set(rv.Convert(fv.Type()))
+				return nil
+			}
+			break
+		}
+
+		newSl := reflect.MakeSlice(fv.Type(), rv.Len(), rv.Len())
+		for i := 0; i < rv.Len(); i++ {
+			if err := sd.setValue(joinPath(path, strconv.Itoa(i)), newSl.Index(i), rv.Index(i).Interface()); err != nil {
+				return err
+			}
+		}
+		fv.Set(newSl)
+		return nil
+	case reflect.Map:
+		if rv.Kind() != reflect.Map {
+			break
+		}
+
+		newMp := reflect.MakeMapWithSize(fv.Type(), rv.Len())
+		for _, key := range rv.MapKeys() {
+			nk := reflect.New(fv.Type().Key()).Elem()
+			if err := sd.setValue(path, nk, key.Interface()); err != nil {
+				return err
+			}
+
+			nv := reflect.New(fv.Type().Elem()).Elem()
+			if err := sd.setValue(joinPath(path, toKeyString(key)), nv, rv.MapIndex(key).Interface()); err != nil {
+				return err
+			}
+			newMp.SetMapIndex(nk, nv)
+		}
+		fv.Set(newMp)
+		return nil
+	}
+
+	if sd.opt.Strict {
+		// only allow convert between numbers. eg: int64 => int, float64(2) => int
+		if isNumberKind(rv.Kind()) && isNumberKind(fv.Kind()) {
+			if isFloatKind(rv.Kind()) && !isFloatKind(fv.Kind()) && rv.Float() != math.Trunc(rv.Float()) {
+				return fmt.Errorf("cannot use %v as %s without truncation", val, fv.Type())
+			}
+			return setNumber(fv, rv, val)
+		}
+		if rv.Kind() == fv.Kind() && rv.Type().ConvertibleTo(fv.Type()) {
+			fv.Set(rv.Convert(fv.Type()))
+			return nil
+		}
+		return fmt.Errorf("cannot use %T value as %s", val, fv.Type())
+	}
+	return weakSetValue(fv, rv, val)
+}
+
+// setDuration set value to time.Duration field. allow: "3s", "1500"(ns), 1500(ns)
+func setDuration(fv, rv reflect.Value, val interface{}) error {
+	if rv.Kind() == reflect.String {
+		str := strings.TrimSpace(rv.String())
+		if _, ok := val.(json.Number); !ok {
+			if dur, err := time.ParseDuration(str); err == nil {
+				fv.SetInt(int64(dur))
+				return nil
+			}
+		}
+
+		f64, err := strconv.ParseFloat(str, 64)
+		if err != nil {
+			return fmt.Errorf("cannot convert %q to time.Duration", str)
+		}
+		fv.SetInt(int64(f64))
+		return nil
+	}
+
+	if isNumberKind(rv.Kind()) {
+		return setNumber(fv, rv, val)
+	}
+	return fmt.Errorf("cannot convert %T value to time.Duration", val)
+}
+
+// weakSetValue set the basic type value with weak convert.
+func weakSetValue(fv, rv reflect.Value, val interface{}) error {
+	switch fv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return setNumber(fv, rv, val)
+	case reflect.Bool:
+		if bl, ok := toBool(baseValue(rv)); ok {
+			fv.SetBool(bl)
+			return nil
+		}
+	case reflect.String:
+		str, err := strutil.ToString(baseValue(rv))
+		if err != nil {
+			break
+		}
+		fv.SetString(str)
+		return nil
+	default:
+		if rv.Type().ConvertibleTo(fv.Type()) {
+			fv.Set(rv.Convert(fv.Type()))
+			return nil
+		}
+	}
+
+	return fmt.Errorf("cannot convert %T value to %s", val, fv.Type())
+}
+
+// setNumber convert the value and set to the number field, will check overflow.
+func setNumber(fv, rv reflect.Value, val interface{}) error {
+	switch fv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i64, err := mathutil.ToInt64(baseValue(rv))
+		if err != nil {
+			break
+		}
+		if fv.OverflowInt(i64) {
+			return fmt.Errorf("value %v overflows %s", val, fv.Type())
+		}
+		fv.SetInt(i64)
+		return nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		bv := baseValue(rv)
+		// the negative number will wrap to a huge uint on convert
+		if isNegative(bv) {
+			return fmt.Errorf("value %v overflows %s", val, fv.Type())
+		}
+
+		u64, err := mathutil.ToUint(bv)
+		if err != nil {
+			break
+		}
+		if fv.OverflowUint(u64) {
+			return fmt.Errorf("value %v overflows %s", val, fv.Type())
+		}
+		fv.SetUint(u64)
+		return nil
+	case reflect.Float32, reflect.Float64:
+		f64, err := mathutil.ToFloat(baseValue(rv))
+		if err != nil {
+			break
+		}
+		fv.SetFloat(f64)
+		return nil
+	}
+	return fmt.Errorf("cannot convert %T value to %s", val, fv.Type())
+}
+
+// isNegative check the base value is a negative number
+func isNegative(bv interface{}) bool {
+	switch typVal := bv.(type) {
+	case int64:
+		return typVal < 0
+	case float64:
+		return typVal < 0
+	case json.Number:
+		f64, err := typVal.Float64()
+		return err == nil && f64 < 0
+	}
+	return false
+}
+
+func isNumberKind(k reflect.Kind) bool {
+	return k >= reflect.Int && k <= reflect.Float64
+}
+
+func isFloatKind(k reflect.Kind) bool {
+	return k == reflect.Float32 || k == reflect.Float64
+}
+
+// baseValue get the value of basic type. eg: type Level int => int
+func baseValue(rv reflect.Value) interface{} {
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return rv.Uint()
+	case reflect.Float32, reflect.Float64:
+		return rv.Float()
+	case reflect.Bool:
+		return rv.Bool()
+	case reflect.String:
+		// json.Number can be converted by mathutil
+		if _, ok := rv.Interface().(json.Number); !ok {
+			return rv.String()
+		}
+	}
+	return rv.Interface()
+}
+
+func toBool(val interface{}) (bool, bool) {
+	switch typVal := val.(type) {
+	case bool:
+		return typVal, true
+	case string:
+		bl, err := strutil.ToBool(typVal)
+		return bl, err == nil
+	case int64:
+		return typVal != 0, true
+	case uint64:
+		return typVal != 0, true
+	case float64:
+		return typVal != 0, true
+	}
+	return false, false
+}
+
+func toStringKeyMap(val interface{}) (map[string]interface{}, bool) {
+	switch typVal := val.(type) {
+	case map[string]interface{}:
+		return typVal, true
+	case Data:
+		return typVal, true
+	case map[interface{}]interface{}:
+		mp := make(map[string]interface{}, len(typVal))
+		for k, v := range typVal {
+			mp[strutil.MustString(k)] = v
+		}
+		return mp, true
+	}
+
+	rv := reflect.ValueOf(val)
+	if rv.Kind() != reflect.Map || rv.Type().Key().Kind() != reflect.String {
+		return nil, false
+	}
+
+	mp := make(map[string]interface{}, rv.Len())
+	for _, key := range rv.MapKeys() {
+		mp[key.String()] = rv.MapIndex(key).Interface()
+	}
+	return mp, true
+}
@@ -0,0 +1,197 @@
+package maputil_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/gookit/goutil/maputil"
+	"github.com/stretchr/testify/assert"
+)
+
+type testBase struct {
+	ID int `json:"id"`
+}
+
+type testDbConf struct {
+	Host string
+	Port uint16 `map:"port"`
+}
+
+type testConfig struct {
+	testBase
+	Name     string            `json:"name"`
+	Debug    bool              `json:"debug"`
+	Rate     float64           `json:"rate,omitempty"`
+	Tags     []string          `json:"tags"`
+	DB       testDbConf        `json:"db"`
+	Backup   *testDbConf       `json:"backup"`
+	Servers  []testDbConf      `json:"servers"`
+	Labels   map[string]string `json:"labels"`
+	Timeout  time.Duration     `json:"timeout"`
+	Password string            `json:"-"`
+	private  string
+}
+
+func TestToStruct(t *testing.T) {
+	mp := map[string]interface{}{
+		"id":    "23",
+		"name":  "app",
+		"debug": "true",
+		"rate":  json.Number("1.5"),
+		"tags":  []interface{}{"a", 2},
+		"db": map[string]interface{}{
+			"host": "localhost",
+			"port": "3306",
+		},
+		"backup": map[interface{}]interface{}{"host": "backup-host", "port": 3307},
+		"servers": []interface{}{
+			map[string]interface{}{"Host": "s1"},
+			map[string]string{"Host": "s2", "port": "80"},
+		},
+		"labels":   map[string]interface{}{"env": "dev", "ver": 1},
+		"timeout":  int64(time.Second),
+		"Password": "should-skip",
+		"private":  "should-skip",
+	}
+
+	conf := &testConfig{}
+	err := maputil.ToStruct(mp, conf)
+	assert.NoError(t, err)
+	assert.Equal(t, 23, conf.ID)
+	assert.Equal(t, "app", conf.Name)
+	assert.True(t, conf.Debug)
+	assert.Equal(t, 1.5, conf.Rate)
+	assert.Equal(t, []string{"a", "2"}, conf.Tags)
+	assert.Equal(t, testDbConf{Host: "localhost", Port: 3306}, conf.DB)
+	assert.Equal(t, &testDbConf{Host: "backup-host", Port: 3307}, conf.Backup)
+	assert.Equal(t, []testDbConf{{Host: "s1"}, {Host: "s2", Port: 80}}, conf.Servers)
+	assert.Equal(t, map[string]string{"env": "dev", "ver": "1"}, conf.Labels)
+	assert.Equal(t, time.Second, conf.Timeout)
+	assert.Equal(t, "", conf.Password)
+	assert.Equal(t, "", conf.private)
+
+	// error
+	assert.Error(t, maputil.ToStruct(mp, *conf))
+	assert.Error(t, maputil.ToStruct(mp, (*testConfig)(nil)))
+	assert.Error(t, maputil.ToStruct(map[string]interface{}{"id": "abc"}, conf))
+	assert.Error(t, maputil.ToStruct(map[string]interface{}{"db": map[string]interface{}{"port": 70000}}, conf))
+	assert.Error(t, maputil.ToStruct(map[string]interface{}{"tags": map[string]int{}}, conf))
+}
+
+func TestToStructWith(t *testing.T) {
+	mp := map[string]interface{}{
+		"id":      1,
+		"unknown": true,
+		"db":      map[string]interface{}{"host": "localhost", "user": "root"},
+	}
+
+	conf := &testConfig{}
+	err := maputil.ToStructWith(mp, conf, func(opt *maputil.StructOptions) {
+		opt.ErrorUnused = true
+	})
+
+	kErr, ok := err.(*maputil.KeysError)
+	assert.True(t, ok)
+	assert.Equal(t, []string{"db.user", "unknown"}, kErr.Unused)
+	assert.Empty(t, kErr.Missing)
+	assert.Equal(t, "localhost", conf.DB.Host)
+
+	// missing
+	err = maputil.ToStructWith(map[string]interface{}{"id": 1, "db": map[string]interface{}{}}, conf, func(opt *maputil.StructOptions) {
+		opt.ErrorMissing = true
+	})
+	assert.ErrorContains(t, err, "has missing keys")
+	kErr, ok = err.(*maputil.KeysError)
+	assert.True(t, ok)
+	assert.Contains(t, kErr.Missing, "name")
+	assert.Contains(t, kErr.Missing, "db.Host")
+	assert.NotContains(t, kErr.Missing, "id")
+
+	// strict
+	strict := func(opt *maputil.StructOptions) {
+		opt.Strict = true
+	}
+	assert.NoError(t, maputil.ToStructWith(map[string]interface{}{"id": float64(2)}, conf, strict))
+	assert.Equal(t, 2, conf.ID)
+	assert.Error(t, maputil.ToStructWith(map[string]interface{}{"id": "2"}, conf, strict))
+
+	// tag names
+	err = maputil.ToStructWith(map[string]interface{}{"db": map[string]interface{}{"port": 80}}, conf, func(opt *maputil.StructOptions) {
+		opt.TagNames = []string{"json"}
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, uint16(80), conf.DB.Port)
+
+	// duration
+	assert.NoError(t, maputil.ToStruct(map[string]interface{}{"timeout": "3s"}, conf))
+	assert.Equal(t, 3*time.Second, conf.Timeout)
+	assert.NoError(t, maputil.ToStruct(map[string]interface{}{"timeout": "1500"}, conf))
+	assert.Equal(t, 1500*time.Nanosecond, conf.Timeout)
+	assert.Error(t, maputil.ToStruct(map[string]interface{}{"timeout": "abc"}, conf))
+}
+
+func TestToStruct_negativeToUint(t *testing.T) {
+	st := &struct{ N uint64 }{}
+	for _, val := range []interface{}{-1, int8(-1), float64(-1), json.Number("-1"), "-1"} {
+		err := maputil.ToStruct(map[string]interface{}{"N": val}, st)
+		assert.Error(t, err, "value: %#v", val)
+		assert.Equal(t, uint64(0), st.N)
+
+		err = maputil.ToStructWith(map[string]interface{}{"N": val}, st, func(opt *maputil.StructOptions) {
+			opt.Strict = true
+		})
+		assert.Error(t, err, "value: %#v", val)
+	}
+
+	assert.NoError(t, maputil.ToStruct(map[string]interface{}{"N": float64(2)}, st))
+	assert.Equal(t, uint64(2), st.N)
+}
+
+func TestFromStruct(t *testing.T) {
+	conf := &testConfig{
+		testBase: testBase{ID: 23},
+		Name:     "app",
+		Tags:     []string{"a"},
+		DB:       testDbConf{Host: "localhost", Port: 3306},
+		Servers:  []testDbConf{{Host: "s1"}},
+		Timeout:  time.Second,
+		Password: "secret",
+	}
+
+	mp, err := maputil.FromStruct(conf)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"id":    23,
+		"name":  "app",
+		"debug": false,
+		"tags":  []string{"a"},
+		"db": map[string]interface{}{
+			"Host": "localhost",
+			"port": uint16(3306),
+		},
+		"backup": nil,
+		"servers": []interface{}{
+			map[string]interface{}{"Host": "s1", "port": uint16(0)},
+		},
+		"labels":  map[string]string(nil),
+		"timeout": time.Second,
+	}, mp)
+
+	// time.Time should be kept
+	now := time.Now()
+	mp, err = maputil.FromStruct(struct{ Time time.Time }{now})
+	assert.NoError(t, err)
+	assert.Equal(t, now, mp["Time"])
+
+	// round trip
+	newConf := &testConfig{}
+	assert.NoError(t, maputil.ToStruct(map[string]interface{}{}, newConf))
+	mp, _ = maputil.FromStruct(conf)
+	assert.NoError(t, maputil.ToStruct(mp, newConf))
+	conf.Password = ""
+	assert.Equal(t, conf, newConf)
+
+	_, err = maputil.FromStruct("invalid")
+	assert.Error(t, err)
+}
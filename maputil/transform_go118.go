@@ -0,0 +1,40 @@
+//go:build go1.18
+// +build go1.18
+
+package maputil
+
+// FilterMap returns a new map with the entries that the pred func returns true.
+//
+// Usage:
+// 	mp := maputil.FilterMap(mp, func(key string, val int) bool {
+// 		return val > 0
+// 	})
+func FilterMap[K comparable, V any](mp map[K]V, pred func(key K, val V) bool) map[K]V {
+	newMp := make(map[K]V)
+	for key, val := range mp {
+		if pred(key, val) {
+			newMp[key] = val
+		}
+	}
+	return newMp
+}
+
+// MapValues returns a new map with the values converted by the fn.
+func MapValues[K comparable, V, T any](mp map[K]V, fn func(key K, val V) T) map[K]T {
+	newMp := make(map[K]T, len(mp))
+	for key, val := range mp {
+		newMp[key] = fn(key, val)
+	}
+	return newMp
+}
+
+// MapKeys returns a new map with the keys converted by the fn.
+//
+// NOTE: if the fn returns duplicate keys, which value will be kept is not determined.
+func MapKeys[K, T comparable, V any](mp map[K]V, fn func(key K, val V) T) map[T]V {
+	newMp := make(map[T]V, len(mp))
+	for key, val := range mp {
+		newMp[fn(key, val)] = val
+	}
+	return newMp
+}
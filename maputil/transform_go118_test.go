@@ -0,0 +1,48 @@
+//go:build go1.18
+// +build go1.18
+
+package maputil_test
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gookit/goutil/maputil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterMap(t *testing.T) {
+	mp := map[string]int{"a": 1, "b": -2, "c": 3}
+
+	newMp := maputil.FilterMap(mp, func(key string, val int) bool {
+		return val > 0
+	})
+	assert.Equal(t, map[string]int{"a": 1, "c": 3}, newMp)
+	assert.Len(t, mp, 3)
+
+	newMp = maputil.FilterMap(mp, func(key string, val int) bool {
+		return false
+	})
+	assert.NotNil(t, newMp)
+	assert.Empty(t, newMp)
+}
+
+func TestMapValues_MapKeys(t *testing.T) {
+	mp := map[string]int{"a": 1, "b": 2}
+
+	strMp := maputil.MapValues(mp, func(key string, val int) string {
+		return key + "=" + strconv.Itoa(val)
+	})
+	assert.Equal(t, map[string]string{"a": "a=1", "b": "b=2"}, strMp)
+
+	upMp := maputil.MapKeys(mp, func(key string, val int) string {
+		return strings.ToUpper(key)
+	})
+	assert.Equal(t, map[string]int{"A": 1, "B": 2}, upMp)
+
+	idxMp := maputil.MapKeys(mp, func(key string, val int) int {
+		return val * 10
+	})
+	assert.Equal(t, map[int]int{10: 1, 20: 2}, idxMp)
+}
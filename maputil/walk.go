@@ -0,0 +1,104 @@
+package maputil
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+// WalkAction the action returned by the WalkFunc
+type WalkAction uint8
+
+// walk actions
+const (
+	// WalkContinue continue walk, will walk into the children of current value.
+	WalkContinue WalkAction = iota
+	// WalkSkip skip walk the children of current value.
+	WalkSkip
+	// WalkStop stop walk.
+	WalkStop
+)
+
+// WalkFunc the visitor func for Walk. path is the key path of the value. eg: "db.hosts.0"
+//
+// the returned newVal will replace the current value, return val for keep it unchanged.
+type WalkFunc func(path string, val interface{}) (newVal interface{}, action WalkAction)
+
+// Walk visit all values in the nested map[string]interface{}, map[interface{}]interface{}
+// and []interface{} data recursive. the map keys are visited in sorted order.
+//
+// Usage:
+// 	// expand ENV vars in all string values
+// 	maputil.Walk(conf, func(path string, val interface{}) (interface{}, maputil.WalkAction) {
+// 		if str, ok := val.(string); ok {
+// 			return os.ExpandEnv(str), maputil.WalkContinue
+// 		}
+// 		return val, maputil.WalkContinue
+// 	})
+func Walk(data interface{}, fn WalkFunc) {
+	walkNode("", data, fn)
+}
+
+// walkNode walk the children of the node, returns false on stop walk.
+func walkNode(prefix string, node interface{}, fn WalkFunc) bool {
+	switch typNode := node.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(typNode))
+		for key := range typNode {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			newVal, ok := walkValue(joinPath(prefix, key), typNode[key], fn)
+			typNode[key] = newVal
+			if !ok {
+				return false
+			}
+		}
+	case Data:
+		return walkNode(prefix, map[string]interface{}(typNode), fn)
+	case map[interface{}]interface{}:
+		keys := make([]interface{}, 0, len(typNode))
+		for key := range typNode {
+			keys = append(keys, key)
+		}
+
+		names := make(map[interface{}]string, len(keys))
+		for _, key := range keys {
+			names[key] = fmt.Sprint(key)
+		}
+		sort.Slice(keys, func(i, j int) bool {
+			return names[keys[i]] < names[keys[j]]
+		})
+
+		for _, key := range keys {
+			newVal, ok := walkValue(joinPath(prefix, names[key]), typNode[key], fn)
+			typNode[key] = newVal
+			if !ok {
+				return false
+			}
+		}
+	case []interface{}:
+		for i, val := range typNode {
+			newVal, ok := walkValue(joinPath(prefix, strconv.Itoa(i)), val, fn)
+			typNode[i] = newVal
+			if !ok {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// walkValue call the fn for the value, then walk its children. returns false on stop walk.
+func walkValue(path string, val interface{}, fn WalkFunc) (interface{}, bool) {
+	newVal, action := fn(path, val)
+	switch action {
+	case WalkStop:
+		return newVal, false
+	case WalkSkip:
+		return newVal, true
+	}
+	return newVal, walkNode(path, newVal, fn)
+}
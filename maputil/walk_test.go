@@ -0,0 +1,64 @@
+package maputil_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/gookit/goutil/maputil"
+	"github.com/gookit/goutil/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWalk(t *testing.T) {
+	mp := map[string]interface{}{
+		"name": "${APP_NAME}",
+		"port": 8080,
+		"db": map[string]interface{}{
+			"hosts": []interface{}{"${DB_HOST}", "h2"},
+		},
+		"yml": map[interface{}]interface{}{"key": "${APP_NAME}"},
+	}
+
+	var paths []string
+	testutil.MockEnvValues(map[string]string{"APP_NAME": "app", "DB_HOST": "h1"}, func() {
+		maputil.Walk(mp, func(path string, val interface{}) (interface{}, maputil.WalkAction) {
+			paths = append(paths, path)
+			if str, ok := val.(string); ok {
+				return os.ExpandEnv(str), maputil.WalkContinue
+			}
+			return val, maputil.WalkContinue
+		})
+	})
+
+	assert.Equal(t, []string{"db", "db.hosts", "db.hosts.0", "db.hosts.1", "name", "port", "yml", "yml.key"}, paths)
+	assert.Equal(t, "app", mp["name"])
+	assert.Equal(t, "app", mp["yml"].(map[interface{}]interface{})["key"])
+	v, _ := maputil.GetByPath("db.hosts.0", mp)
+	assert.Equal(t, "h1", v)
+
+	// skip and stop
+	paths = paths[:0]
+	maputil.Walk(mp, func(path string, val interface{}) (interface{}, maputil.WalkAction) {
+		paths = append(paths, path)
+		if path == "db" {
+			return val, maputil.WalkSkip
+		}
+		if strings.HasPrefix(path, "port") {
+			return 9090, maputil.WalkStop
+		}
+		return val, maputil.WalkContinue
+	})
+	assert.Equal(t, []string{"db", "name", "port"}, paths)
+	assert.Equal(t, 9090, mp["port"])
+
+	// replace a node
+	maputil.Walk(mp, func(path string, val interface{}) (interface{}, maputil.WalkAction) {
+		if path == "db" {
+			return map[string]interface{}{"host": "h3"}, maputil.WalkContinue
+		}
+		return val, maputil.WalkContinue
+	})
+	v, _ = maputil.GetByPath("db.host", mp)
+	assert.Equal(t, "h3", v)
+}
@@ -0,0 +1,98 @@
+package mathutil
+
+import (
+	"fmt"
+	"strings"
+)
+
+// base62Chars the digits for base conversion
+const base62Chars = "0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+
+// MustToBase convert the integer to string by the base, will panic on invalid base. see ToBase()
+func MustToBase(n int64, base int) string {
+	s, err := ToBase(n, base)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// ToBase convert the integer to string by the base, allow base is 2 - 62.
+// it is same as strconv.FormatInt on base <= 36.
+//
+// Usage:
+// 	str, err := mathutil.ToBase(123456789, 62) // "8m0Kx"
+func ToBase(n int64, base int) (string, error) {
+	if base < 2 || base > len(base62Chars) {
+		return "", fmt.Errorf("mathutil: invalid base %d, allow 2 - 62", base)
+	}
+
+	if n == 0 {
+		return "0", nil
+	}
+
+	u := uint64(n)
+	if n < 0 {
+		u = uint64(-n) // MinInt64 is ok
+	}
+
+	var buf [65]byte
+	i := len(buf)
+	for u > 0 {
+		i--
+		buf[i] = base62Chars[u%uint64(base)]
+		u /= uint64(base)
+	}
+
+	if n < 0 {
+		i--
+		buf[i] = '-'
+	}
+	return string(buf[i:]), nil
+}
+
+// FromBase parse the string to integer by the base, allow base is 2 - 62.
+// on base <= 36 the letters are case-insensitive.
+func FromBase(s string, base int) (int64, error) {
+	if base < 2 || base > len(base62Chars) {
+		return 0, fmt.Errorf("mathutil: invalid base %d, allow 2 - 62", base)
+	}
+
+	str := strings.TrimSpace(s)
+	neg := strings.HasPrefix(str, "-")
+	if neg || strings.HasPrefix(str, "+") {
+		str = str[1:]
+	}
+
+	if str == "" {
+		return 0, fmt.Errorf("mathutil: invalid base%d string %q", base, s)
+	}
+
+	if base <= 36 {
+		str = strings.ToLower(str)
+	}
+
+	// the abs value limit
+	limit := uint64(1) << 63
+	if !neg {
+		limit--
+	}
+
+	var u uint64
+	for i := 0; i < len(str); i++ {
+		d := strings.IndexByte(base62Chars[:base], str[i])
+		if d < 0 {
+			return 0, fmt.Errorf("mathutil: invalid base%d string %q", base, s)
+		}
+
+		if u > (limit-uint64(d))/uint64(base) {
+			return 0, fmt.Errorf("%w: parse base%d string %q", ErrOverflow, base, s)
+		}
+		u = u*uint64(base) + uint64(d)
+	}
+
+	if neg {
+		return -int64(u), nil
+	}
+	return int64(u), nil
+}
@@ -0,0 +1,60 @@
+package mathutil_test
+
+import (
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/gookit/goutil/mathutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToBase_FromBase(t *testing.T) {
+	assert.Equal(t, "0", mathutil.MustToBase(0, 62))
+	assert.Equal(t, "Z", mathutil.MustToBase(61, 62))
+	assert.Equal(t, "10", mathutil.MustToBase(62, 62))
+	assert.Equal(t, "-ff", mathutil.MustToBase(-255, 16))
+
+	for _, n := range []int64{0, 1, -1, 23, 123456789, math.MaxInt64, math.MinInt64} {
+		for _, base := range []int{2, 8, 10, 16, 36, 62} {
+			str, err := mathutil.ToBase(n, base)
+			assert.NoError(t, err)
+			if base <= 36 {
+				assert.Equal(t, strconv.FormatInt(n, base), str)
+			}
+
+			val, err := mathutil.FromBase(str, base)
+			assert.NoError(t, err)
+			assert.Equal(t, n, val, "base %d: %s", base, str)
+		}
+	}
+
+	// case-insensitive on base <= 36
+	val, err := mathutil.FromBase("FF", 16)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(255), val)
+
+	val, err = mathutil.FromBase("+A", 62)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(36), val)
+
+	_, err = mathutil.ToBase(1, 63)
+	assert.Error(t, err)
+	_, err = mathutil.ToBase(1, 1)
+	assert.Error(t, err)
+	assert.Panics(t, func() {
+		mathutil.MustToBase(1, 63)
+	})
+
+	for _, str := range []string{"", "-", "12x", "2"} {
+		_, err = mathutil.FromBase(str, 2)
+		assert.Error(t, err, str)
+	}
+
+	_, err = mathutil.FromBase("1", 1)
+	assert.Error(t, err)
+	_, err = mathutil.FromBase("AzL8n0Y58m8", 62)
+	assert.ErrorIs(t, err, mathutil.ErrOverflow)
+	_, err = mathutil.FromBase("9223372036854775808", 10)
+	assert.ErrorIs(t, err, mathutil.ErrOverflow)
+}
@@ -0,0 +1,92 @@
+//go:build go1.18
+// +build go1.18
+
+package mathutil
+
+import "github.com/gookit/goutil/comdef"
+
+// Min compare two numbers, returns the smaller one
+func Min[T comdef.XintOrFloat](a, b T) T {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// Max compare two numbers, returns the bigger one
+func Max[T comdef.XintOrFloat](a, b T) T {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// MinIn get the min value in the list. returns zero value on the list is empty.
+func MinIn[T comdef.XintOrFloat](list []T) T {
+	var min T
+	for i, val := range list {
+		if i == 0 || val < min {
+			min = val
+		}
+	}
+	return min
+}
+
+// MaxIn get the max value in the list. returns zero value on the list is empty.
+//
+// Usage:
+// 	mathutil.MaxIn([]int{2, 5, 3}) // 5
+func MaxIn[T comdef.XintOrFloat](list []T) T {
+	var max T
+	for i, val := range list {
+		if i == 0 || val > max {
+			max = val
+		}
+	}
+	return max
+}
+
+// Clamp limit the value at the range [min, max]
+//
+// Usage:
+// 	mathutil.Clamp(12, 0, 10) // 10
+// 	mathutil.Clamp(-2, 0, 10) // 0
+func Clamp[T comdef.XintOrFloat](val, min, max T) T {
+	if val < min {
+		return min
+	}
+	if val > max {
+		return max
+	}
+	return val
+}
+
+// Abs get the absolute value of the number
+func Abs[T comdef.IntOrFloat](val T) T {
+	if val < 0 {
+		return -val
+	}
+	return val
+}
+
+// Sum all numbers
+func Sum[T comdef.XintOrFloat](list ...T) T {
+	var sum T
+	for _, val := range list {
+		sum += val
+	}
+	return sum
+}
+
+// Avg get the average value of numbers. returns 0 on the list is empty.
+func Avg[T comdef.XintOrFloat](list ...T) float64 {
+	if len(list) == 0 {
+		return 0
+	}
+
+	var sum float64
+	for _, val := range list {
+		sum += float64(val)
+	}
+	return sum / float64(len(list))
+}
@@ -0,0 +1,48 @@
+//go:build go1.18
+// +build go1.18
+
+package mathutil_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gookit/goutil/mathutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMin_Max(t *testing.T) {
+	assert.Equal(t, 2, mathutil.Min(2, 3))
+	assert.Equal(t, 3, mathutil.Max(2, 3))
+	assert.Equal(t, 2.5, mathutil.Min(2.5, 3.1))
+	assert.Equal(t, uint8(3), mathutil.Max(uint8(2), uint8(3)))
+	assert.Equal(t, time.Second, mathutil.Max(time.Millisecond, time.Second))
+
+	assert.Equal(t, -1, mathutil.MinIn([]int{3, -1, 5}))
+	assert.Equal(t, 5, mathutil.MaxIn([]int{3, -1, 5}))
+	assert.Equal(t, -1.5, mathutil.MaxIn([]float64{-3, -1.5}))
+	assert.Equal(t, 0, mathutil.MaxIn([]int{}))
+	assert.Equal(t, 0, mathutil.MinIn([]int(nil)))
+}
+
+func TestClamp_Abs(t *testing.T) {
+	assert.Equal(t, 10, mathutil.Clamp(12, 0, 10))
+	assert.Equal(t, 0, mathutil.Clamp(-2, 0, 10))
+	assert.Equal(t, 5, mathutil.Clamp(5, 0, 10))
+	assert.Equal(t, 0.5, mathutil.Clamp(0.5, 0, 1))
+
+	assert.Equal(t, 3, mathutil.Abs(-3))
+	assert.Equal(t, 3, mathutil.Abs(3))
+	assert.Equal(t, 1.5, mathutil.Abs(-1.5))
+	assert.Equal(t, int64(0), mathutil.Abs(int64(0)))
+}
+
+func TestSum_Avg(t *testing.T) {
+	assert.Equal(t, 6, mathutil.Sum(1, 2, 3))
+	assert.Equal(t, 4.0, mathutil.Sum(1.5, 2.5))
+	assert.Equal(t, uint(0), mathutil.Sum[uint]())
+
+	assert.Equal(t, 2.0, mathutil.Avg(1, 2, 3))
+	assert.Equal(t, 2.5, mathutil.Avg(2, 3))
+	assert.Equal(t, 0.0, mathutil.Avg[int]())
+}
@@ -12,6 +12,8 @@ import (
 var (
 	// ErrConvertFail convert error
 	ErrConvertFail = errors.New("convert data type is failure")
+	// ErrOverflow the number is overflow or underflow the target type
+	ErrOverflow = errors.New("the number is overflow the target type")
 	// ErrConvertFail = errors.New("convert data type is failure")
 )
 
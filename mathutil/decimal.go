@@ -0,0 +1,123 @@
+package mathutil
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// AddStr add two decimal strings, without float precision loss. eg: "0.1" + "0.2" = "0.3"
+//
+// Usage:
+// 	sum, err := mathutil.AddStr("19.99", "0.01") // "20"
+func AddStr(a, b string) (string, error) {
+	x, y, scale, err := parseDecimalPair(a, b)
+	if err != nil {
+		return "", err
+	}
+	return formatDecimal(x.Add(x, y), scale), nil
+}
+
+// SubStr subtract two decimal strings: a - b
+func SubStr(a, b string) (string, error) {
+	x, y, scale, err := parseDecimalPair(a, b)
+	if err != nil {
+		return "", err
+	}
+	return formatDecimal(x.Sub(x, y), scale), nil
+}
+
+// MulStr multiply two decimal strings: a * b
+func MulStr(a, b string) (string, error) {
+	x, xs, err := parseDecimal(a)
+	if err != nil {
+		return "", err
+	}
+
+	y, ys, err := parseDecimal(b)
+	if err != nil {
+		return "", err
+	}
+	return formatDecimal(x.Mul(x, y), xs+ys), nil
+}
+
+// CmpStr compare two decimal strings. returns -1 on a < b, 0 on a == b, 1 on a > b
+func CmpStr(a, b string) (int, error) {
+	x, y, _, err := parseDecimalPair(a, b)
+	if err != nil {
+		return 0, err
+	}
+	return x.Cmp(y), nil
+}
+
+// parseDecimalPair parse two decimal strings and align them to same scale.
+func parseDecimalPair(a, b string) (x, y *big.Int, scale int, err error) {
+	x, xs, err := parseDecimal(a)
+	if err != nil {
+		return
+	}
+
+	y, ys, err := parseDecimal(b)
+	if err != nil {
+		return
+	}
+
+	scale = xs
+	if ys > xs {
+		scale = ys
+		x.Mul(x, pow10(ys-xs))
+	} else if xs > ys {
+		y.Mul(y, pow10(xs-ys))
+	}
+	return
+}
+
+// parseDecimal parse decimal string to an integer and the scale. eg: "-1.23" => -123, 2
+func parseDecimal(s string) (*big.Int, int, error) {
+	str := strings.TrimSpace(s)
+	if str == "" {
+		return nil, 0, fmt.Errorf("mathutil: invalid decimal string %q", s)
+	}
+
+	sign := ""
+	if str[0] == '-' || str[0] == '+' {
+		sign, str = str[:1], str[1:]
+	}
+
+	intPart, fracPart := str, ""
+	if pos := strings.IndexByte(str, '.'); pos >= 0 {
+		intPart, fracPart = str[:pos], str[pos+1:]
+	}
+
+	digits := intPart + fracPart
+	if digits == "" || strings.Trim(digits, "0123456789") != "" {
+		return nil, 0, fmt.Errorf("mathutil: invalid decimal string %q", s)
+	}
+
+	num, _ := new(big.Int).SetString(sign+digits, 10)
+	return num, len(fracPart), nil
+}
+
+// formatDecimal format the integer and scale to decimal string, will trim the tailing zeros.
+func formatDecimal(num *big.Int, scale int) string {
+	neg := num.Sign() < 0
+	digits := new(big.Int).Abs(num).String()
+
+	if scale > 0 {
+		if len(digits) <= scale {
+			digits = strings.Repeat("0", scale-len(digits)+1) + digits
+		}
+
+		digits = digits[:len(digits)-scale] + "." + digits[len(digits)-scale:]
+		digits = strings.TrimRight(strings.TrimRight(digits, "0"), ".")
+	}
+
+	if neg {
+		return "-" + digits
+	}
+	return digits
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
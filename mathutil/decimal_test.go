@@ -0,0 +1,67 @@
+package mathutil_test
+
+import (
+	"testing"
+
+	"github.com/gookit/goutil/mathutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddStr_SubStr(t *testing.T) {
+	tests := []struct {
+		a, b, sum, sub string
+	}{
+		{"0.1", "0.2", "0.3", "-0.1"},
+		{"19.99", "0.01", "20", "19.98"},
+		{"-1.5", "2", "0.5", "-3.5"},
+		{"100", "100.00", "200", "0"},
+		{"123456789012345678901234567890.12", "0.88", "123456789012345678901234567891", "123456789012345678901234567889.24"},
+		{"+.5", "0.", "0.5", "0.5"},
+	}
+
+	for _, tt := range tests {
+		sum, err := mathutil.AddStr(tt.a, tt.b)
+		assert.NoError(t, err)
+		assert.Equal(t, tt.sum, sum, "%s + %s", tt.a, tt.b)
+
+		sub, err := mathutil.SubStr(tt.a, tt.b)
+		assert.NoError(t, err)
+		assert.Equal(t, tt.sub, sub, "%s - %s", tt.a, tt.b)
+	}
+
+	_, err := mathutil.AddStr("1.2.3", "1")
+	assert.Error(t, err)
+	_, err = mathutil.SubStr("1", "abc")
+	assert.Error(t, err)
+	_, err = mathutil.AddStr("", "1")
+	assert.Error(t, err)
+	_, err = mathutil.AddStr("-", "1")
+	assert.Error(t, err)
+}
+
+func TestMulStr_CmpStr(t *testing.T) {
+	val, err := mathutil.MulStr("1.1", "1.1")
+	assert.NoError(t, err)
+	assert.Equal(t, "1.21", val)
+
+	val, err = mathutil.MulStr("-0.05", "20")
+	assert.NoError(t, err)
+	assert.Equal(t, "-1", val)
+
+	val, err = mathutil.MulStr("0.001", "0.001")
+	assert.NoError(t, err)
+	assert.Equal(t, "0.000001", val)
+
+	_, err = mathutil.MulStr("x", "1")
+	assert.Error(t, err)
+
+	n, err := mathutil.CmpStr("0.30", "0.3")
+	assert.NoError(t, err)
+	assert.Equal(t, 0, n)
+	n, _ = mathutil.CmpStr("-1", "0.5")
+	assert.Equal(t, -1, n)
+	n, _ = mathutil.CmpStr("10.01", "10")
+	assert.Equal(t, 1, n)
+	_, err = mathutil.CmpStr("1", "1e3")
+	assert.Error(t, err)
+}
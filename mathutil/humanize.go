@@ -0,0 +1,75 @@
+package mathutil
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// humanize number units
+var humanUnits = []string{"", "K", "M", "B", "T"}
+
+// HumanizeNumber format the large number to human-readable string. eg: 1234 => "1.2K", 3400000 => "3.4M"
+//
+// precision is the decimal places, the tailing zeros will be trimmed.
+//
+// Usage:
+// 	mathutil.HumanizeNumber(1234, 1) // "1.2K"
+// 	mathutil.HumanizeNumber(1100000000, 2) // "1.1B"
+func HumanizeNumber(val float64, precision int) string {
+	if math.IsNaN(val) || math.IsInf(val, 0) {
+		return strconv.FormatFloat(val, 'f', -1, 64)
+	}
+
+	sign := ""
+	if val < 0 {
+		sign, val = "-", -val
+	}
+
+	idx := 0
+	for val >= 1000 && idx < len(humanUnits)-1 {
+		val /= 1000
+		idx++
+	}
+
+	// eg: 999.96K => 1000K => 1M
+	val = Round(val, precision)
+	if val >= 1000 && idx < len(humanUnits)-1 {
+		val /= 1000
+		idx++
+	}
+
+	return sign + strconv.FormatFloat(val, 'f', -1, 64) + humanUnits[idx]
+}
+
+// ParseHumanNumber parse the human-readable number string. eg: "3.4M" => 3400000, "1.2k" => 1200
+func ParseHumanNumber(s string) (float64, error) {
+	str := strings.TrimSpace(s)
+	if str == "" {
+		return 0, fmt.Errorf("mathutil: invalid human number %q", s)
+	}
+
+	multiple := 1.0
+	unit := strings.ToUpper(str[len(str)-1:])
+	for i := 1; i < len(humanUnits); i++ {
+		if unit == humanUnits[i] {
+			multiple = math.Pow(1000, float64(i))
+			str = strings.TrimSpace(str[:len(str)-1])
+			break
+		}
+	}
+
+	num, err := strconv.ParseFloat(str, 64)
+	if err != nil {
+		return 0, fmt.Errorf("mathutil: invalid human number %q", s)
+	}
+
+	// use decimal string multiply, avoid float precision loss. eg: 3.4 * 1e6
+	if multiple > 1 {
+		if ret, err := MulStr(str, strconv.FormatFloat(multiple, 'f', -1, 64)); err == nil {
+			return strconv.ParseFloat(ret, 64)
+		}
+	}
+	return num * multiple, nil
+}
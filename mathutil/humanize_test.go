@@ -0,0 +1,57 @@
+package mathutil_test
+
+import (
+	"testing"
+
+	"github.com/gookit/goutil/mathutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHumanizeNumber(t *testing.T) {
+	tests := []struct {
+		val       float64
+		precision int
+		want      string
+	}{
+		{0, 1, "0"},
+		{999, 1, "999"},
+		{1000, 1, "1K"},
+		{1234, 1, "1.2K"},
+		{1250, 2, "1.25K"},
+		{3400000, 1, "3.4M"},
+		{1100000000, 2, "1.1B"},
+		{999960, 1, "1M"},
+		{2.5e12, 1, "2.5T"},
+		{3e15, 0, "3000T"},
+		{-1234, 1, "-1.2K"},
+		{12.345, 1, "12.3"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, mathutil.HumanizeNumber(tt.val, tt.precision))
+	}
+}
+
+func TestParseHumanNumber(t *testing.T) {
+	tests := map[string]float64{
+		"3.4M":  3400000,
+		"1.2k":  1200,
+		"1.1B":  1100000000,
+		"2T":    2e12,
+		"-1.5K": -1500,
+		"123":   123,
+		" 4 M ": 4000000,
+		"0.1M":  100000,
+	}
+
+	for str, want := range tests {
+		val, err := mathutil.ParseHumanNumber(str)
+		assert.NoError(t, err, str)
+		assert.Equal(t, want, val, str)
+	}
+
+	for _, str := range []string{"", "M", "abc", "1.2X"} {
+		_, err := mathutil.ParseHumanNumber(str)
+		assert.Error(t, err, str)
+	}
+}
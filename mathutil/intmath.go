@@ -0,0 +1,110 @@
+package mathutil
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+)
+
+// GCD get the greatest common divisor of the numbers. the result is always non-negative.
+//
+// Usage:
+// 	mathutil.GCD(12, 18, 24) // 6
+func GCD(nums ...int64) int64 {
+	var ret int64
+	for _, n := range nums {
+		x, y := ret, n
+		for y != 0 {
+			x, y = y, x%y
+		}
+		ret = x
+	}
+
+	if ret < 0 {
+		return -ret
+	}
+	return ret
+}
+
+// LCM get the least common multiple of the numbers. returns 0 on any number is 0.
+func LCM(nums ...int64) int64 {
+	if len(nums) == 0 {
+		return 0
+	}
+
+	ret := int64(1)
+	for _, n := range nums {
+		if n == 0 {
+			return 0
+		}
+		if n < 0 {
+			n = -n
+		}
+		ret = ret / GCD(ret, n) * n
+	}
+	return ret
+}
+
+// PowInt get the base**exp by integer, will return error on overflow or the exp is negative.
+func PowInt(base, exp int64) (int64, error) {
+	if exp < 0 {
+		return 0, fmt.Errorf("mathutil: PowInt not support negative exp %d", exp)
+	}
+
+	// the base and exp will be changed in the loop
+	oBase, oExp := base, exp
+
+	ret := int64(1)
+	for exp > 0 {
+		if exp&1 == 1 {
+			if mulOverflow(ret, base) {
+				return 0, fmt.Errorf("%w: %d**%d", ErrOverflow, oBase, oExp)
+			}
+			ret *= base
+		}
+
+		exp >>= 1
+		if exp > 0 {
+			if mulOverflow(base, base) {
+				return 0, fmt.Errorf("%w: %d**%d", ErrOverflow, oBase, oExp)
+			}
+			base *= base
+		}
+	}
+	return ret, nil
+}
+
+func mulOverflow(a, b int64) bool {
+	if a == 0 || b == 0 {
+		return false
+	}
+
+	c := a * b
+	return c/b != a || a == -1 && b == math.MinInt64 || b == -1 && a == math.MinInt64
+}
+
+// IsPrime check the number is a prime number.
+func IsPrime(n int64) bool {
+	if n < 2 {
+		return false
+	}
+	// it is 100% accurate for inputs less than 2^64
+	return big.NewInt(n).ProbablyPrime(0)
+}
+
+// NextPrime get the smallest prime number that greater than n. returns 0 on overflow.
+//
+// Usage:
+// 	shards := mathutil.NextPrime(100) // 101
+func NextPrime(n int64) int64 {
+	if n < 2 {
+		return 2
+	}
+
+	for i := n + 1; i > 0; i++ {
+		if IsPrime(i) {
+			return i
+		}
+	}
+	return 0
+}
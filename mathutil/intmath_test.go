@@ -0,0 +1,68 @@
+package mathutil_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/gookit/goutil/mathutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGCD_LCM(t *testing.T) {
+	assert.Equal(t, int64(6), mathutil.GCD(12, 18, 24))
+	assert.Equal(t, int64(1), mathutil.GCD(7, 13))
+	assert.Equal(t, int64(4), mathutil.GCD(-8, 12))
+	assert.Equal(t, int64(5), mathutil.GCD(0, 5))
+	assert.Equal(t, int64(0), mathutil.GCD())
+
+	assert.Equal(t, int64(12), mathutil.LCM(4, 6))
+	assert.Equal(t, int64(60), mathutil.LCM(3, 4, 5))
+	assert.Equal(t, int64(6), mathutil.LCM(-2, 3))
+	assert.Equal(t, int64(0), mathutil.LCM(0, 3))
+	assert.Equal(t, int64(0), mathutil.LCM())
+}
+
+func TestPowInt(t *testing.T) {
+	val, err := mathutil.PowInt(2, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1024), val)
+
+	val, err = mathutil.PowInt(-3, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(-27), val)
+
+	val, err = mathutil.PowInt(5, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), val)
+
+	val, err = mathutil.PowInt(2, 62)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1)<<62, val)
+
+	_, err = mathutil.PowInt(2, 63)
+	assert.ErrorIs(t, err, mathutil.ErrOverflow)
+	_, err = mathutil.PowInt(10, 19)
+	assert.ErrorIs(t, err, mathutil.ErrOverflow)
+	_, err = mathutil.PowInt(10, 30)
+	assert.ErrorContains(t, err, "10**30")
+	_, err = mathutil.PowInt(2, -1)
+	assert.Error(t, err)
+
+	val, err = mathutil.PowInt(-2, 63)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(math.MinInt64), val)
+}
+
+func TestIsPrime(t *testing.T) {
+	for _, n := range []int64{2, 3, 5, 101, 7919, 2147483647} {
+		assert.True(t, mathutil.IsPrime(n), n)
+	}
+	for _, n := range []int64{-7, 0, 1, 4, 100, 7917} {
+		assert.False(t, mathutil.IsPrime(n), n)
+	}
+
+	assert.Equal(t, int64(2), mathutil.NextPrime(-1))
+	assert.Equal(t, int64(3), mathutil.NextPrime(2))
+	assert.Equal(t, int64(101), mathutil.NextPrime(100))
+	assert.Equal(t, int64(0), mathutil.NextPrime(math.MaxInt64))
+}
@@ -0,0 +1,130 @@
+package mathutil
+
+import (
+	"math"
+	"sync"
+	"sync/atomic"
+)
+
+// Counter an atomic int64 counter, concurrency-safe. the zero value is ready to use.
+type Counter struct {
+	// NOTE: keep it as the first field, for 64-bit alignment on 32-bit platform.
+	val int64
+}
+
+// Inc increase 1, returns the new value.
+func (c *Counter) Inc() int64 {
+	return atomic.AddInt64(&c.val, 1)
+}
+
+// Dec decrease 1, returns the new value.
+func (c *Counter) Dec() int64 {
+	return atomic.AddInt64(&c.val, -1)
+}
+
+// Add delta to the counter, returns the new value.
+func (c *Counter) Add(delta int64) int64 {
+	return atomic.AddInt64(&c.val, delta)
+}
+
+// Load get the current value
+func (c *Counter) Load() int64 {
+	return atomic.LoadInt64(&c.val)
+}
+
+// Reset the value to 0, returns the old value.
+func (c *Counter) Reset() int64 {
+	return atomic.SwapInt64(&c.val, 0)
+}
+
+// Gauge an atomic float64 value, concurrency-safe. the zero value is ready to use.
+type Gauge struct {
+	bits uint64
+}
+
+// Set the value
+func (g *Gauge) Set(val float64) {
+	atomic.StoreUint64(&g.bits, math.Float64bits(val))
+}
+
+// Add delta to the value, returns the new value.
+func (g *Gauge) Add(delta float64) float64 {
+	for {
+		old := atomic.LoadUint64(&g.bits)
+		val := math.Float64frombits(old) + delta
+		if atomic.CompareAndSwapUint64(&g.bits, old, math.Float64bits(val)) {
+			return val
+		}
+	}
+}
+
+// Load get the current value
+func (g *Gauge) Load() float64 {
+	return math.Float64frombits(atomic.LoadUint64(&g.bits))
+}
+
+// MovingAverage calc the average of the latest N values in the sliding window, concurrency-safe.
+//
+// Usage:
+// 	ma := mathutil.NewMovingAverage(10)
+// 	ma.Add(costMs)
+// 	fmt.Println(ma.Avg())
+type MovingAverage struct {
+	mu     sync.Mutex
+	values []float64
+	// next write position in the values
+	pos   int
+	count int
+	sum   float64
+}
+
+// NewMovingAverage create a MovingAverage with window size. size <= 0 will be as 1.
+func NewMovingAverage(size int) *MovingAverage {
+	if size <= 0 {
+		size = 1
+	}
+	return &MovingAverage{values: make([]float64, size)}
+}
+
+// Add a value to the window, the oldest value will be removed on the window is full.
+// returns the new average.
+func (ma *MovingAverage) Add(val float64) float64 {
+	ma.mu.Lock()
+	defer ma.mu.Unlock()
+
+	if ma.count == len(ma.values) {
+		ma.sum -= ma.values[ma.pos]
+	} else {
+		ma.count++
+	}
+
+	ma.values[ma.pos] = val
+	ma.sum += val
+	ma.pos = (ma.pos + 1) % len(ma.values)
+	return ma.sum / float64(ma.count)
+}
+
+// Avg get the average of values in the window. returns 0 on no values.
+func (ma *MovingAverage) Avg() float64 {
+	ma.mu.Lock()
+	defer ma.mu.Unlock()
+
+	if ma.count == 0 {
+		return 0
+	}
+	return ma.sum / float64(ma.count)
+}
+
+// Count of values in the window
+func (ma *MovingAverage) Count() int {
+	ma.mu.Lock()
+	defer ma.mu.Unlock()
+	return ma.count
+}
+
+// Reset clear all values
+func (ma *MovingAverage) Reset() {
+	ma.mu.Lock()
+	ma.pos, ma.count, ma.sum = 0, 0, 0
+	ma.mu.Unlock()
+}
@@ -0,0 +1,69 @@
+package mathutil_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/gookit/goutil/mathutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCounter(t *testing.T) {
+	c := &mathutil.Counter{}
+	wg := sync.WaitGroup{}
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c.Inc()
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int64(100), c.Load())
+	assert.Equal(t, int64(99), c.Dec())
+	assert.Equal(t, int64(109), c.Add(10))
+	assert.Equal(t, int64(109), c.Reset())
+	assert.Equal(t, int64(0), c.Load())
+}
+
+func TestGauge(t *testing.T) {
+	var g mathutil.Gauge
+	assert.Equal(t, 0.0, g.Load())
+
+	g.Set(1.5)
+	assert.Equal(t, 1.5, g.Load())
+	assert.Equal(t, 2.0, g.Add(0.5))
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			g.Add(1)
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, 52.0, g.Load())
+}
+
+func TestMovingAverage(t *testing.T) {
+	ma := mathutil.NewMovingAverage(3)
+	assert.Equal(t, 0.0, ma.Avg())
+
+	assert.Equal(t, 1.0, ma.Add(1))
+	assert.Equal(t, 1.5, ma.Add(2))
+	assert.Equal(t, 2.0, ma.Add(3))
+	// 1 is removed
+	assert.Equal(t, 3.0, ma.Add(4))
+	assert.Equal(t, 3, ma.Count())
+	assert.Equal(t, 3.0, ma.Avg())
+
+	ma.Reset()
+	assert.Equal(t, 0, ma.Count())
+	assert.Equal(t, 5.0, ma.Add(5))
+
+	ma = mathutil.NewMovingAverage(0)
+	ma.Add(1)
+	assert.Equal(t, 2.0, ma.Add(2))
+}
@@ -2,6 +2,8 @@ package mathutil
 
 import (
 	"fmt"
+	"math"
+	"strconv"
 	"time"
 
 	"github.com/gookit/goutil/fmtutil"
@@ -21,6 +23,34 @@ func Percent(val, total int) float64 {
 	return (float64(val) / float64(total)) * 100
 }
 
+// PercentChange returns the change percent from the value to another. eg: 50 => 75 is 50.0
+//
+// returns 0 on the from value is 0.
+func PercentChange(from, to float64) float64 {
+	if from == 0 {
+		return 0
+	}
+	return (to - from) / math.Abs(from) * 100
+}
+
+// SafeDiv divide a by b, returns 0 on the b is 0.
+func SafeDiv(a, b float64) float64 {
+	if b == 0 {
+		return 0
+	}
+	return a / b
+}
+
+// RatioString returns the simplest ratio string of a and b. eg: 10, 15 => "2:3"
+func RatioString(a, b int64) string {
+	if a == 0 || b == 0 {
+		return strconv.FormatInt(a, 10) + ":" + strconv.FormatInt(b, 10)
+	}
+
+	x := GCD(a, b)
+	return strconv.FormatInt(a/x, 10) + ":" + strconv.FormatInt(b/x, 10)
+}
+
 // ElapsedTime calc elapsed time 计算运行时间消耗 单位 ms(毫秒)
 func ElapsedTime(startTime time.Time) string {
 	return fmt.Sprintf("%.3f", time.Since(startTime).Seconds()*1000)
@@ -35,3 +65,15 @@ func DataSize(size uint64) string {
 func HowLongAgo(sec int64) string {
 	return fmtutil.HowLongAgo(sec)
 }
+
+// Times call the fn n times, the i is at [0, n)
+//
+// Usage:
+// 	mathutil.Times(3, func(i int) {
+// 		fmt.Println(i)
+// 	})
+func Times(n int, fn func(i int)) {
+	for i := 0; i < n; i++ {
+		fn(i)
+	}
+}
@@ -14,6 +14,26 @@ func TestPercent(t *testing.T) {
 	assert.Equal(t, float64(-100), mathutil.Percent(34, -34))
 }
 
+func TestPercentChange(t *testing.T) {
+	assert.Equal(t, float64(50), mathutil.PercentChange(50, 75))
+	assert.Equal(t, float64(-25), mathutil.PercentChange(100, 75))
+	assert.Equal(t, float64(200), mathutil.PercentChange(-10, 10))
+	assert.Equal(t, float64(0), mathutil.PercentChange(0, 10))
+}
+
+func TestSafeDiv(t *testing.T) {
+	assert.Equal(t, 2.5, mathutil.SafeDiv(5, 2))
+	assert.Equal(t, float64(0), mathutil.SafeDiv(5, 0))
+}
+
+func TestRatioString(t *testing.T) {
+	assert.Equal(t, "2:3", mathutil.RatioString(10, 15))
+	assert.Equal(t, "16:9", mathutil.RatioString(1920, 1080))
+	assert.Equal(t, "-1:2", mathutil.RatioString(-5, 10))
+	assert.Equal(t, "0:5", mathutil.RatioString(0, 5))
+	assert.Equal(t, "3:0", mathutil.RatioString(3, 0))
+}
+
 func TestElapsedTime(t *testing.T) {
 	nt := time.Now().Add(-time.Second * 3)
 	num := mathutil.ElapsedTime(nt)
@@ -28,3 +48,15 @@ func TestDataSize(t *testing.T) {
 func TestHowLongAgo(t *testing.T) {
 	assert.Equal(t, "57 mins", mathutil.HowLongAgo(3456))
 }
+
+func TestTimes(t *testing.T) {
+	var ns []int
+	mathutil.Times(3, func(i int) {
+		ns = append(ns, i)
+	})
+	assert.Equal(t, []int{0, 1, 2}, ns)
+
+	mathutil.Times(0, func(i int) {
+		panic("should not be called")
+	})
+}
@@ -1,10 +1,45 @@
 package mathutil
 
 import (
+	crand "crypto/rand"
+	"errors"
+	"math/big"
 	"math/rand"
+	"sync"
 	"time"
 )
 
+// lockedSource a concurrency-safe rand source
+type lockedSource struct {
+	mu  sync.Mutex
+	src rand.Source64
+}
+
+func (ls *lockedSource) Int63() int64 {
+	ls.mu.Lock()
+	n := ls.src.Int63()
+	ls.mu.Unlock()
+	return n
+}
+
+func (ls *lockedSource) Uint64() uint64 {
+	ls.mu.Lock()
+	n := ls.src.Uint64()
+	ls.mu.Unlock()
+	return n
+}
+
+func (ls *lockedSource) Seed(seed int64) {
+	ls.mu.Lock()
+	ls.src.Seed(seed)
+	ls.mu.Unlock()
+}
+
+// std random generator, concurrency-safe. not affected by rand.Seed()
+var stdRand = rand.New(&lockedSource{
+	src: rand.NewSource(time.Now().UnixNano()).(rand.Source64),
+})
+
 // RandomInt return a random int at the [min, max)
 //
 // Usage:
@@ -16,8 +51,11 @@ func RandomInt(min, max int) int {
 	return min + rand.Intn(max-min)
 }
 
-// RandInt alias of RandomInt()
-func RandInt(min, max int) int { return RandomInt(min, max) }
+// RandInt return a random int at the [min, max) by the concurrency-safe generator.
+// will return min on max <= min.
+func RandInt(min, max int) int {
+	return int(RandInt64(int64(min), int64(max)))
+}
 
 // RandIntWithSeed alias of RandomIntWithSeed()
 func RandIntWithSeed(min, max int, seed int64) int {
@@ -33,3 +71,43 @@ func RandomIntWithSeed(min, max int, seed int64) int {
 	rand.Seed(seed)
 	return min + rand.Intn(max-min)
 }
+
+// RandInt64 return a random int64 at the [min, max). will return min on max <= min.
+func RandInt64(min, max int64) int64 {
+	if max <= min {
+		return min
+	}
+	return min + stdRand.Int63n(max-min)
+}
+
+// RandFloat return a random float64 at the [min, max)
+func RandFloat(min, max float64) float64 {
+	return min + stdRand.Float64()*(max-min)
+}
+
+// CryptoRandInt return a random int at the [min, max) by crypto/rand. use for security contexts.
+//
+// Usage:
+// 	code, err := mathutil.CryptoRandInt(100000, 999999)
+func CryptoRandInt(min, max int) (int, error) {
+	if max <= min {
+		return 0, errors.New("mathutil: the max must be greater than min")
+	}
+
+	n, err := crand.Int(crand.Reader, big.NewInt(int64(max-min)))
+	if err != nil {
+		return 0, err
+	}
+	return min + int(n.Int64()), nil
+}
+
+// NewRand create a new rand.Rand by the seed, use for reproducible randomness. eg: in tests
+//
+// NOTE: the returned rand.Rand is not concurrency-safe.
+//
+// Usage:
+// 	rd := mathutil.NewRand(23)
+// 	rd.Intn(100) // always same sequence by the seed
+func NewRand(seed int64) *rand.Rand {
+	return rand.New(rand.NewSource(seed))
+}
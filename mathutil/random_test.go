@@ -22,3 +22,40 @@ func TestRandomInt(t *testing.T) {
 		assert.True(t, val >= min)
 	}
 }
+
+func TestRandInt64_RandFloat(t *testing.T) {
+	for i := 0; i < 10; i++ {
+		val := RandInt64(10, 20)
+		assert.True(t, val >= 10 && val < 20)
+
+		fv := RandFloat(1.5, 2.5)
+		assert.True(t, fv >= 1.5 && fv < 2.5)
+
+		iv := RandInt(-5, 5)
+		assert.True(t, iv >= -5 && iv < 5)
+	}
+
+	// max <= min
+	assert.Equal(t, int64(10), RandInt64(10, 10))
+	assert.Equal(t, int64(10), RandInt64(10, 5))
+	assert.Equal(t, 3, RandInt(3, 3))
+	assert.Equal(t, 3, RandInt(3, 1))
+}
+
+func TestCryptoRandInt(t *testing.T) {
+	for i := 0; i < 10; i++ {
+		val, err := CryptoRandInt(100000, 999999)
+		assert.NoError(t, err)
+		assert.True(t, val >= 100000 && val < 999999)
+	}
+
+	_, err := CryptoRandInt(10, 10)
+	assert.Error(t, err)
+}
+
+func TestNewRand(t *testing.T) {
+	r1, r2 := NewRand(23), NewRand(23)
+	for i := 0; i < 5; i++ {
+		assert.Equal(t, r1.Intn(1000), r2.Intn(1000))
+	}
+}
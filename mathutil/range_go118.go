@@ -0,0 +1,44 @@
+//go:build go1.18
+// +build go1.18
+
+package mathutil
+
+import "github.com/gookit/goutil/comdef"
+
+// Range generate a number sequence at [start, stop), step is 1.
+// returns empty slice on start >= stop.
+//
+// Usage:
+// 	mathutil.Range(0, 5) // [0 1 2 3 4]
+func Range[T comdef.XintOrFloat](start, stop T) []T {
+	return RangeStep(start, stop, 1)
+}
+
+// RangeStep generate a number sequence at [start, stop) by the step.
+// the step can be negative for descending sequence. returns empty slice on step is 0.
+//
+// Usage:
+// 	mathutil.RangeStep(0, 10, 3) // [0 3 6 9]
+// 	mathutil.RangeStep(5, 0, -2) // [5 3 1]
+// 	mathutil.RangeStep(0, 1, 0.25) // [0 0.25 0.5 0.75]
+func RangeStep[T comdef.XintOrFloat](start, stop, step T) []T {
+	list := make([]T, 0)
+	if step == 0 {
+		return list
+	}
+
+	// use start + i*step, avoid accumulate float error
+	for i := 0; ; i++ {
+		val := start + T(i)*step
+		if step > 0 && val >= stop || step < 0 && val <= stop {
+			break
+		}
+
+		// overflow the type bounds, the value will wrap around. eg: uint8(200) + 100 => 44
+		if i > 0 && (step > 0 && val <= list[i-1] || step < 0 && val >= list[i-1]) {
+			break
+		}
+		list = append(list, val)
+	}
+	return list
+}
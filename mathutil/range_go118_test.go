@@ -0,0 +1,33 @@
+//go:build go1.18
+// +build go1.18
+
+package mathutil_test
+
+import (
+	"testing"
+
+	"github.com/gookit/goutil/mathutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRange(t *testing.T) {
+	assert.Equal(t, []int{0, 1, 2, 3, 4}, mathutil.Range(0, 5))
+	assert.Equal(t, []int{-2, -1}, mathutil.Range(-2, 0))
+	assert.Empty(t, mathutil.Range(5, 5))
+	assert.Empty(t, mathutil.Range(5, 1))
+
+	assert.Equal(t, []int{0, 3, 6, 9}, mathutil.RangeStep(0, 10, 3))
+	assert.Equal(t, []int{5, 3, 1}, mathutil.RangeStep(5, 0, -2))
+	assert.Equal(t, []uint{1, 3}, mathutil.RangeStep[uint](1, 5, 2))
+	assert.Equal(t, []float64{0, 0.25, 0.5, 0.75}, mathutil.RangeStep(0, 1, 0.25))
+	assert.Len(t, mathutil.RangeStep(0, 1, 0.1), 10)
+	assert.Empty(t, mathutil.RangeStep(0, 10, 0))
+	assert.Empty(t, mathutil.RangeStep(0, 10, -1))
+
+	// near the type bounds
+	assert.Equal(t, []uint8{0, 100, 200}, mathutil.RangeStep[uint8](0, 250, 100))
+	assert.Equal(t, []int8{-128, -28, 72}, mathutil.RangeStep[int8](-128, 127, 100))
+	assert.Equal(t, []int8{127, 27, -73}, mathutil.RangeStep[int8](127, -128, -100))
+	assert.Len(t, mathutil.RangeStep[int8](-128, 127, 1), 255)
+	assert.Len(t, mathutil.RangeStep[uint8](0, 255, 1), 255)
+}
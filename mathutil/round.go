@@ -0,0 +1,105 @@
+package mathutil
+
+import (
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// RoundingMode for RoundMode()
+type RoundingMode uint8
+
+// rounding modes
+const (
+	// HalfUp round half away from zero. eg: 1.25 => 1.3, -1.25 => -1.3
+	HalfUp RoundingMode = iota
+	// HalfEven round half to even, also called banker's rounding. eg: 1.25 => 1.2, 1.35 => 1.4
+	HalfEven
+	// Floor round toward negative infinity. eg: 1.29 => 1.2, -1.21 => -1.3
+	Floor
+	// Ceil round toward positive infinity. eg: 1.21 => 1.3, -1.29 => -1.2
+	Ceil
+	// truncate toward zero
+	trunc
+)
+
+// Round the float by decimal places, rounding half away from zero.
+// it uses the shortest decimal representation of the float, so 1.005 => 1.01 as expected.
+//
+// Usage:
+// 	mathutil.Round(1.005, 2) // 1.01
+// 	mathutil.Round(-2.5, 0) // -3
+func Round(val float64, places int) float64 {
+	return RoundMode(val, places, HalfUp)
+}
+
+// TruncDecimal truncate the float to the decimal places, toward zero. eg: 1.239 => 1.23
+func TruncDecimal(val float64, places int) float64 {
+	return RoundMode(val, places, trunc)
+}
+
+// RoundMode round the float by decimal places and the rounding mode. places < 0 will be as 0.
+func RoundMode(val float64, places int, mode RoundingMode) float64 {
+	if math.IsNaN(val) || math.IsInf(val, 0) {
+		return val
+	}
+	if places < 0 {
+		places = 0
+	}
+
+	neg := val < 0
+	str := strconv.FormatFloat(math.Abs(val), 'f', -1, 64)
+
+	intPart, fracPart := str, ""
+	if pos := strings.IndexByte(str, '.'); pos >= 0 {
+		intPart, fracPart = str[:pos], str[pos+1:]
+	}
+
+	// no need to round
+	if len(fracPart) <= places {
+		return val
+	}
+
+	kept, rest := intPart+fracPart[:places], fracPart[places:]
+	restNonZero := strings.TrimRight(rest, "0") != ""
+
+	var roundUp bool // round up the absolute value
+	switch mode {
+	case HalfUp:
+		roundUp = rest[0] >= '5'
+	case HalfEven:
+		if rest[0] != '5' {
+			roundUp = rest[0] > '5'
+		} else if strings.TrimRight(rest[1:], "0") != "" {
+			roundUp = true
+		} else { // exactly half, round to even
+			roundUp = (kept[len(kept)-1]-'0')%2 == 1
+		}
+	case Floor:
+		roundUp = neg && restNonZero
+	case Ceil:
+		roundUp = !neg && restNonZero
+	}
+
+	num, _ := new(big.Int).SetString(kept, 10)
+	if roundUp {
+		num.Add(num, big.NewInt(1))
+	}
+
+	digits := num.String()
+	if places > 0 {
+		// pad leading zeros. eg: "5" with 2 places => "005" => "0.05"
+		if len(digits) <= places {
+			digits = strings.Repeat("0", places-len(digits)+1) + digits
+		}
+		digits = digits[:len(digits)-places] + "." + digits[len(digits)-places:]
+	}
+
+	ret, _ := strconv.ParseFloat(digits, 64)
+	// not return -0. eg: Round(-0.001, 2)
+	if neg && ret != 0 {
+		return -ret
+	}
+	return ret
+}
@@ -0,0 +1,61 @@
+package mathutil_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/gookit/goutil/mathutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRound(t *testing.T) {
+	tests := []struct {
+		val    float64
+		places int
+		want   float64
+	}{
+		{1.005, 2, 1.01},
+		{1.004, 2, 1},
+		{2.675, 2, 2.68},
+		{-2.5, 0, -3},
+		{2.5, 0, 3},
+		{0.045, 2, 0.05},
+		{9.995, 2, 10},
+		{123.456, -1, 123},
+		{1.2, 3, 1.2},
+		{1e21, 2, 1e21},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, mathutil.Round(tt.val, tt.places), "round %v", tt.val)
+	}
+
+	// not return -0
+	assert.False(t, math.Signbit(mathutil.Round(-0.001, 2)))
+	assert.False(t, math.Signbit(mathutil.Round(-0.4, 0)))
+	assert.False(t, math.Signbit(mathutil.RoundMode(-0.09, 1, mathutil.Ceil)))
+
+	assert.True(t, math.IsNaN(mathutil.Round(math.NaN(), 2)))
+	assert.True(t, math.IsInf(mathutil.Round(math.Inf(1), 2), 1))
+}
+
+func TestRoundMode(t *testing.T) {
+	// half even
+	assert.Equal(t, 1.2, mathutil.RoundMode(1.25, 1, mathutil.HalfEven))
+	assert.Equal(t, 1.4, mathutil.RoundMode(1.35, 1, mathutil.HalfEven))
+	assert.Equal(t, 1.3, mathutil.RoundMode(1.251, 1, mathutil.HalfEven))
+	assert.Equal(t, 2.0, mathutil.RoundMode(2.5, 0, mathutil.HalfEven))
+	assert.Equal(t, -2.0, mathutil.RoundMode(-2.5, 0, mathutil.HalfEven))
+
+	// floor and ceil
+	assert.Equal(t, 1.2, mathutil.RoundMode(1.29, 1, mathutil.Floor))
+	assert.Equal(t, -1.3, mathutil.RoundMode(-1.21, 1, mathutil.Floor))
+	assert.Equal(t, 1.3, mathutil.RoundMode(1.21, 1, mathutil.Ceil))
+	assert.Equal(t, -1.2, mathutil.RoundMode(-1.29, 1, mathutil.Ceil))
+	assert.Equal(t, 1.2, mathutil.RoundMode(1.2000, 1, mathutil.Ceil))
+
+	// trunc
+	assert.Equal(t, 1.23, mathutil.TruncDecimal(1.239, 2))
+	assert.Equal(t, -1.23, mathutil.TruncDecimal(-1.239, 2))
+	assert.Equal(t, 0.0, mathutil.TruncDecimal(0.009, 2))
+}
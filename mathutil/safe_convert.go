@@ -0,0 +1,46 @@
+package mathutil
+
+import (
+	"fmt"
+	"math"
+)
+
+// SafeInt64ToInt32 convert int64 to int32, will return error on overflow.
+func SafeInt64ToInt32(val int64) (int32, error) {
+	if val < math.MinInt32 || val > math.MaxInt32 {
+		return 0, fmt.Errorf("%w: %d to int32", ErrOverflow, val)
+	}
+	return int32(val), nil
+}
+
+// SafeInt64ToInt convert int64 to int, will return error on overflow(on 32-bit platform).
+func SafeInt64ToInt(val int64) (int, error) {
+	if int64(int(val)) != val {
+		return 0, fmt.Errorf("%w: %d to int", ErrOverflow, val)
+	}
+	return int(val), nil
+}
+
+// SafeUintToInt convert uint to int, will return error on overflow.
+func SafeUintToInt(val uint) (int, error) {
+	if int(val) < 0 {
+		return 0, fmt.Errorf("%w: %d to int", ErrOverflow, val)
+	}
+	return int(val), nil
+}
+
+// SafeUint64ToInt64 convert uint64 to int64, will return error on overflow.
+func SafeUint64ToInt64(val uint64) (int64, error) {
+	if val > math.MaxInt64 {
+		return 0, fmt.Errorf("%w: %d to int64", ErrOverflow, val)
+	}
+	return int64(val), nil
+}
+
+// SafeIntToUint convert int to uint, will return error on the value is negative.
+func SafeIntToUint(val int) (uint, error) {
+	if val < 0 {
+		return 0, fmt.Errorf("%w: negative %d to uint", ErrOverflow, val)
+	}
+	return uint(val), nil
+}
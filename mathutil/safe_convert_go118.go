@@ -0,0 +1,27 @@
+//go:build go1.18
+// +build go1.18
+
+package mathutil
+
+import (
+	"fmt"
+
+	"github.com/gookit/goutil/comdef"
+)
+
+// SafeConvert convert an integer to another integer type, will return error on
+// overflow, underflow or convert negative value to unsigned type.
+//
+// Usage:
+// 	i8, err := mathutil.SafeConvert[int, int8](300) // error
+// 	u, err := mathutil.SafeConvert[int, uint](-1) // error
+func SafeConvert[T, R comdef.Xint](val T) (R, error) {
+	ret := R(val)
+
+	// check sign changed and truncated
+	if (val < 0) != (ret < 0) || T(ret) != val {
+		var zero R
+		return zero, fmt.Errorf("%w: %v to %T", ErrOverflow, val, zero)
+	}
+	return ret, nil
+}
@@ -0,0 +1,43 @@
+//go:build go1.18
+// +build go1.18
+
+package mathutil_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/gookit/goutil/mathutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSafeConvert(t *testing.T) {
+	i8, err := mathutil.SafeConvert[int, int8](127)
+	assert.NoError(t, err)
+	assert.Equal(t, int8(127), i8)
+
+	i8, err = mathutil.SafeConvert[int, int8](-128)
+	assert.NoError(t, err)
+	assert.Equal(t, int8(-128), i8)
+
+	_, err = mathutil.SafeConvert[int, int8](300)
+	assert.ErrorIs(t, err, mathutil.ErrOverflow)
+	_, err = mathutil.SafeConvert[int, int8](-129)
+	assert.Error(t, err)
+
+	// negative to unsigned
+	_, err = mathutil.SafeConvert[int, uint](-1)
+	assert.ErrorIs(t, err, mathutil.ErrOverflow)
+	_, err = mathutil.SafeConvert[int8, uint64](-1)
+	assert.Error(t, err)
+
+	// unsigned to signed
+	_, err = mathutil.SafeConvert[uint64, int64](math.MaxUint64)
+	assert.Error(t, err)
+	_, err = mathutil.SafeConvert[uint8, int8](200)
+	assert.Error(t, err)
+
+	u16, err := mathutil.SafeConvert[int64, uint16](65535)
+	assert.NoError(t, err)
+	assert.Equal(t, uint16(65535), u16)
+}
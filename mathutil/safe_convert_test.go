@@ -0,0 +1,49 @@
+package mathutil_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/gookit/goutil/mathutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSafeInt64ToInt32(t *testing.T) {
+	i32, err := mathutil.SafeInt64ToInt32(23)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(23), i32)
+
+	i32, err = mathutil.SafeInt64ToInt32(math.MinInt32)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(math.MinInt32), i32)
+
+	_, err = mathutil.SafeInt64ToInt32(math.MaxInt32 + 1)
+	assert.ErrorIs(t, err, mathutil.ErrOverflow)
+	_, err = mathutil.SafeInt64ToInt32(math.MinInt32 - 1)
+	assert.Error(t, err)
+
+	iv, err := mathutil.SafeInt64ToInt(-23)
+	assert.NoError(t, err)
+	assert.Equal(t, -23, iv)
+}
+
+func TestSafeUintToInt(t *testing.T) {
+	iv, err := mathutil.SafeUintToInt(23)
+	assert.NoError(t, err)
+	assert.Equal(t, 23, iv)
+
+	_, err = mathutil.SafeUintToInt(^uint(0))
+	assert.ErrorIs(t, err, mathutil.ErrOverflow)
+
+	i64, err := mathutil.SafeUint64ToInt64(math.MaxInt64)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(math.MaxInt64), i64)
+	_, err = mathutil.SafeUint64ToInt64(math.MaxInt64 + 1)
+	assert.Error(t, err)
+
+	u, err := mathutil.SafeIntToUint(23)
+	assert.NoError(t, err)
+	assert.Equal(t, uint(23), u)
+	_, err = mathutil.SafeIntToUint(-1)
+	assert.ErrorIs(t, err, mathutil.ErrOverflow)
+}
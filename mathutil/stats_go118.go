@@ -0,0 +1,114 @@
+//go:build go1.18
+// +build go1.18
+
+package mathutil
+
+import (
+	"math"
+	"sort"
+
+	"github.com/gookit/goutil/comdef"
+)
+
+// Mean get the arithmetic mean of numbers. alias of Avg()
+func Mean[T comdef.XintOrFloat](list []T) float64 {
+	return Avg(list...)
+}
+
+// Median get the median value of numbers. returns 0 on the list is empty.
+func Median[T comdef.XintOrFloat](list []T) float64 {
+	n := len(list)
+	if n == 0 {
+		return 0
+	}
+
+	sorted := sortedCopy(list)
+	if n%2 == 1 {
+		return float64(sorted[n/2])
+	}
+	return (float64(sorted[n/2-1]) + float64(sorted[n/2])) / 2
+}
+
+// Mode get the most frequent value of numbers, the smallest one will be returned on multi modes.
+// returns zero value on the list is empty.
+func Mode[T comdef.XintOrFloat](list []T) T {
+	var mode T
+	var maxCount int
+
+	counts := make(map[T]int, len(list))
+	for _, val := range list {
+		counts[val]++
+		n := counts[val]
+		if n > maxCount || n == maxCount && val < mode {
+			mode, maxCount = val, n
+		}
+	}
+	return mode
+}
+
+// Variance get the population variance of numbers. returns 0 on the list is empty.
+func Variance[T comdef.XintOrFloat](list []T) float64 {
+	if len(list) == 0 {
+		return 0
+	}
+
+	mean := Avg(list...)
+	var sum float64
+	for _, val := range list {
+		diff := float64(val) - mean
+		sum += diff * diff
+	}
+	return sum / float64(len(list))
+}
+
+// StdDev get the population standard deviation of numbers.
+func StdDev[T comdef.XintOrFloat](list []T) float64 {
+	return math.Sqrt(Variance(list))
+}
+
+// Percentile get the p-th percentile of numbers by linear interpolation. p is at [0, 100].
+// returns 0 on the list is empty.
+//
+// Usage:
+// 	p99 := mathutil.Percentile(latencies, 99)
+func Percentile[T comdef.XintOrFloat](list []T, p float64) float64 {
+	n := len(list)
+	if n == 0 {
+		return 0
+	}
+
+	sorted := sortedCopy(list)
+	p = Clamp(p, 0, 100)
+
+	rank := p / 100 * float64(n-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+	if lower == upper {
+		return float64(sorted[lower])
+	}
+
+	weight := rank - float64(lower)
+	return float64(sorted[lower])*(1-weight) + float64(sorted[upper])*weight
+}
+
+// MinMax get the min and max value of numbers. returns zero values on the list is empty.
+func MinMax[T comdef.XintOrFloat](list []T) (min, max T) {
+	for i, val := range list {
+		if i == 0 || val < min {
+			min = val
+		}
+		if i == 0 || val > max {
+			max = val
+		}
+	}
+	return
+}
+
+func sortedCopy[T comdef.XintOrFloat](list []T) []T {
+	sorted := make([]T, len(list))
+	copy(sorted, list)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i] < sorted[j]
+	})
+	return sorted
+}
@@ -0,0 +1,53 @@
+//go:build go1.18
+// +build go1.18
+
+package mathutil_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/gookit/goutil/mathutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStats(t *testing.T) {
+	list := []int{2, 4, 4, 4, 5, 5, 7, 9}
+
+	assert.Equal(t, 5.0, mathutil.Mean(list))
+	assert.Equal(t, 4.5, mathutil.Median(list))
+	assert.Equal(t, 4.0, mathutil.Median([]int{9, 1, 4}))
+	assert.Equal(t, 4, mathutil.Mode(list))
+	assert.Equal(t, 1, mathutil.Mode([]int{3, 1, 3, 1}))
+	assert.Equal(t, 4.0, mathutil.Variance(list))
+	assert.Equal(t, 2.0, mathutil.StdDev(list))
+
+	min, max := mathutil.MinMax(list)
+	assert.Equal(t, 2, min)
+	assert.Equal(t, 9, max)
+
+	// input should not be modified
+	unsorted := []float64{3, 1, 2}
+	assert.Equal(t, 2.0, mathutil.Median(unsorted))
+	assert.Equal(t, []float64{3, 1, 2}, unsorted)
+
+	// empty
+	assert.Equal(t, 0.0, mathutil.Mean([]int{}))
+	assert.Equal(t, 0.0, mathutil.Median([]int{}))
+	assert.Equal(t, 0, mathutil.Mode([]int{}))
+	assert.Equal(t, 0.0, mathutil.StdDev([]int{}))
+	min, max = mathutil.MinMax([]int{})
+	assert.Equal(t, 0, min+max)
+}
+
+func TestPercentile(t *testing.T) {
+	list := []int{15, 20, 35, 40, 50}
+
+	assert.Equal(t, 15.0, mathutil.Percentile(list, 0))
+	assert.Equal(t, 35.0, mathutil.Percentile(list, 50))
+	assert.Equal(t, 50.0, mathutil.Percentile(list, 100))
+	assert.Equal(t, 50.0, mathutil.Percentile(list, 120))
+	assert.Equal(t, 27.5, mathutil.Percentile(list, 37.5))
+	assert.True(t, math.Abs(mathutil.Percentile(list, 90)-46) < 1e-9)
+	assert.Equal(t, 0.0, mathutil.Percentile([]int{}, 50))
+}
@@ -0,0 +1,156 @@
+package netutil
+
+import (
+	"errors"
+	"math/big"
+	"net"
+)
+
+// private networks, see RFC 1918 and RFC 4193
+var privateNets = mustParseCIDRs("10.0.0.0/8", "172.16.0.0/12", "192.168.0.0/16", "fc00::/7")
+
+func mustParseCIDRs(cidrs ...string) []*net.IPNet {
+	nets := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		nets = append(nets, ipNet)
+	}
+	return nets
+}
+
+// IPInCIDR check the ip is in the CIDR network. returns false on the ip or cidr is invalid.
+//
+// Usage:
+// 	netutil.IPInCIDR("192.168.1.10", "192.168.1.0/24") // true
+func IPInCIDR(ip, cidr string) bool {
+	addr := net.ParseIP(ip)
+	_, ipNet, err := net.ParseCIDR(cidr)
+	if addr == nil || err != nil {
+		return false
+	}
+	return ipNet.Contains(addr)
+}
+
+// IsPrivateIP check the ip is a private address, by RFC 1918(IPv4) and RFC 4193(IPv6).
+// returns false on the ip is invalid.
+func IsPrivateIP(ip string) bool {
+	addr := net.ParseIP(ip)
+	if addr == nil {
+		return false
+	}
+
+	for _, ipNet := range privateNets {
+		if ipNet.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// CIDROverlaps check the two CIDR networks are overlapped. returns false on the cidr is invalid.
+//
+// Usage:
+// 	netutil.CIDROverlaps("10.0.0.0/8", "10.1.0.0/16") // true
+func CIDROverlaps(a, b string) bool {
+	_, netA, err := net.ParseCIDR(a)
+	if err != nil {
+		return false
+	}
+
+	_, netB, err := net.ParseCIDR(b)
+	if err != nil {
+		return false
+	}
+	return netA.Contains(netB.IP) || netB.Contains(netA.IP)
+}
+
+// NextIP get the next IP of the ip. returns nil on overflow.
+func NextIP(ip net.IP) net.IP {
+	return addToIP(ip, 1)
+}
+
+// PrevIP get the previous IP of the ip. returns nil on overflow.
+func PrevIP(ip net.IP) net.IP {
+	return addToIP(ip, -1)
+}
+
+// normalizeIP returns 4 bytes for IPv4, 16 bytes for IPv6
+func normalizeIP(ip net.IP) net.IP {
+	if ip4 := ip.To4(); ip4 != nil {
+		return ip4
+	}
+	return ip.To16()
+}
+
+func addToIP(ip net.IP, delta int64) net.IP {
+	ip = normalizeIP(ip)
+	if ip == nil {
+		return nil
+	}
+
+	n := new(big.Int).SetBytes(ip)
+	n.Add(n, big.NewInt(delta))
+	if n.Sign() < 0 || n.BitLen() > len(ip)*8 {
+		return nil
+	}
+	return bigToIP(n, len(ip))
+}
+
+func bigToIP(n *big.Int, size int) net.IP {
+	bs := n.Bytes()
+	ip := make(net.IP, size)
+	copy(ip[size-len(bs):], bs)
+	return ip
+}
+
+// RangeToCIDRs convert the IP range [start, end] to the minimal CIDR list.
+//
+// Usage:
+// 	cidrs, err := netutil.RangeToCIDRs("192.168.1.0", "192.168.1.130")
+// 	// cidrs: ["192.168.1.0/25", "192.168.1.128/31", "192.168.1.130/32"]
+func RangeToCIDRs(start, end string) ([]string, error) {
+	startIP, endIP := normalizeIP(net.ParseIP(start)), normalizeIP(net.ParseIP(end))
+	if startIP == nil || endIP == nil {
+		return nil, errors.New("netutil: invalid start or end IP")
+	}
+
+	if len(startIP) != len(endIP) {
+		return nil, errors.New("netutil: the start and end IP must be same version")
+	}
+
+	bits := len(startIP) * 8
+	cur := new(big.Int).SetBytes(startIP)
+	last := new(big.Int).SetBytes(endIP)
+	if cur.Cmp(last) > 0 {
+		return nil, errors.New("netutil: the start IP must be less than or equal to the end IP")
+	}
+
+	var cidrs []string
+	one := big.NewInt(1)
+	for cur.Cmp(last) <= 0 {
+		// the max block size by the alignment of the cur
+		hostBits := bits
+		if cur.Sign() != 0 {
+			hostBits = int(cur.TrailingZeroBits())
+		}
+
+		// shrink the block until it is in the range
+		for hostBits > 0 {
+			blockEnd := new(big.Int).Lsh(one, uint(hostBits))
+			blockEnd.Add(blockEnd, cur).Sub(blockEnd, one)
+			if blockEnd.Cmp(last) <= 0 {
+				break
+			}
+			hostBits--
+		}
+
+		ipNet := &net.IPNet{IP: bigToIP(cur, len(startIP)), Mask: net.CIDRMask(bits-hostBits, bits)}
+		cidrs = append(cidrs, ipNet.String())
+
+		cur.Add(cur, new(big.Int).Lsh(one, uint(hostBits)))
+	}
+	return cidrs, nil
+}
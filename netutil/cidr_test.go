@@ -0,0 +1,72 @@
+package netutil_test
+
+import (
+	"net"
+	"testing"
+
+	"github.com/gookit/goutil/netutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIPInCIDR(t *testing.T) {
+	assert.True(t, netutil.IPInCIDR("192.168.1.10", "192.168.1.0/24"))
+	assert.False(t, netutil.IPInCIDR("192.168.2.10", "192.168.1.0/24"))
+	assert.True(t, netutil.IPInCIDR("2001:db8::1", "2001:db8::/32"))
+	assert.False(t, netutil.IPInCIDR("invalid", "192.168.1.0/24"))
+	assert.False(t, netutil.IPInCIDR("192.168.1.10", "192.168.1.0"))
+}
+
+func TestIsPrivateIP(t *testing.T) {
+	for _, ip := range []string{"10.1.2.3", "172.16.0.1", "172.31.255.255", "192.168.0.1", "fd00::1"} {
+		assert.True(t, netutil.IsPrivateIP(ip), ip)
+	}
+
+	for _, ip := range []string{"8.8.8.8", "172.32.0.1", "127.0.0.1", "2001:db8::1", "invalid"} {
+		assert.False(t, netutil.IsPrivateIP(ip), ip)
+	}
+}
+
+func TestCIDROverlaps(t *testing.T) {
+	assert.True(t, netutil.CIDROverlaps("10.0.0.0/8", "10.1.0.0/16"))
+	assert.True(t, netutil.CIDROverlaps("10.1.0.0/16", "10.0.0.0/8"))
+	assert.True(t, netutil.CIDROverlaps("10.1.0.0/16", "10.1.0.0/16"))
+	assert.False(t, netutil.CIDROverlaps("10.1.0.0/16", "10.2.0.0/16"))
+	assert.False(t, netutil.CIDROverlaps("10.1.0.0/16", "invalid"))
+	assert.False(t, netutil.CIDROverlaps("invalid", "10.1.0.0/16"))
+}
+
+func TestNextIP_PrevIP(t *testing.T) {
+	assert.Equal(t, "192.168.1.1", netutil.NextIP(net.ParseIP("192.168.1.0")).String())
+	assert.Equal(t, "192.168.2.0", netutil.NextIP(net.ParseIP("192.168.1.255")).String())
+	assert.Equal(t, "192.168.0.255", netutil.PrevIP(net.ParseIP("192.168.1.0")).String())
+	assert.Equal(t, "2001:db8::1:0", netutil.NextIP(net.ParseIP("2001:db8::ffff")).String())
+
+	assert.Nil(t, netutil.NextIP(net.ParseIP("255.255.255.255")))
+	assert.Nil(t, netutil.PrevIP(net.ParseIP("0.0.0.0")))
+	assert.Nil(t, netutil.NextIP(nil))
+}
+
+func TestRangeToCIDRs(t *testing.T) {
+	cidrs, err := netutil.RangeToCIDRs("192.168.1.0", "192.168.1.130")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"192.168.1.0/25", "192.168.1.128/31", "192.168.1.130/32"}, cidrs)
+
+	cidrs, err = netutil.RangeToCIDRs("10.0.0.1", "10.0.0.6")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"10.0.0.1/32", "10.0.0.2/31", "10.0.0.4/31", "10.0.0.6/32"}, cidrs)
+
+	cidrs, err = netutil.RangeToCIDRs("0.0.0.0", "255.255.255.255")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"0.0.0.0/0"}, cidrs)
+
+	cidrs, err = netutil.RangeToCIDRs("2001:db8::", "2001:db8::ff")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"2001:db8::/120"}, cidrs)
+
+	_, err = netutil.RangeToCIDRs("10.0.0.6", "10.0.0.1")
+	assert.Error(t, err)
+	_, err = netutil.RangeToCIDRs("10.0.0.1", "2001:db8::")
+	assert.Error(t, err)
+	_, err = netutil.RangeToCIDRs("invalid", "10.0.0.1")
+	assert.Error(t, err)
+}
@@ -0,0 +1,313 @@
+package netutil
+
+import (
+	"context"
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gookit/goutil/netutil/httpreq"
+)
+
+// DownloadOptions for Download
+type DownloadOptions struct {
+	// Client for send request. default is http.DefaultClient
+	Client httpreq.Doer
+	// Header custom request headers
+	Header http.Header
+	// Progress callback on data written. total is -1 on the size is unknown.
+	Progress func(written, total int64)
+	// Checksum the expected hex checksum of the file. empty for skip verify.
+	Checksum string
+	// ChecksumAlgo the checksum algorithm, allow: md5, sha1, sha256, sha512. default is sha256
+	ChecksumAlgo string
+	// Resume continue download from the exists part file("DEST.part") by Range request.
+	//
+	// the ETag or Last-Modified of the resource will be saved to "DEST.part.meta",
+	// and send by If-Range on resume, will restart download on the resource changed.
+	Resume bool
+	// Retries the max retry times on the download failed. default is 0, no retry.
+	Retries int
+	// RetryWait the wait time before retry. default is 1s
+	RetryWait time.Duration
+}
+
+// downloadStatusError the response status error
+type downloadStatusError struct {
+	code int
+}
+
+func (e *downloadStatusError) Error() string {
+	return "netutil: download failed, response status " + strconv.Itoa(e.code)
+}
+
+// Download the url to the dest file. the data will be written to the "DEST.part" file,
+// and rename to the dest file on download completed and the checksum verified.
+//
+// Usage:
+// 	err := netutil.Download(ctx, "https://example.com/app.tar.gz", "/tmp/app.tar.gz", func(opt *netutil.DownloadOptions) {
+// 		opt.Resume = true
+// 		opt.Retries = 3
+// 		opt.Checksum = "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"
+// 		opt.Progress = func(written, total int64) {
+// 			fmt.Printf("\rdownloading %d/%d", written, total)
+// 		}
+// 	})
+func Download(ctx context.Context, url, dest string, fns ...func(opt *DownloadOptions)) error {
+	opt := &DownloadOptions{
+		Client:       http.DefaultClient,
+		ChecksumAlgo: "sha256",
+		RetryWait:    time.Second,
+	}
+	for _, fn := range fns {
+		fn(opt)
+	}
+
+	newHash, err := checksumHash(opt.ChecksumAlgo)
+	if err != nil {
+		return err
+	}
+
+	partFile := dest + ".part"
+	for i := 0; ; i++ {
+		err = downloadOnce(ctx, url, partFile, opt, i > 0)
+		if err == nil {
+			err = verifyChecksum(partFile, opt.Checksum, newHash)
+			if err == nil {
+				_ = os.Remove(partFile + ".meta")
+				return os.Rename(partFile, dest)
+			}
+			// the file is broken, remove it for restart
+			_ = removePartFile(partFile)
+		}
+
+		if i >= opt.Retries || ctx.Err() != nil || !downloadRetryable(err) {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(opt.RetryWait):
+		}
+	}
+}
+
+func downloadOnce(ctx context.Context, url, partFile string, opt *DownloadOptions, isRetry bool) error {
+	var offset int64
+	if opt.Resume || isRetry {
+		if fi, err := os.Stat(partFile); err == nil {
+			offset = fi.Size()
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+
+	for key, vals := range opt.Header {
+		req.Header[key] = vals
+	}
+	if offset > 0 {
+		req.Header.Set("Range", "bytes="+strconv.FormatInt(offset, 10)+"-")
+		if bs, err := ioutil.ReadFile(partFile + ".meta"); err == nil && len(bs) > 0 {
+			req.Header.Set("If-Range", string(bs))
+		}
+	}
+
+	resp, err := opt.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	total := int64(-1)
+	flag := os.O_CREATE | os.O_WRONLY
+	switch resp.StatusCode {
+	case http.StatusPartialContent:
+		crv := resp.Header.Get("Content-Range")
+		// the range is not match the part file, restart download for avoid to break the file.
+		if contentRangeStart(crv) != offset {
+			if offset == 0 {
+				return &downloadStatusError{code: resp.StatusCode}
+			}
+			return restartDownload(ctx, url, partFile, opt, resp)
+		}
+
+		flag |= os.O_APPEND
+		total = contentRangeTotal(crv)
+	case http.StatusOK: // not support range or the resource changed, restart from 0
+		offset = 0
+		flag |= os.O_TRUNC
+		total = resp.ContentLength
+		if err = saveRangeValidator(partFile+".meta", resp.Header); err != nil {
+			return err
+		}
+	case http.StatusRequestedRangeNotSatisfiable:
+		if offset == 0 {
+			return &downloadStatusError{code: resp.StatusCode}
+		}
+
+		// the part file is completed. eg: "bytes */200"
+		if contentRangeTotal(resp.Header.Get("Content-Range")) == offset {
+			return nil
+		}
+
+		// the part file is invalid, remove it and restart download
+		return restartDownload(ctx, url, partFile, opt, resp)
+	default:
+		return &downloadStatusError{code: resp.StatusCode}
+	}
+
+	f, err := os.OpenFile(partFile, flag, 0644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var w io.Writer = f
+	if opt.Progress != nil {
+		w = &progressWriter{w: f, written: offset, total: total, fn: opt.Progress}
+		opt.Progress(offset, total)
+	}
+
+	_, err = io.Copy(w, resp.Body)
+	return err
+}
+
+// restartDownload remove the part file and download from 0
+func restartDownload(ctx context.Context, url, partFile string, opt *DownloadOptions, resp *http.Response) error {
+	_ = resp.Body.Close()
+	if err := removePartFile(partFile); err != nil {
+		return err
+	}
+	return downloadOnce(ctx, url, partFile, opt, false)
+}
+
+// saveRangeValidator save the ETag or Last-Modified of the resource for If-Range.
+// the weak ETag is not allowed for If-Range.
+func saveRangeValidator(metaFile string, h http.Header) error {
+	val := h.Get("ETag")
+	if val == "" || strings.HasPrefix(val, "W/") {
+		val = h.Get("Last-Modified")
+	}
+
+	if val == "" {
+		if err := os.Remove(metaFile); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+	return ioutil.WriteFile(metaFile, []byte(val), 0644)
+}
+
+// removePartFile remove the part file and the meta file
+func removePartFile(partFile string) error {
+	_ = os.Remove(partFile + ".meta")
+	return os.Remove(partFile)
+}
+
+// downloadRetryable check the download error can be retried.
+func downloadRetryable(err error) bool {
+	var se *downloadStatusError
+	if errors.As(err, &se) {
+		return se.code == http.StatusTooManyRequests || se.code >= 500
+	}
+
+	// not retry for the file system error
+	var pe *os.PathError
+	return !errors.As(err, &pe)
+}
+
+// contentRangeStart parse the start position from Content-Range. eg: "bytes 100-199/200"
+func contentRangeStart(s string) int64 {
+	s = strings.TrimPrefix(s, "bytes ")
+	pos := strings.IndexByte(s, '-')
+	if pos < 0 {
+		return -1
+	}
+
+	start, err := strconv.ParseInt(s[:pos], 10, 64)
+	if err != nil {
+		return -1
+	}
+	return start
+}
+
+// contentRangeTotal parse the total size from Content-Range. eg: "bytes 100-199/200"
+func contentRangeTotal(s string) int64 {
+	pos := strings.LastIndexByte(s, '/')
+	if pos < 0 {
+		return -1
+	}
+
+	total, err := strconv.ParseInt(s[pos+1:], 10, 64)
+	if err != nil {
+		return -1
+	}
+	return total
+}
+
+func checksumHash(algo string) (func() hash.Hash, error) {
+	switch strings.ToLower(algo) {
+	case "md5":
+		return md5.New, nil
+	case "sha1":
+		return sha1.New, nil
+	case "", "sha256":
+		return sha256.New, nil
+	case "sha512":
+		return sha512.New, nil
+	}
+	return nil, errors.New("netutil: unsupported checksum algorithm " + algo)
+}
+
+func verifyChecksum(file, checksum string, newHash func() hash.Hash) error {
+	if checksum == "" {
+		return nil
+	}
+
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	h := newHash()
+	if _, err = io.Copy(h, f); err != nil {
+		return err
+	}
+
+	if got := hex.EncodeToString(h.Sum(nil)); !strings.EqualFold(got, checksum) {
+		return fmt.Errorf("netutil: checksum mismatch, want %s, got %s", checksum, got)
+	}
+	return nil
+}
+
+// progressWriter call the progress func on write data
+type progressWriter struct {
+	w       io.Writer
+	written int64
+	total   int64
+	fn      func(written, total int64)
+}
+
+func (pw *progressWriter) Write(p []byte) (int, error) {
+	n, err := pw.w.Write(p)
+	pw.written += int64(n)
+	pw.fn(pw.written, pw.total)
+	return n, err
+}
@@ -0,0 +1,272 @@
+package netutil_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gookit/goutil/netutil"
+	"github.com/stretchr/testify/assert"
+)
+
+var dlContent = []byte(strings.Repeat("0123456789abcdef", 1024))
+
+func newDownloadServer(fails int32, ranges *[]string) *httptest.Server {
+	var calls int32
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) <= fails {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		if ranges != nil {
+			*ranges = append(*ranges, r.Header.Get("Range"))
+		}
+		http.ServeContent(w, r, "file.bin", time.Time{}, bytes.NewReader(dlContent))
+	}))
+}
+
+func sha256Hex(bs []byte) string {
+	sum := sha256.Sum256(bs)
+	return hex.EncodeToString(sum[:])
+}
+
+func TestDownload(t *testing.T) {
+	dir, err := ioutil.TempDir("", "netutil-dl")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	srv := newDownloadServer(0, nil)
+	defer srv.Close()
+
+	var lastWritten, lastTotal int64
+	dest := filepath.Join(dir, "file.bin")
+	err = netutil.Download(context.Background(), srv.URL, dest, func(opt *netutil.DownloadOptions) {
+		opt.Checksum = sha256Hex(dlContent)
+		opt.Progress = func(written, total int64) {
+			lastWritten, lastTotal = written, total
+		}
+	})
+	assert.NoError(t, err)
+
+	bs, err := ioutil.ReadFile(dest)
+	assert.NoError(t, err)
+	assert.Equal(t, dlContent, bs)
+	assert.Equal(t, int64(len(dlContent)), lastWritten)
+	assert.Equal(t, int64(len(dlContent)), lastTotal)
+	assert.NoFileExists(t, dest+".part")
+}
+
+func TestDownload_resume(t *testing.T) {
+	dir, err := ioutil.TempDir("", "netutil-dl")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	var ranges []string
+	srv := newDownloadServer(0, &ranges)
+	defer srv.Close()
+
+	dest := filepath.Join(dir, "file.bin")
+	assert.NoError(t, ioutil.WriteFile(dest+".part", dlContent[:1000], 0644))
+
+	var firstWritten int64 = -1
+	err = netutil.Download(context.Background(), srv.URL, dest, func(opt *netutil.DownloadOptions) {
+		opt.Resume = true
+		opt.Checksum = sha256Hex(dlContent)
+		opt.Progress = func(written, total int64) {
+			if firstWritten < 0 {
+				firstWritten = written
+			}
+		}
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"bytes=1000-"}, ranges)
+	assert.Equal(t, int64(1000), firstWritten)
+
+	bs, err := ioutil.ReadFile(dest)
+	assert.NoError(t, err)
+	assert.Equal(t, dlContent, bs)
+}
+
+func TestDownload_retry(t *testing.T) {
+	dir, err := ioutil.TempDir("", "netutil-dl")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	srv := newDownloadServer(2, nil)
+	defer srv.Close()
+
+	dest := filepath.Join(dir, "file.bin")
+	err = netutil.Download(context.Background(), srv.URL, dest, func(opt *netutil.DownloadOptions) {
+		opt.Retries = 1
+		opt.RetryWait = time.Millisecond
+	})
+	assert.EqualError(t, err, "netutil: download failed, response status 503")
+
+	err = netutil.Download(context.Background(), srv.URL, dest, func(opt *netutil.DownloadOptions) {
+		opt.Retries = 1
+		opt.RetryWait = time.Millisecond
+	})
+	assert.NoError(t, err)
+	assert.FileExists(t, dest)
+}
+
+func TestDownload_errors(t *testing.T) {
+	dir, err := ioutil.TempDir("", "netutil-dl")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	srv := newDownloadServer(0, nil)
+	defer srv.Close()
+
+	dest := filepath.Join(dir, "file.bin")
+	err = netutil.Download(context.Background(), srv.URL, dest, func(opt *netutil.DownloadOptions) {
+		opt.Checksum = "abcd"
+	})
+	assert.ErrorContains(t, err, "netutil: checksum mismatch, want abcd, got ")
+	assert.NoFileExists(t, dest)
+	assert.NoFileExists(t, dest+".part")
+
+	err = netutil.Download(context.Background(), srv.URL, dest, func(opt *netutil.DownloadOptions) {
+		opt.ChecksumAlgo = "crc32"
+	})
+	assert.EqualError(t, err, "netutil: unsupported checksum algorithm crc32")
+
+	notFound := httptest.NewServer(http.NotFoundHandler())
+	defer notFound.Close()
+	err = netutil.Download(context.Background(), notFound.URL, dest, func(opt *netutil.DownloadOptions) {
+		opt.Retries = 3
+	})
+	assert.EqualError(t, err, "netutil: download failed, response status 404")
+}
+
+func TestDownload_rangeNotSatisfiable(t *testing.T) {
+	dir, err := ioutil.TempDir("", "netutil-dl")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	var ranges []string
+	srv := newDownloadServer(0, &ranges)
+	defer srv.Close()
+
+	// the part file is completed
+	dest := filepath.Join(dir, "file.bin")
+	assert.NoError(t, ioutil.WriteFile(dest+".part", dlContent, 0644))
+	err = netutil.Download(context.Background(), srv.URL, dest, func(opt *netutil.DownloadOptions) {
+		opt.Resume = true
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"bytes=16384-"}, ranges)
+
+	// the part file is larger than the remote file, will restart download
+	ranges = nil
+	assert.NoError(t, ioutil.WriteFile(dest+".part", append(dlContent, "more"...), 0644))
+	err = netutil.Download(context.Background(), srv.URL, dest, func(opt *netutil.DownloadOptions) {
+		opt.Resume = true
+		opt.Checksum = sha256Hex(dlContent)
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"bytes=16388-", ""}, ranges)
+
+	bs, err := ioutil.ReadFile(dest)
+	assert.NoError(t, err)
+	assert.Equal(t, dlContent, bs)
+}
+
+func TestDownload_ifRange(t *testing.T) {
+	dir, err := ioutil.TempDir("", "netutil-dl")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	var etag atomic.Value
+	etag.Store(`"v1"`)
+	var ifRanges []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ifRanges = append(ifRanges, r.Header.Get("If-Range"))
+		w.Header().Set("ETag", etag.Load().(string))
+
+		// interrupt the first download
+		if len(ifRanges) == 1 {
+			w.Header().Set("Content-Length", strconv.Itoa(len(dlContent)))
+			_, _ = w.Write(dlContent[:1000])
+			return
+		}
+		http.ServeContent(w, r, "file.bin", time.Time{}, bytes.NewReader(dlContent))
+	}))
+	defer srv.Close()
+
+	dest := filepath.Join(dir, "file.bin")
+	err = netutil.Download(context.Background(), srv.URL, dest)
+	assert.Error(t, err)
+	assert.FileExists(t, dest+".part")
+
+	bs, err := ioutil.ReadFile(dest + ".part.meta")
+	assert.NoError(t, err)
+	assert.Equal(t, `"v1"`, string(bs))
+
+	// the resource changed, will restart from 0
+	etag.Store(`"v2"`)
+	var firstWritten int64 = -1
+	err = netutil.Download(context.Background(), srv.URL, dest, func(opt *netutil.DownloadOptions) {
+		opt.Resume = true
+		opt.Checksum = sha256Hex(dlContent)
+		opt.Progress = func(written, total int64) {
+			if firstWritten < 0 {
+				firstWritten = written
+			}
+		}
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"", `"v1"`}, ifRanges)
+	assert.Equal(t, int64(0), firstWritten)
+	assert.NoFileExists(t, dest+".part.meta")
+
+	bs, err = ioutil.ReadFile(dest)
+	assert.NoError(t, err)
+	assert.Equal(t, dlContent, bs)
+}
+
+func TestDownload_rangeMismatch(t *testing.T) {
+	dir, err := ioutil.TempDir("", "netutil-dl")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	var ranges []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ranges = append(ranges, r.Header.Get("Range"))
+		if r.Header.Get("Range") != "" {
+			// always response the range from 500
+			w.Header().Set("Content-Range", "bytes 500-"+strconv.Itoa(len(dlContent)-1)+"/"+strconv.Itoa(len(dlContent)))
+			w.WriteHeader(http.StatusPartialContent)
+			_, _ = w.Write(dlContent[500:])
+			return
+		}
+		_, _ = w.Write(dlContent)
+	}))
+	defer srv.Close()
+
+	dest := filepath.Join(dir, "file.bin")
+	assert.NoError(t, ioutil.WriteFile(dest+".part", dlContent[:1000], 0644))
+
+	err = netutil.Download(context.Background(), srv.URL, dest, func(opt *netutil.DownloadOptions) {
+		opt.Resume = true
+		opt.Checksum = sha256Hex(dlContent)
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"bytes=1000-", ""}, ranges)
+
+	bs, err := ioutil.ReadFile(dest)
+	assert.NoError(t, err)
+	assert.Equal(t, dlContent, bs)
+}
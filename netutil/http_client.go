@@ -0,0 +1,267 @@
+package netutil
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gookit/goutil/netutil/httpctype"
+	"github.com/gookit/goutil/netutil/httpreq"
+)
+
+// HTTPErrSnippetLen the max length of the response body snippet in HTTPError
+var HTTPErrSnippetLen = 512
+
+// HTTPError the error for the response status is not 2xx, contains the response body snippet.
+type HTTPError struct {
+	Method     string
+	URL        string
+	StatusCode int
+	Status     string
+	// Snippet the start of the response body
+	Snippet string
+}
+
+// Error string. eg: "netutil: POST http://host/api: 500 Internal Server Error: {"error": "..."}"
+func (e *HTTPError) Error() string {
+	s := "netutil: " + e.Method + " " + e.URL + ": " + e.Status
+	if e.Snippet != "" {
+		s += ": " + e.Snippet
+	}
+	return s
+}
+
+// HTTPClient a minimal HTTP client builder, with timeout, retries and JSON helpers.
+// create by HTTP(), it is not safe for concurrent use.
+//
+// Usage:
+// 	var out Result
+// 	err := netutil.HTTP().Timeout(5*time.Second).Retry(3).JSON(body).PostDecode(url, &out)
+type HTTPClient struct {
+	client    httpreq.Doer
+	ctx       context.Context
+	timeout   time.Duration
+	retries   int
+	retryWait time.Duration
+	header    http.Header
+	body      []byte
+	err       error
+}
+
+// HTTP create a new HTTPClient with defaults: timeout 30s, no retry.
+func HTTP() *HTTPClient {
+	return &HTTPClient{
+		client:    http.DefaultClient,
+		ctx:       context.Background(),
+		timeout:   30 * time.Second,
+		retryWait: 500 * time.Millisecond,
+		header:    make(http.Header),
+	}
+}
+
+// Client set custom http client
+func (h *HTTPClient) Client(c httpreq.Doer) *HTTPClient {
+	h.client = c
+	return h
+}
+
+// Context set the context for requests
+func (h *HTTPClient) Context(ctx context.Context) *HTTPClient {
+	h.ctx = ctx
+	return h
+}
+
+// Timeout set the timeout for each request. <= 0 for no timeout.
+func (h *HTTPClient) Timeout(d time.Duration) *HTTPClient {
+	h.timeout = d
+	return h
+}
+
+// Retry set the max retry times on network error or the response status is 429, 5xx
+func (h *HTTPClient) Retry(times int, wait ...time.Duration) *HTTPClient {
+	h.retries = times
+	if len(wait) > 0 {
+		h.retryWait = wait[0]
+	}
+	return h
+}
+
+// Header set a request header
+func (h *HTTPClient) Header(key, val string) *HTTPClient {
+	h.header.Set(key, val)
+	return h
+}
+
+// Headers set request headers
+func (h *HTTPClient) Headers(kvMap map[string]string) *HTTPClient {
+	for key, val := range kvMap {
+		h.header.Set(key, val)
+	}
+	return h
+}
+
+// ContentType set the request Content-Type
+func (h *HTTPClient) ContentType(cType string) *HTTPClient {
+	return h.Header(httpctype.Key, cType)
+}
+
+// BasicAuth set the Authorization header for basic auth
+func (h *HTTPClient) BasicAuth(username, password string) *HTTPClient {
+	return h.Header("Authorization", httpreq.BuildBasicAuth(username, password))
+}
+
+// BearerToken set the Authorization header by bearer token
+func (h *HTTPClient) BearerToken(token string) *HTTPClient {
+	return h.Header("Authorization", "Bearer "+token)
+}
+
+// Body set the request body and Content-Type
+func (h *HTTPClient) Body(bs []byte, cType string) *HTTPClient {
+	h.body = bs
+	return h.ContentType(cType)
+}
+
+// JSON set the request body by encode the data to JSON, and set the Content-Type.
+func (h *HTTPClient) JSON(data interface{}) *HTTPClient {
+	h.body, h.err = json.Marshal(data)
+	h.header.Set("Accept", "application/json")
+	return h.ContentType(httpctype.JSON)
+}
+
+// Get send GET request
+func (h *HTTPClient) Get(url string) (*http.Response, error) {
+	return h.Send(http.MethodGet, url)
+}
+
+// Post send POST request
+func (h *HTTPClient) Post(url string) (*http.Response, error) {
+	return h.Send(http.MethodPost, url)
+}
+
+// Put send PUT request
+func (h *HTTPClient) Put(url string) (*http.Response, error) {
+	return h.Send(http.MethodPut, url)
+}
+
+// Delete send DELETE request
+func (h *HTTPClient) Delete(url string) (*http.Response, error) {
+	return h.Send(http.MethodDelete, url)
+}
+
+// GetDecode send GET request and decode the JSON response to out. see DoDecode()
+func (h *HTTPClient) GetDecode(url string, out interface{}) error {
+	return h.DoDecode(http.MethodGet, url, out)
+}
+
+// PostDecode send POST request and decode the JSON response to out. see DoDecode()
+func (h *HTTPClient) PostDecode(url string, out interface{}) error {
+	return h.DoDecode(http.MethodPost, url, out)
+}
+
+// DoDecode send request and decode the JSON response to out, out can be nil for skip decode.
+// will return *HTTPError on the response status is not 2xx.
+func (h *HTTPClient) DoDecode(method, url string, out interface{}) error {
+	resp, err := h.Send(method, url)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if !httpreq.IsSuccessful(resp.StatusCode) {
+		return newHTTPError(method, url, resp)
+	}
+
+	if out == nil {
+		_, _ = io.Copy(ioutil.Discard, resp.Body)
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// Send request and returns the response. will retry on network error or the response status is 429, 5xx
+//
+// NOTE: should close the response body after use.
+func (h *HTTPClient) Send(method, url string) (*http.Response, error) {
+	if h.err != nil {
+		return nil, h.err
+	}
+
+	for i := 0; ; i++ {
+		resp, err := h.sendOnce(method, url)
+		canRetry := err != nil || resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500
+		if !canRetry || i >= h.retries || h.ctx.Err() != nil {
+			return resp, err
+		}
+
+		// discard the response for retry, drain a limited size for reuse the connection
+		if resp != nil {
+			_, _ = io.Copy(ioutil.Discard, io.LimitReader(resp.Body, maxDrainSize))
+			_ = resp.Body.Close()
+		}
+
+		select {
+		case <-h.ctx.Done():
+			return nil, h.ctx.Err()
+		case <-time.After(h.retryWait):
+		}
+	}
+}
+
+func (h *HTTPClient) sendOnce(method, url string) (*http.Response, error) {
+	ctx, cancel := h.ctx, context.CancelFunc(func() {})
+	if h.timeout > 0 {
+		ctx, cancel = context.WithTimeout(h.ctx, h.timeout)
+	}
+
+	var body io.Reader
+	if h.body != nil {
+		body = bytes.NewReader(h.body)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, body)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	httpreq.AddHeadersToRequest(req, h.header)
+
+	resp, err := h.client.Do(req)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	// cancel the timeout ctx on the body closed
+	resp.Body = &cancelBody{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+// cancelBody call cancel func on the body closed
+type cancelBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
+func newHTTPError(method, url string, resp *http.Response) *HTTPError {
+	bs, _ := ioutil.ReadAll(io.LimitReader(resp.Body, int64(HTTPErrSnippetLen)))
+
+	return &HTTPError{
+		Method:     method,
+		URL:        url,
+		StatusCode: resp.StatusCode,
+		Status:     resp.Status,
+		Snippet:    strings.TrimSpace(string(bs)),
+	}
+}
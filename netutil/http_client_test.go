@@ -0,0 +1,123 @@
+package netutil_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gookit/goutil/netutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHTTPClient_PostDecode(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"method": r.Method,
+			"ctype":  r.Header.Get("Content-Type"),
+			"auth":   r.Header.Get("Authorization"),
+			"name":   body["name"],
+		})
+	}))
+	defer srv.Close()
+
+	var out struct {
+		Method, Ctype, Auth, Name string
+	}
+	err := netutil.HTTP().
+		Timeout(time.Second).
+		BearerToken("abc").
+		JSON(map[string]string{"name": "inhere"}).
+		PostDecode(srv.URL, &out)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "POST", out.Method)
+	assert.Equal(t, "application/json; charset=utf-8", out.Ctype)
+	assert.Equal(t, "Bearer abc", out.Auth)
+	assert.Equal(t, "inhere", out.Name)
+
+	err = netutil.HTTP().BasicAuth("user", "pwd").GetDecode(srv.URL, &out)
+	assert.NoError(t, err)
+	assert.Equal(t, "GET", out.Method)
+	assert.True(t, strings.HasPrefix(out.Auth, "Basic "))
+
+	// json encode error
+	err = netutil.HTTP().JSON(make(chan int)).PostDecode(srv.URL, nil)
+	assert.Error(t, err)
+}
+
+func TestHTTPClient_retry(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) < 3 {
+			w.WriteHeader(http.StatusBadGateway)
+			_, _ = w.Write([]byte(`{"error": "bad gateway"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"ok": true}`))
+	}))
+	defer srv.Close()
+
+	var out map[string]bool
+	err := netutil.HTTP().Retry(1, time.Millisecond).GetDecode(srv.URL, &out)
+	assert.Error(t, err)
+
+	var he *netutil.HTTPError
+	assert.True(t, errors.As(err, &he))
+	assert.Equal(t, 502, he.StatusCode)
+	assert.Equal(t, `{"error": "bad gateway"}`, he.Snippet)
+	assert.Equal(t, "netutil: GET "+srv.URL+`: 502 Bad Gateway: {"error": "bad gateway"}`, err.Error())
+
+	// with body on retry
+	atomic.StoreInt32(&calls, 0)
+	err = netutil.HTTP().Retry(2, time.Millisecond).JSON(map[string]int{"id": 1}).PostDecode(srv.URL, &out)
+	assert.NoError(t, err)
+	assert.True(t, out["ok"])
+	assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+}
+
+func TestHTTPClient_retryDrainLimit(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			// a large 5xx body, block until the client closed it
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte(strings.Repeat("x", 16<<10)))
+			w.(http.Flusher).Flush()
+			<-r.Context().Done()
+			return
+		}
+		_, _ = w.Write([]byte(`{"ok": true}`))
+	}))
+	defer srv.Close()
+
+	var out map[string]bool
+	start := time.Now()
+	err := netutil.HTTP().Timeout(3*time.Second).Retry(1, time.Millisecond).GetDecode(srv.URL, &out)
+	assert.NoError(t, err)
+	assert.True(t, out["ok"])
+	assert.Less(t, int64(time.Since(start)), int64(time.Second))
+}
+
+func TestHTTPClient_timeout(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(100 * time.Millisecond)
+	}))
+	defer srv.Close()
+
+	_, err := netutil.HTTP().Timeout(20 * time.Millisecond).Get(srv.URL)
+	assert.Error(t, err)
+
+	resp, err := netutil.HTTP().Header("X-Test", "val").Headers(map[string]string{"X-Key": "abc"}).Delete(srv.URL)
+	assert.NoError(t, err)
+	assert.NoError(t, resp.Body.Close())
+	assert.Equal(t, 200, resp.StatusCode)
+}
@@ -0,0 +1,122 @@
+package netutil
+
+import (
+	"errors"
+	"net"
+	"strings"
+)
+
+// InterfaceInfo the summary info of a network interface
+type InterfaceInfo struct {
+	Name string `json:"name"`
+	// MAC the hardware address, empty on the interface has not MAC. eg: loopback
+	MAC string   `json:"mac"`
+	IPs []string `json:"ips"`
+	MTU int      `json:"mtu"`
+	// Up the interface is up
+	Up       bool `json:"up"`
+	Loopback bool `json:"loopback"`
+}
+
+// Interfaces get the summary info of the network interfaces. useful for diagnostics.
+func Interfaces() ([]InterfaceInfo, error) {
+	ifs, err := net.Interfaces()
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]InterfaceInfo, 0, len(ifs))
+	for _, iface := range ifs {
+		info := InterfaceInfo{
+			Name:     iface.Name,
+			MAC:      iface.HardwareAddr.String(),
+			MTU:      iface.MTU,
+			Up:       iface.Flags&net.FlagUp != 0,
+			Loopback: iface.Flags&net.FlagLoopback != 0,
+		}
+
+		if addrs, err := iface.Addrs(); err == nil {
+			for _, addr := range addrs {
+				if ipNet, ok := addr.(*net.IPNet); ok {
+					info.IPs = append(info.IPs, ipNet.IP.String())
+				}
+			}
+		}
+		infos = append(infos, info)
+	}
+	return infos, nil
+}
+
+// MACOf get the MAC address of the interface by name. eg: "eth0"
+func MACOf(name string) (string, error) {
+	iface, err := net.InterfaceByName(name)
+	if err != nil {
+		return "", err
+	}
+
+	if len(iface.HardwareAddr) == 0 {
+		return "", errors.New("netutil: the interface " + name + " has not MAC address")
+	}
+	return iface.HardwareAddr.String(), nil
+}
+
+// PrimaryMAC get the MAC address of the primary interface.
+//
+// the primary interface is the interface of the OutboundIP, or the first up and non-loopback interface has MAC.
+// returns empty on not found.
+func PrimaryMAC() string {
+	infos, err := Interfaces()
+	if err != nil {
+		return ""
+	}
+
+	if ip := OutboundIP(); ip != "" {
+		for _, info := range infos {
+			if info.MAC == "" {
+				continue
+			}
+
+			for _, ifIP := range info.IPs {
+				if ifIP == ip {
+					return info.MAC
+				}
+			}
+		}
+	}
+
+	for _, info := range infos {
+		if info.Up && !info.Loopback && info.MAC != "" {
+			return info.MAC
+		}
+	}
+	return ""
+}
+
+// NormalizeMAC normalize the MAC address to lower case and colon separated format.
+//
+// allow formats:
+// 	"AA:BB:CC:DD:EE:FF", "aa-bb-cc-dd-ee-ff", "aabb.ccdd.eeff", "aabbccddeeff"
+//
+// Usage:
+// 	mac, err := netutil.NormalizeMAC("AA-BB-CC-DD-EE-FF") // "aa:bb:cc:dd:ee:ff"
+func NormalizeMAC(s string) (string, error) {
+	s = strings.TrimSpace(s)
+
+	// no separator, eg: "aabbccddeeff"
+	if len(s) == 12 && !strings.ContainsAny(s, ":-.") {
+		var sb strings.Builder
+		for i := 0; i < 12; i += 2 {
+			if i > 0 {
+				sb.WriteByte(':')
+			}
+			sb.WriteString(s[i : i+2])
+		}
+		s = sb.String()
+	}
+
+	hw, err := net.ParseMAC(s)
+	if err != nil {
+		return "", err
+	}
+	return hw.String(), nil
+}
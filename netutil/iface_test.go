@@ -0,0 +1,54 @@
+package netutil_test
+
+import (
+	"net"
+	"testing"
+
+	"github.com/gookit/goutil/netutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInterfaces(t *testing.T) {
+	infos, err := netutil.Interfaces()
+	assert.NoError(t, err)
+	assert.NotEmpty(t, infos)
+
+	for _, info := range infos {
+		assert.NotEmpty(t, info.Name)
+		for _, ip := range info.IPs {
+			assert.NotNil(t, net.ParseIP(ip))
+		}
+
+		if info.MAC != "" {
+			mac, err := netutil.MACOf(info.Name)
+			assert.NoError(t, err)
+			assert.Equal(t, info.MAC, mac)
+		}
+	}
+
+	_, err = netutil.MACOf("not-exists-iface")
+	assert.Error(t, err)
+}
+
+func TestPrimaryMAC(t *testing.T) {
+	mac := netutil.PrimaryMAC()
+	if mac == "" {
+		t.Skip("not found the primary interface")
+	}
+
+	_, err := net.ParseMAC(mac)
+	assert.NoError(t, err)
+}
+
+func TestNormalizeMAC(t *testing.T) {
+	for _, s := range []string{"AA:BB:CC:DD:EE:0F", "aa-bb-cc-dd-ee-0f", "aabb.ccdd.ee0f", "AABBCCDDEE0F", " aabbccddee0f "} {
+		mac, err := netutil.NormalizeMAC(s)
+		assert.NoError(t, err, s)
+		assert.Equal(t, "aa:bb:cc:dd:ee:0f", mac, s)
+	}
+
+	_, err := netutil.NormalizeMAC("aa:bb:cc")
+	assert.Error(t, err)
+	_, err = netutil.NormalizeMAC("zzbbccddee0f")
+	assert.Error(t, err)
+}
@@ -4,23 +4,82 @@ import (
 	"net"
 )
 
-// InternalIP get internal IP
+// InternalIP get internal IP. alias of InternalIPv4()
 func InternalIP() (ip string) {
+	return InternalIPv4()
+}
+
+// InternalIPv4 get the first non-loopback IPv4 address of the interfaces.
+// returns empty on not found.
+func InternalIPv4() (ip string) {
+	ips, err := InterfaceAddrs(func(ip net.IP) bool {
+		return !ip.IsLoopback() && ip.To4() != nil
+	})
+
+	if err != nil || len(ips) == 0 {
+		return ""
+	}
+	return ips[0].String()
+}
+
+// InternalIPv6 get the first non-loopback and non-link-local IPv6 address of the interfaces.
+// returns empty on not found.
+func InternalIPv6() (ip string) {
+	ips, err := InterfaceAddrs(func(ip net.IP) bool {
+		return ip.To4() == nil && !ip.IsLoopback() && !ip.IsLinkLocalUnicast()
+	})
+
+	if err != nil || len(ips) == 0 {
+		return ""
+	}
+	return ips[0].String()
+}
+
+// OutboundIP get the preferred outbound IP of the machine.
+// returns empty on the network is unreachable.
+//
+// it uses the UDP dial for get the local address, no any data will be sent.
+func OutboundIP() string {
+	conn, err := net.Dial("udp", "8.8.8.8:80")
+	if err != nil {
+		return ""
+	}
+	defer conn.Close()
+
+	if addr, ok := conn.LocalAddr().(*net.UDPAddr); ok {
+		return addr.IP.String()
+	}
+	return ""
+}
+
+// InterfaceAddrs get the IP addresses of the interfaces, can with a filter func.
+//
+// Usage:
+// 	// all IPv4 addresses
+// 	ips, err := netutil.InterfaceAddrs(func(ip net.IP) bool {
+// 		return ip.To4() != nil
+// 	})
+func InterfaceAddrs(filter func(ip net.IP) bool) ([]net.IP, error) {
 	addrs, err := net.InterfaceAddrs()
 	if err != nil {
-		panic("Oops: " + err.Error())
+		return nil, err
 	}
 
+	var ips []net.IP
 	for _, a := range addrs {
-		if ipNet, ok := a.(*net.IPNet); ok && !ipNet.IP.IsLoopback() {
-			if ipNet.IP.To4() != nil {
-				// os.Stdout.WriteString(ipNet.IP.String() + "\n")
-				ip = ipNet.IP.String()
-				return
-			}
+		var ip net.IP
+		switch typAddr := a.(type) {
+		case *net.IPNet:
+			ip = typAddr.IP
+		case *net.IPAddr:
+			ip = typAddr.IP
+		default:
+			continue
 		}
-	}
 
-	// os.Exit(0)
-	return
+		if filter == nil || filter(ip) {
+			ips = append(ips, ip)
+		}
+	}
+	return ips, nil
 }
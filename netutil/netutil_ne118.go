@@ -1,4 +0,0 @@
-//go:build !go1.18
-// +build !go1.18
-
-package netutil
@@ -1,6 +1,7 @@
 package netutil_test
 
 import (
+	"net"
 	"testing"
 
 	"github.com/gookit/goutil/netutil"
@@ -9,4 +10,37 @@ import (
 
 func TestInternalIP(t *testing.T) {
 	assert.NotEmpty(t, netutil.InternalIP())
+	assert.Equal(t, netutil.InternalIPv4(), netutil.InternalIP())
+
+	ip := net.ParseIP(netutil.InternalIPv4())
+	assert.NotNil(t, ip)
+	assert.False(t, ip.IsLoopback())
+
+	if s := netutil.InternalIPv6(); s != "" {
+		ip = net.ParseIP(s)
+		assert.NotNil(t, ip)
+		assert.Nil(t, ip.To4())
+	}
+}
+
+func TestOutboundIP(t *testing.T) {
+	s := netutil.OutboundIP()
+	if s == "" {
+		t.Skip("the network is unreachable")
+	}
+	assert.NotNil(t, net.ParseIP(s))
+}
+
+func TestInterfaceAddrs(t *testing.T) {
+	ips, err := netutil.InterfaceAddrs(nil)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, ips)
+
+	ips, err = netutil.InterfaceAddrs(func(ip net.IP) bool {
+		return ip.IsLoopback()
+	})
+	assert.NoError(t, err)
+	for _, ip := range ips {
+		assert.True(t, ip.IsLoopback())
+	}
 }
@@ -0,0 +1,96 @@
+package netutil
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/gookit/goutil/netutil/httpreq"
+)
+
+// DefaultWaitInterval default poll interval for WaitPort, WaitHTTPOK
+var DefaultWaitInterval = 200 * time.Millisecond
+
+// maxDrainSize the max size for drain the discarded response body
+const maxDrainSize = 4 << 10
+
+// WaitPort wait the TCP address is reachable, will poll by the interval until the ctx is done.
+// the interval <= 0 will use DefaultWaitInterval.
+//
+// Usage:
+// 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+// 	defer cancel()
+// 	err := netutil.WaitPort(ctx, "127.0.0.1:6379", 100*time.Millisecond)
+func WaitPort(ctx context.Context, addr string, interval time.Duration) error {
+	dialer := &net.Dialer{}
+	return waitFor(ctx, interval, "port "+addr, func() error {
+		conn, err := dialer.DialContext(ctx, "tcp", addr)
+		if err != nil {
+			return err
+		}
+		return conn.Close()
+	})
+}
+
+// WaitHTTPOK wait the url response status is 2xx, will poll by the interval until the ctx is done.
+// the interval <= 0 will use DefaultWaitInterval.
+//
+// Usage:
+// 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+// 	defer cancel()
+// 	err := netutil.WaitHTTPOK(ctx, "http://127.0.0.1:8080/health", 100*time.Millisecond)
+func WaitHTTPOK(ctx context.Context, url string, interval time.Duration) error {
+	return waitFor(ctx, interval, "url "+url, func() error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return err
+		}
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return err
+		}
+
+		// drain a little body for reuse the connection
+		_, _ = io.Copy(ioutil.Discard, io.LimitReader(resp.Body, maxDrainSize))
+		_ = resp.Body.Close()
+
+		if !httpreq.IsSuccessful(resp.StatusCode) {
+			return fmt.Errorf("response status %d", resp.StatusCode)
+		}
+		return nil
+	})
+}
+
+// waitFor poll the check func until it returns nil or the ctx is done.
+func waitFor(ctx context.Context, interval time.Duration, target string, check func() error) error {
+	if interval <= 0 {
+		interval = DefaultWaitInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var lastErr error
+	for {
+		err := check()
+		if err == nil {
+			return nil
+		}
+
+		// keep the error before the ctx is done
+		if lastErr == nil || ctx.Err() == nil {
+			lastErr = err
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("netutil: wait %s: %w (last error: %v)", target, ctx.Err(), lastErr)
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package netutil_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gookit/goutil/netutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWaitPort(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	addr := ln.Addr().String()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	assert.NoError(t, netutil.WaitPort(ctx, addr, 10*time.Millisecond))
+
+	// closed port
+	assert.NoError(t, ln.Close())
+	ctx1, cancel1 := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel1()
+
+	err = netutil.WaitPort(ctx1, addr, 10*time.Millisecond)
+	assert.Error(t, err)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	assert.Contains(t, err.Error(), "netutil: wait port "+addr)
+}
+
+func TestWaitHTTPOK(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte("OK"))
+	}))
+	defer srv.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	assert.NoError(t, netutil.WaitHTTPOK(ctx, srv.URL+"/health", 10*time.Millisecond))
+	assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+
+	// always fail
+	srv1 := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv1.Close()
+
+	ctx1, cancel1 := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel1()
+	err := netutil.WaitHTTPOK(ctx1, srv1.URL, 10*time.Millisecond)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "response status 500")
+}
@@ -0,0 +1,77 @@
+package structs
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/gookit/goutil/maputil"
+)
+
+// BindOptions for bind map data to struct
+type BindOptions struct {
+	// TagNames for get the field name, default is DefaultTagNames.
+	TagNames []string
+	// Strict disable the weak type conversion. eg: string "1" => int 1
+	Strict bool
+	// ErrorUnknown return an *BindError on the map has keys that not matched any field.
+	ErrorUnknown bool
+	// ErrorMissing return an *BindError on the struct fields not found in the map.
+	ErrorMissing bool
+}
+
+// BindOptFunc define
+type BindOptFunc func(opt *BindOptions)
+
+// BindError the error of the unknown or missing keys on bind map to struct
+type BindError struct {
+	// Unknown the key paths of map that not matched any field. eg: "db.user"
+	Unknown []string
+	// Missing the key paths of fields that not found in map. eg: "db.password"
+	Missing []string
+}
+
+// Error message
+func (e *BindError) Error() string {
+	var ss []string
+	if len(e.Unknown) > 0 {
+		ss = append(ss, "unknown keys: "+strings.Join(e.Unknown, ", "))
+	}
+	if len(e.Missing) > 0 {
+		ss = append(ss, "missing keys: "+strings.Join(e.Missing, ", "))
+	}
+	return "structs: " + strings.Join(ss, "; ")
+}
+
+// FieldError the error on set the field value
+type FieldError = maputil.FieldError
+
+// BindMap bind the map data to the struct pointer. it is a focused alternative to mapstructure.
+// the binding and weak type conversion are same as the maputil.ToStruct()
+//
+// - weak type conversion. eg: string "1" => int 1, "true" => bool true, "3s" => time.Duration
+// - report the unknown map keys and the missing struct fields by the options.
+//
+// Usage:
+// 	conf := &Config{}
+// 	err := structs.BindMap(mp, conf, func(opt *structs.BindOptions) {
+// 		opt.ErrorUnknown = true
+// 	})
+func BindMap(mp map[string]interface{}, ptr interface{}, optFns ...BindOptFunc) error {
+	opt := &BindOptions{TagNames: DefaultTagNames}
+	for _, fn := range optFns {
+		fn(opt)
+	}
+
+	err := maputil.ToStructWith(mp, ptr, func(smOpt *maputil.StructOptions) {
+		smOpt.TagNames = opt.TagNames
+		smOpt.Strict = opt.Strict
+		smOpt.ErrorUnused = opt.ErrorUnknown
+		smOpt.ErrorMissing = opt.ErrorMissing
+	})
+
+	var kErr *maputil.KeysError
+	if errors.As(err, &kErr) {
+		return &BindError{Unknown: kErr.Unused, Missing: kErr.Missing}
+	}
+	return err
+}
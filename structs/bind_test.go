@@ -0,0 +1,119 @@
+package structs_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gookit/goutil/structs"
+	"github.com/stretchr/testify/assert"
+)
+
+type dbConfig struct {
+	Host     string `json:"host"`
+	Port     int    `json:"port"`
+	Password string `json:"password"`
+}
+
+type appConfig struct {
+	Name    string        `json:"name"`
+	Debug   bool          `json:"debug"`
+	Timeout time.Duration `json:"timeout"`
+	Retry   time.Duration
+	Rate    float64
+	Tags    []string       `json:"tags"`
+	Ports   []uint16       `json:"ports"`
+	DB      *dbConfig      `json:"db"`
+	Labels  map[string]int `json:"labels"`
+	Start   time.Time      `json:"start"`
+}
+
+func TestBindMap(t *testing.T) {
+	mp := map[string]interface{}{
+		"name":    "app",
+		"debug":   "true",
+		"timeout": "3s",
+		"retry":   1.5e9,
+		"Rate":    "0.5",
+		"tags":    []interface{}{"a", 1},
+		"ports":   []string{"80", "443"},
+		"db": map[interface{}]interface{}{
+			"host": "localhost",
+			"port": "3306",
+		},
+		"labels": map[string]interface{}{"a": "1", "b": 2.0},
+		"start":  "2022-08-01T10:00:00Z",
+	}
+
+	conf := &appConfig{}
+	err := structs.BindMap(mp, conf)
+	assert.NoError(t, err)
+	assert.Equal(t, "app", conf.Name)
+	assert.True(t, conf.Debug)
+	assert.Equal(t, 3*time.Second, conf.Timeout)
+	assert.Equal(t, 1500*time.Millisecond, conf.Retry)
+	assert.Equal(t, 0.5, conf.Rate)
+	assert.Equal(t, []string{"a", "1"}, conf.Tags)
+	assert.Equal(t, []uint16{80, 443}, conf.Ports)
+	assert.Equal(t, &dbConfig{Host: "localhost", Port: 3306}, conf.DB)
+	assert.Equal(t, map[string]int{"a": 1, "b": 2}, conf.Labels)
+	assert.Equal(t, 2022, conf.Start.Year())
+
+	// field error
+	err = structs.BindMap(map[string]interface{}{"db": map[string]interface{}{"port": "abc"}}, conf)
+	assert.Error(t, err)
+	var fe *structs.FieldError
+	assert.True(t, errors.As(err, &fe))
+	assert.Equal(t, "db.port", fe.Path)
+
+	err = structs.BindMap(map[string]interface{}{"ports": []int{70000}}, conf)
+	assert.ErrorContains(t, err, "overflows uint16")
+
+	err = structs.BindMap(mp, *conf)
+	assert.Error(t, err)
+}
+
+func TestBindMap_options(t *testing.T) {
+	mp := map[string]interface{}{
+		"name":  "app",
+		"port":  80,
+		"extra": "value",
+		"db":    map[string]interface{}{"host": "localhost", "user": "root"},
+	}
+
+	conf := &appConfig{}
+	err := structs.BindMap(mp, conf, func(opt *structs.BindOptions) {
+		opt.ErrorUnknown = true
+		opt.ErrorMissing = true
+	})
+
+	var be *structs.BindError
+	assert.True(t, errors.As(err, &be))
+	assert.Equal(t, []string{"db.user", "extra", "port"}, be.Unknown)
+	assert.Contains(t, be.Missing, "db.port")
+	assert.Contains(t, be.Missing, "timeout")
+	assert.NotContains(t, be.Missing, "name")
+	assert.Equal(t, "app", conf.Name)
+
+	// strict mode
+	err = structs.BindMap(map[string]interface{}{"name": 123}, conf, func(opt *structs.BindOptions) {
+		opt.Strict = true
+	})
+	assert.ErrorContains(t, err, "cannot use int value as string")
+
+	err = structs.BindMap(map[string]interface{}{"Rate": 2, "db": map[string]interface{}{"port": 2.0}}, conf, func(opt *structs.BindOptions) {
+		opt.Strict = true
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 2.0, conf.Rate)
+	assert.Equal(t, 2, conf.DB.Port)
+}
+
+func TestBindMap_negativeToUint(t *testing.T) {
+	st := &struct{ N uint64 }{}
+
+	err := structs.BindMap(map[string]interface{}{"N": -1}, st)
+	assert.ErrorContains(t, err, "overflows uint64")
+	assert.Equal(t, uint64(0), st.N)
+	assert.Error(t, structs.BindMap(map[string]interface{}{"N": float64(-1)}, st))
+}
@@ -0,0 +1,274 @@
+package structs
+
+import (
+	"encoding"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"unicode"
+)
+
+// DefaultTagNames the default tag names for get the field name. will use the first found tag.
+//
+// for ToMap(), it is used on call WithTagNames() without args.
+var DefaultTagNames = []string{"map", "json"}
+
+// key styles for the MapOptions.KeyStyle
+const (
+	// KeyRaw use the field name as key. it is default
+	KeyRaw = ""
+	// KeySnake convert the field name to snake case. eg: "UserName" -> "user_name"
+	KeySnake = "snake"
+	// KeyCamel convert the field name to lower camel case. eg: "UserName" -> "userName"
+	KeyCamel = "camel"
+)
+
+// MapOptions for convert struct to map
+type MapOptions struct {
+	// TagNames for get the field name and options. default is empty, use the field name as key.
+	TagNames []string
+	// KeyStyle convert the field name to the key. only for the fields without tag name.
+	KeyStyle string
+	// KeyFunc custom convert the field name to the key. it is higher priority than KeyStyle.
+	KeyFunc func(name string) string
+	// OmitEmpty skip all zero value fields, like all fields has the "omitempty" option.
+	OmitEmpty bool
+	// InlineEmbedded inline the fields of the embedded struct to parent.
+	// default will keep the embedded struct as a field.
+	InlineEmbedded bool
+	// Recursive convert the nested struct to map, default will keep the raw value.
+	Recursive bool
+}
+
+// MapOptFunc define
+type MapOptFunc func(opt *MapOptions)
+
+// WithKeyStyle set the key style for convert struct to map. eg: KeySnake
+func WithKeyStyle(style string) MapOptFunc {
+	return func(opt *MapOptions) {
+		opt.KeyStyle = style
+	}
+}
+
+// WithTagNames set the tag names for get the field name and options. if tagNames is empty, will use DefaultTagNames.
+func WithTagNames(tagNames ...string) MapOptFunc {
+	if len(tagNames) == 0 {
+		tagNames = DefaultTagNames
+	}
+
+	return func(opt *MapOptions) {
+		opt.TagNames = tagNames
+	}
+}
+
+// WithRecursive convert the nested struct to map, and inline the embedded struct fields to parent.
+func WithRecursive() MapOptFunc {
+	return func(opt *MapOptions) {
+		opt.Recursive = true
+		opt.InlineEmbedded = true
+	}
+}
+
+// TryToMap convert struct to map by reflect, will keep the type of the field values.
+// default only use the exported field name as key, and keep the raw field value.
+//
+// - support tags on set TagNames: `map:"name"`, `json:"name,omitempty"`, `json:"-"`, `json:",inline"`
+// - the nested struct will be converted to map[string]interface{} on Recursive is true
+// - the anonymous(embedded) struct fields will be inlined to the parent map on InlineEmbedded is true
+//
+// Usage:
+// 	mp, err := structs.TryToMap(user, structs.WithTagNames(), structs.WithRecursive())
+func TryToMap(st interface{}, optFns ...MapOptFunc) (map[string]interface{}, error) {
+	return toMap(st, optFns, nil)
+}
+
+// toMap convert struct to map, the filter is for the top level fields.
+func toMap(st interface{}, optFns []MapOptFunc, filter func(fieldName, key string) bool) (map[string]interface{}, error) {
+	mp := make(map[string]interface{})
+	if st == nil {
+		return mp, nil
+	}
+
+	rv := reflect.ValueOf(st)
+	for rv.Kind() == reflect.Ptr && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+
+	if rv.Kind() != reflect.Struct {
+		return mp, errNotAnStruct
+	}
+
+	opt := &MapOptions{}
+	for _, fn := range optFns {
+		fn(opt)
+	}
+
+	mc := &mapConverter{opt: opt, filter: filter}
+	mc.structToMap(rv, mp, true)
+	return mp, nil
+}
+
+type mapConverter struct {
+	opt *MapOptions
+	// filter the top level fields
+	filter func(fieldName, key string) bool
+}
+
+func (mc *mapConverter) structToMap(sv reflect.Value, mp map[string]interface{}, top bool) {
+	sm := getStructMeta(sv.Type(), mc.opt.TagNames)
+	for _, fm := range sm.Fields {
+		tag := fm.Tag
+		if tag.Skip {
+			continue
+		}
+
+		fv := sv.Field(fm.Index[0])
+		if tag.Squash || fm.Inline() && mc.opt.InlineEmbedded {
+			if ev := reflect.Indirect(fv); ev.Kind() == reflect.Struct {
+				mc.structToMap(ev, mp, top)
+				continue
+			}
+		}
+
+		// skip unexported field
+		if fm.PkgPath != "" {
+			continue
+		}
+
+		if (tag.OmitEmpty || mc.opt.OmitEmpty) && fv.IsZero() {
+			continue
+		}
+
+		name := tag.Name
+		if !tag.Tagged {
+			name = mc.keyName(name)
+		}
+
+		if top && mc.filter != nil && !mc.filter(fm.Name, name) {
+			continue
+		}
+		mp[name] = mc.toMapValue(fv)
+	}
+}
+
+func (mc *mapConverter) keyName(name string) string {
+	if mc.opt.KeyFunc != nil {
+		return mc.opt.KeyFunc(name)
+	}
+
+	switch mc.opt.KeyStyle {
+	case KeySnake:
+		return strings.ToLower(strings.Join(splitWords(name), "_"))
+	case KeyCamel:
+		words := splitWords(name)
+		if len(words) > 0 {
+			words[0] = strings.ToLower(words[0])
+		}
+		return strings.Join(words, "")
+	}
+	return name
+}
+
+// toMapValue convert the field value, the nested struct will be converted to map on Recursive is true.
+func (mc *mapConverter) toMapValue(fv reflect.Value) interface{} {
+	if !mc.opt.Recursive || isMarshaler(fv) {
+		return fv.Interface()
+	}
+
+	switch fv.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if fv.IsNil() {
+			return fv.Interface()
+		}
+
+		if ev := fv.Elem(); ev.Kind() == reflect.Struct || hasStructElem(ev.Type()) {
+			return mc.toMapValue(ev)
+		}
+		return fv.Interface()
+	case reflect.Struct:
+		sub := make(map[string]interface{}, fv.NumField())
+		mc.structToMap(fv, sub, false)
+		return sub
+	case reflect.Slice, reflect.Array:
+		if fv.Kind() == reflect.Slice && fv.IsNil() || !hasStructElem(fv.Type()) {
+			return fv.Interface()
+		}
+
+		list := make([]interface{}, fv.Len())
+		for i := 0; i < fv.Len(); i++ {
+			list[i] = mc.toMapValue(fv.Index(i))
+		}
+		return list
+	case reflect.Map:
+		if fv.IsNil() || fv.Type().Key().Kind() != reflect.String || !hasStructElem(fv.Type()) {
+			return fv.Interface()
+		}
+
+		sub := make(map[string]interface{}, fv.Len())
+		iter := fv.MapRange()
+		for iter.Next() {
+			sub[iter.Key().String()] = mc.toMapValue(iter.Value())
+		}
+		return sub
+	}
+	return fv.Interface()
+}
+
+// isMarshaler check the value has custom marshal method. eg: time.Time
+func isMarshaler(fv reflect.Value) bool {
+	if !fv.CanInterface() {
+		return false
+	}
+
+	switch fv.Interface().(type) {
+	case json.Marshaler, encoding.TextMarshaler:
+		return true
+	}
+	return false
+}
+
+// hasStructElem check the elem type of slice, array, map is struct
+func hasStructElem(typ reflect.Type) bool {
+	switch typ.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map:
+	default:
+		return false
+	}
+
+	elemTyp := typ.Elem()
+	for elemTyp.Kind() == reflect.Ptr {
+		elemTyp = elemTyp.Elem()
+	}
+	return elemTyp.Kind() == reflect.Struct
+}
+
+// splitWords split the field name to words. eg: "HTTPServerID" -> ["HTTP", "Server", "ID"]
+func splitWords(name string) []string {
+	rs := []rune(name)
+
+	var words []string
+	var start int
+	for i := 1; i < len(rs); i++ {
+		cur, prev := rs[i], rs[i-1]
+		switch {
+		case cur == '_':
+			if start < i {
+				words = append(words, string(rs[start:i]))
+			}
+			start = i + 1
+		// "userName" -> "user", "Name"
+		case unicode.IsUpper(cur) && !unicode.IsUpper(prev) && prev != '_':
+			words = append(words, string(rs[start:i]))
+			start = i
+		// "HTTPServer" -> "HTTP", "Server"
+		case unicode.IsLower(cur) && unicode.IsUpper(prev) && i-1 > start:
+			words = append(words, string(rs[start:i-1]))
+			start = i - 1
+		}
+	}
+
+	if start < len(rs) {
+		words = append(words, string(rs[start:]))
+	}
+	return words
+}
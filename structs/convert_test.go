@@ -0,0 +1,92 @@
+package structs_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gookit/goutil/structs"
+	"github.com/stretchr/testify/assert"
+)
+
+type BaseModel struct {
+	ID        int
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type address struct {
+	City    string `json:"city"`
+	ZIPCode string
+}
+
+type testUser struct {
+	BaseModel
+	UserName string
+	Email    string `json:"email,omitempty"`
+	Password string `json:"-"`
+	Addr     *address
+	Others   []address `map:"others"`
+	Extra    address   `json:",inline"`
+	age      int
+}
+
+func TestTryToMap_options(t *testing.T) {
+	now := time.Now()
+	u := &testUser{
+		BaseModel: BaseModel{ID: 1, CreatedAt: now},
+		UserName:  "inhere",
+		Password:  "secret",
+		Addr:      &address{City: "chengdu"},
+		Others:    []address{{City: "beijing"}},
+		Extra:     address{ZIPCode: "610000"},
+	}
+
+	// default: use the field name, keep the raw values
+	mp, err := structs.TryToMap(u)
+	assert.NoError(t, err)
+	assert.Equal(t, u.BaseModel, mp["BaseModel"])
+	assert.Equal(t, "", mp["Email"])
+	assert.Equal(t, "secret", mp["Password"])
+	assert.Equal(t, u.Addr, mp["Addr"])
+	assert.Equal(t, u.Others, mp["Others"])
+	assert.Equal(t, u.Extra, mp["Extra"])
+	assert.NotContains(t, mp, "ID")
+	assert.NotContains(t, mp, "age")
+
+	mp, err = structs.TryToMap(u, structs.WithTagNames(), structs.WithRecursive())
+	assert.NoError(t, err)
+	assert.Equal(t, 1, mp["ID"])
+	assert.Equal(t, now, mp["created_at"])
+	assert.Equal(t, "inhere", mp["UserName"])
+	assert.Equal(t, "610000", mp["ZIPCode"])
+	assert.Equal(t, map[string]interface{}{"city": "chengdu", "ZIPCode": ""}, mp["Addr"])
+	assert.Equal(t, []interface{}{map[string]interface{}{"city": "beijing", "ZIPCode": ""}}, mp["others"])
+	assert.NotContains(t, mp, "email")
+	assert.NotContains(t, mp, "Password")
+	assert.NotContains(t, mp, "age")
+
+	mp = structs.ToMap(u, structs.WithTagNames(), structs.WithKeyStyle(structs.KeySnake), func(opt *structs.MapOptions) {
+		opt.Recursive = true
+		opt.OmitEmpty = true
+	})
+	assert.Equal(t, "inhere", mp["user_name"])
+	assert.Equal(t, "610000", mp["zip_code"])
+	assert.Equal(t, map[string]interface{}{"id": 1, "created_at": now}, mp["base_model"])
+	assert.Equal(t, map[string]interface{}{"city": "chengdu"}, mp["addr"])
+
+	mp = structs.ToMap(u, structs.WithKeyStyle(structs.KeyCamel), structs.WithTagNames("map"), structs.WithRecursive())
+	assert.Equal(t, "inhere", mp["userName"])
+	assert.Equal(t, "secret", mp["password"])
+	assert.Equal(t, "", mp["email"])
+	assert.Equal(t, now, mp["createdAt"])
+	assert.Equal(t, map[string]interface{}{"city": "", "zipCode": "610000"}, mp["extra"])
+
+	mp = structs.ToMap(u, structs.WithTagNames(), func(opt *structs.MapOptions) {
+		opt.InlineEmbedded = true
+		opt.KeyFunc = func(name string) string { return "f_" + name }
+	})
+	assert.Equal(t, u.Addr, mp["f_Addr"])
+	assert.Equal(t, u.Others, mp["others"])
+
+	_, err = structs.TryToMap([]int{1})
+	assert.Error(t, err)
+}
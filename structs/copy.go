@@ -0,0 +1,221 @@
+package structs
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+	"unsafe"
+)
+
+// UnexportedPolicy the policy for copy the unexported struct fields
+type UnexportedPolicy uint8
+
+// the policies for copy unexported fields
+const (
+	// UnexportedShallow shallow copy the unexported fields, it is default.
+	UnexportedShallow UnexportedPolicy = iota
+	// UnexportedSkip skip the unexported fields, keep them as zero value.
+	// NOTE: the struct without exported fields(eg: time.Time) will be copied as a value.
+	UnexportedSkip
+	// UnexportedDeep deep copy the unexported fields by unsafe.
+	UnexportedDeep
+)
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// CopyOptions for deep copy
+type CopyOptions struct {
+	// Unexported the policy for unexported fields. default is UnexportedShallow
+	Unexported UnexportedPolicy
+}
+
+// CopyOptFunc define
+type CopyOptFunc func(opt *CopyOptions)
+
+// DeepCopy deep copy the value. support nested structs, maps, slices, arrays and pointers.
+//
+// - the cyclic references will be kept in the copied value.
+// - chan, func values are copied as is.
+//
+// Usage:
+// 	newUser := structs.DeepCopy(user).(*User)
+func DeepCopy(src interface{}, optFns ...CopyOptFunc) interface{} {
+	if src == nil {
+		return nil
+	}
+
+	return newCopier(optFns).copy(reflect.ValueOf(src)).Interface()
+}
+
+// CopyTo deep copy the src value to the dst pointer.
+// the dst must be a pointer of the src type, or same type as the src pointer.
+//
+// Usage:
+// 	var newUser User
+// 	err := structs.CopyTo(user, &newUser)
+func CopyTo(src, dst interface{}, optFns ...CopyOptFunc) error {
+	dv := reflect.ValueOf(dst)
+	if dv.Kind() != reflect.Ptr || dv.IsNil() {
+		return errors.New("structs: CopyTo the dst must be a non-nil pointer")
+	}
+
+	sv := reflect.ValueOf(src)
+	if !sv.IsValid() {
+		dv.Elem().Set(reflect.Zero(dv.Elem().Type()))
+		return nil
+	}
+
+	// eg: src is *User, dst is *User
+	if sv.Type() == dv.Type() {
+		if sv.IsNil() {
+			return errors.New("structs: CopyTo the src is a nil pointer")
+		}
+		sv = sv.Elem()
+	}
+
+	if sv.Type() != dv.Elem().Type() {
+		return fmt.Errorf("structs: CopyTo cannot copy %s value to %s", sv.Type(), dv.Type())
+	}
+
+	dv.Elem().Set(newCopier(optFns).copy(sv))
+	return nil
+}
+
+type copier struct {
+	opt *CopyOptions
+	// copied pointers and maps, for keep the cyclic references
+	visited map[visitKey]reflect.Value
+}
+
+type visitKey struct {
+	ptr uintptr
+	typ reflect.Type
+}
+
+func newCopier(optFns []CopyOptFunc) *copier {
+	opt := &CopyOptions{}
+	for _, fn := range optFns {
+		fn(opt)
+	}
+
+	return &copier{opt: opt, visited: make(map[visitKey]reflect.Value)}
+}
+
+// copy the value, returns a new value of the same type.
+func (c *copier) copy(src reflect.Value) reflect.Value {
+	typ := src.Type()
+
+	switch src.Kind() {
+	case reflect.Ptr:
+		if src.IsNil() {
+			return reflect.Zero(typ)
+		}
+
+		key := visitKey{src.Pointer(), typ}
+		if nv, ok := c.visited[key]; ok {
+			return nv
+		}
+
+		nv := reflect.New(typ.Elem())
+		c.visited[key] = nv
+		nv.Elem().Set(c.copy(src.Elem()))
+		return nv
+	case reflect.Interface:
+		nv := reflect.New(typ).Elem()
+		if !src.IsNil() {
+			nv.Set(c.copy(src.Elem()))
+		}
+		return nv
+	case reflect.Map:
+		if src.IsNil() {
+			return reflect.Zero(typ)
+		}
+
+		key := visitKey{src.Pointer(), typ}
+		if nv, ok := c.visited[key]; ok {
+			return nv
+		}
+
+		nv := reflect.MakeMapWithSize(typ, src.Len())
+		c.visited[key] = nv
+		iter := src.MapRange()
+		for iter.Next() {
+			nv.SetMapIndex(c.copy(iter.Key()), c.copy(iter.Value()))
+		}
+		return nv
+	case reflect.Slice:
+		if src.IsNil() {
+			return reflect.Zero(typ)
+		}
+
+		nv := reflect.MakeSlice(typ, src.Len(), src.Cap())
+		for i := 0; i < src.Len(); i++ {
+			nv.Index(i).Set(c.copy(src.Index(i)))
+		}
+		return nv
+	case reflect.Array:
+		nv := reflect.New(typ).Elem()
+		for i := 0; i < src.Len(); i++ {
+			nv.Index(i).Set(c.copy(src.Index(i)))
+		}
+		return nv
+	case reflect.Struct:
+		return c.copyStruct(src)
+	}
+
+	// basic types, chan, func
+	return src
+}
+
+func (c *copier) copyStruct(src reflect.Value) reflect.Value {
+	typ := src.Type()
+	nv := reflect.New(typ).Elem()
+
+	// time.Time is immutable, and its location pointer should be kept.
+	if typ == timeType {
+		nv.Set(src)
+		return nv
+	}
+
+	switch c.opt.Unexported {
+	case UnexportedShallow:
+		nv.Set(src)
+	case UnexportedSkip:
+		if !hasExportedField(typ) {
+			nv.Set(src)
+			return nv
+		}
+	case UnexportedDeep:
+		// make the src addressable for read the unexported fields
+		if !src.CanAddr() {
+			tmp := reflect.New(typ).Elem()
+			tmp.Set(src)
+			src = tmp
+		}
+	}
+
+	for i := 0; i < typ.NumField(); i++ {
+		sf := typ.Field(i)
+		if sf.PkgPath == "" {
+			nv.Field(i).Set(c.copy(src.Field(i)))
+			continue
+		}
+
+		if c.opt.Unexported == UnexportedDeep {
+			sfv := reflect.NewAt(sf.Type, unsafe.Pointer(src.Field(i).UnsafeAddr())).Elem()
+			dfv := reflect.NewAt(sf.Type, unsafe.Pointer(nv.Field(i).UnsafeAddr())).Elem()
+			dfv.Set(c.copy(sfv))
+		}
+	}
+	return nv
+}
+
+func hasExportedField(typ reflect.Type) bool {
+	for i := 0; i < typ.NumField(); i++ {
+		if typ.Field(i).PkgPath == "" {
+			return true
+		}
+	}
+	return false
+}
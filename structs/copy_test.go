@@ -0,0 +1,99 @@
+package structs_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gookit/goutil/structs"
+	"github.com/stretchr/testify/assert"
+)
+
+type copyNode struct {
+	Name     string
+	Tags     []string
+	Attrs    map[string]interface{}
+	Next     *copyNode
+	Children []*copyNode
+	Arr      [2]*int
+	Created  time.Time
+	secret   []int
+}
+
+func TestDeepCopy(t *testing.T) {
+	num := 3
+	src := &copyNode{
+		Name:    "root",
+		Tags:    []string{"a", "b"},
+		Attrs:   map[string]interface{}{"list": []interface{}{1, map[string]interface{}{"k": "v"}}},
+		Arr:     [2]*int{&num},
+		Created: time.Now(),
+		secret:  []int{1, 2},
+	}
+	src.Children = []*copyNode{{Name: "child"}}
+	// cyclic reference
+	src.Next = src
+
+	dst := structs.DeepCopy(src).(*copyNode)
+	assert.Equal(t, src.Name, dst.Name)
+	assert.Equal(t, src.Tags, dst.Tags)
+	assert.Equal(t, src.Attrs, dst.Attrs)
+	assert.Equal(t, src.Created, dst.Created)
+	assert.Equal(t, 3, *dst.Arr[0])
+	assert.Equal(t, []int{1, 2}, dst.secret)
+	assert.True(t, dst.Next == dst)
+
+	// modify the copied value, the src is not changed.
+	dst.Tags[0] = "x"
+	dst.Children[0].Name = "new"
+	*dst.Arr[0] = 5
+	dst.Attrs["list"].([]interface{})[1].(map[string]interface{})["k"] = "new"
+	assert.Equal(t, "a", src.Tags[0])
+	assert.Equal(t, "child", src.Children[0].Name)
+	assert.Equal(t, 3, num)
+	assert.Equal(t, "v", src.Attrs["list"].([]interface{})[1].(map[string]interface{})["k"])
+
+	// shallow copy the unexported fields by default
+	dst.secret[0] = 10
+	assert.Equal(t, 10, src.secret[0])
+
+	assert.Nil(t, structs.DeepCopy(nil))
+	assert.Equal(t, 23, structs.DeepCopy(23))
+}
+
+func TestDeepCopy_unexported(t *testing.T) {
+	src := copyNode{Name: "a", Created: time.Now(), secret: []int{1, 2}}
+
+	dst := structs.DeepCopy(src, func(opt *structs.CopyOptions) {
+		opt.Unexported = structs.UnexportedSkip
+	}).(copyNode)
+	assert.Nil(t, dst.secret)
+	assert.Equal(t, src.Created, dst.Created)
+
+	dst = structs.DeepCopy(src, func(opt *structs.CopyOptions) {
+		opt.Unexported = structs.UnexportedDeep
+	}).(copyNode)
+	dst.secret[0] = 10
+	assert.Equal(t, []int{10, 2}, dst.secret)
+	assert.Equal(t, []int{1, 2}, src.secret)
+}
+
+func TestCopyTo(t *testing.T) {
+	src := &copyNode{Name: "a", Tags: []string{"a"}}
+
+	var dst copyNode
+	assert.NoError(t, structs.CopyTo(src, &dst))
+	assert.Equal(t, "a", dst.Name)
+	dst.Tags[0] = "b"
+	assert.Equal(t, "a", src.Tags[0])
+
+	var dst2 copyNode
+	assert.NoError(t, structs.CopyTo(*src, &dst2))
+	assert.Equal(t, "a", dst2.Name)
+
+	assert.NoError(t, structs.CopyTo(nil, &dst2))
+	assert.Equal(t, "", dst2.Name)
+
+	assert.Error(t, structs.CopyTo(src, dst))
+	assert.Error(t, structs.CopyTo("abc", &dst))
+	assert.Error(t, structs.CopyTo((*copyNode)(nil), &dst))
+}
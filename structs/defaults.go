@@ -0,0 +1,166 @@
+package structs
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+
+	"github.com/gookit/goutil/internal/comfunc"
+	"github.com/gookit/goutil/maputil"
+)
+
+// DefaultsOptions for init default values
+type DefaultsOptions struct {
+	// TagName for get the default value. default is "default"
+	TagName string
+}
+
+// DefaultsOptFunc define
+type DefaultsOptFunc func(opt *DefaultsOptions)
+
+// InitDefaults init the default values of struct fields by the tag `default:"..."`.
+// only the zero value fields will be set.
+//
+// - support the nested struct and pointer fields. the nil pointer to struct will be created on it has default values.
+// - slice values are split by comma. eg: `default:"a,b"`, also allow JSON array or object. eg: `default:"{\"a\": 1}"`
+// - time.Duration values is like "3s", "1m30s"
+//
+// Usage:
+// 	type Config struct {
+// 		Host    string        `default:"localhost"`
+// 		Port    int           `default:"8080"`
+// 		Timeout time.Duration `default:"3s"`
+// 		Tags    []string      `default:"a,b"`
+// 	}
+//
+// 	conf := &Config{}
+// 	err := structs.InitDefaults(conf)
+func InitDefaults(ptr interface{}, optFns ...DefaultsOptFunc) error {
+	rv := reflect.ValueOf(ptr)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return errors.New("structs: InitDefaults the ptr must be a non-nil pointer to struct")
+	}
+
+	opt := &DefaultsOptions{TagName: "default"}
+	for _, fn := range optFns {
+		fn(opt)
+	}
+
+	_, err := initDefaults("", rv.Elem(), opt)
+	return err
+}
+
+// initDefaults returns true on has field is set.
+func initDefaults(prefix string, sv reflect.Value, opt *DefaultsOptions) (bool, error) {
+	var changed bool
+
+	st := sv.Type()
+	for i := 0; i < st.NumField(); i++ {
+		sf := st.Field(i)
+		fv := sv.Field(i)
+		if !fv.CanSet() {
+			// the exported fields of the unexported embedded struct can be set.
+			if sf.Anonymous && fv.Kind() == reflect.Struct {
+				ok, err := initDefaults(prefix, fv, opt)
+				if err != nil {
+					return false, err
+				}
+				changed = changed || ok
+			}
+			continue
+		}
+
+		path := sf.Name
+		if prefix != "" {
+			path = prefix + "." + sf.Name
+		}
+		// the default value can contain comma, so use the raw value.
+		if tv, hasTag := comfunc.LookupTag(sf, opt.TagName); hasTag {
+			if !fv.IsZero() {
+				continue
+			}
+
+			if err := maputil.SetReflectValue(fv, parseDefault(tv.Raw, fv.Type())); err != nil {
+				if _, ok := err.(*FieldError); ok {
+					return false, err
+				}
+				return false, &FieldError{Path: path, Err: err}
+			}
+			changed = true
+			continue
+		}
+
+		// init the nested struct
+		switch {
+		case fv.Kind() == reflect.Struct:
+			ok, err := initDefaults(path, fv, opt)
+			if err != nil {
+				return false, err
+			}
+			changed = changed || ok
+		case fv.Kind() == reflect.Ptr && fv.Type().Elem().Kind() == reflect.Struct:
+			if !fv.IsNil() {
+				ok, err := initDefaults(path, fv.Elem(), opt)
+				if err != nil {
+					return false, err
+				}
+				changed = changed || ok
+				continue
+			}
+
+			// create the pointer value on it has default values
+			nv := reflect.New(fv.Type().Elem())
+			ok, err := initDefaults(path, nv.Elem(), opt)
+			if err != nil {
+				return false, err
+			}
+
+			if ok {
+				fv.Set(nv)
+				changed = true
+			}
+		}
+	}
+	return changed, nil
+}
+
+// parseDefault parse the default value string for the slice, map type.
+func parseDefault(defVal string, typ reflect.Type) interface{} {
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+
+	switch typ.Kind() {
+	case reflect.Slice:
+		// []byte is keep the string value
+		if typ.Elem().Kind() == reflect.Uint8 {
+			return defVal
+		}
+
+		if strings.HasPrefix(defVal, "[") {
+			var list []interface{}
+			if err := json.Unmarshal([]byte(defVal), &list); err == nil {
+				return list
+			}
+		}
+
+		if defVal == "" {
+			return []string{}
+		}
+
+		ss := strings.Split(defVal, ",")
+		for i, s := range ss {
+			ss[i] = strings.TrimSpace(s)
+		}
+		return ss
+	case reflect.Map, reflect.Struct:
+		if strings.HasPrefix(defVal, "{") {
+			var mp map[string]interface{}
+			if err := json.Unmarshal([]byte(defVal), &mp); err == nil {
+				return mp
+			}
+		}
+	}
+	return defVal
+}
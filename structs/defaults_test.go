@@ -0,0 +1,74 @@
+package structs_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gookit/goutil/structs"
+	"github.com/stretchr/testify/assert"
+)
+
+type logConfig struct {
+	Level string `default:"info"`
+	File  string
+}
+
+type serverConfig struct {
+	Host    string         `default:"localhost"`
+	Port    int            `default:"8080"`
+	Debug   bool           `default:"true"`
+	Rate    *float64       `default:"0.5"`
+	Timeout time.Duration  `default:"3s"`
+	Tags    []string       `default:"a, b"`
+	Ports   []int          `default:"[80, 443]"`
+	Labels  map[string]int `default:"{\"a\": 1}"`
+	Start   time.Time      `default:"2022-08-01T10:00:00Z"`
+	Log     logConfig
+	LogPtr  *logConfig
+	noSet   string `default:"abc"`
+}
+
+func TestInitDefaults(t *testing.T) {
+	conf := &serverConfig{Port: 9090}
+	err := structs.InitDefaults(conf)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "localhost", conf.Host)
+	assert.Equal(t, 9090, conf.Port)
+	assert.True(t, conf.Debug)
+	assert.Equal(t, 0.5, *conf.Rate)
+	assert.Equal(t, 3*time.Second, conf.Timeout)
+	assert.Equal(t, []string{"a", "b"}, conf.Tags)
+	assert.Equal(t, []int{80, 443}, conf.Ports)
+	assert.Equal(t, map[string]int{"a": 1}, conf.Labels)
+	assert.Equal(t, 2022, conf.Start.Year())
+	assert.Equal(t, "info", conf.Log.Level)
+	assert.Equal(t, "info", conf.LogPtr.Level)
+	assert.Equal(t, "", conf.noSet)
+
+	type custom struct {
+		Name string `def:"inhere"`
+		Age  int    `def:"abc"`
+	}
+
+	c := &custom{}
+	err = structs.InitDefaults(c, func(opt *structs.DefaultsOptions) {
+		opt.TagName = "def"
+	})
+	assert.ErrorContains(t, err, `"Age"`)
+	assert.Equal(t, "inhere", c.Name)
+
+	assert.Error(t, structs.InitDefaults(*c))
+}
+
+func TestInitDefaults_unexportedEmbedded(t *testing.T) {
+	type appConf struct {
+		logConfig
+		Name string `default:"app"`
+	}
+
+	conf := &appConf{}
+	assert.NoError(t, structs.InitDefaults(conf))
+	assert.Equal(t, "app", conf.Name)
+	assert.Equal(t, "info", conf.Level)
+}
@@ -0,0 +1,156 @@
+package structs
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/gookit/goutil/internal/comfunc"
+)
+
+// MergeStrategy for Merge. can be combined by "|". eg: MergeKeepExisting|MergeAppendSlice
+type MergeStrategy uint8
+
+// merge strategies
+const (
+	// MergeOverride the non-zero src field will override the dst field. it is default.
+	MergeOverride MergeStrategy = 0
+	// MergeKeepExisting keep the non-zero dst field, only set the zero fields.
+	MergeKeepExisting MergeStrategy = 1 << 0
+	// MergeAppendSlice append the src slice to the dst slice, instead of replace it.
+	MergeAppendSlice MergeStrategy = 1 << 1
+)
+
+// MergeOptions for merge structs
+type MergeOptions struct {
+	// Strategy for merge fields, default is MergeOverride
+	Strategy MergeStrategy
+	// TagName for set the field merge strategy, default is "merge".
+	//
+	// allow tag values:
+	// 	"-"        skip the field
+	// 	"override" use the MergeOverride strategy
+	// 	"keep"     use the MergeKeepExisting strategy
+	// 	"append"   append the slice field
+	TagName string
+}
+
+// MergeOptFunc define
+type MergeOptFunc func(opt *MergeOptions)
+
+// Merge the non-zero fields of src struct to the dst struct pointer.
+// the nested struct will be merged recursively, and the map keys will be merged.
+//
+// Usage:
+// 	// defaults <- file config <- env config
+// 	err := structs.Merge(conf, fileConf)
+// 	err = structs.Merge(conf, envConf)
+func Merge(dst, src interface{}, optFns ...MergeOptFunc) error {
+	dv := reflect.ValueOf(dst)
+	if dv.Kind() != reflect.Ptr || dv.IsNil() || dv.Elem().Kind() != reflect.Struct {
+		return errors.New("structs: Merge the dst must be a non-nil pointer to struct")
+	}
+
+	sv := reflect.ValueOf(src)
+	if sv.Kind() == reflect.Ptr {
+		if sv.IsNil() {
+			return nil
+		}
+		sv = sv.Elem()
+	}
+
+	dv = dv.Elem()
+	if sv.Type() != dv.Type() {
+		return fmt.Errorf("structs: Merge cannot merge %s value to %s", sv.Type(), dv.Type())
+	}
+
+	opt := &MergeOptions{TagName: "merge"}
+	for _, fn := range optFns {
+		fn(opt)
+	}
+
+	m := &merger{opt: opt, cp: newCopier(nil)}
+	m.mergeStruct(dv, sv, opt.Strategy)
+	return nil
+}
+
+type merger struct {
+	opt *MergeOptions
+	cp  *copier
+}
+
+func (m *merger) mergeStruct(dv, sv reflect.Value, strategy MergeStrategy) {
+	st := dv.Type()
+	for i := 0; i < st.NumField(); i++ {
+		sf := st.Field(i)
+		fv := dv.Field(i)
+		if !fv.CanSet() {
+			// the exported fields of the unexported embedded struct can be set.
+			if sf.Anonymous && fv.Kind() == reflect.Struct {
+				m.mergeStruct(fv, sv.Field(i), strategy)
+			}
+			continue
+		}
+
+		fs := strategy
+		tv, _ := comfunc.LookupTag(sf, m.opt.TagName)
+		switch tv.Name {
+		case "-":
+			continue
+		case "override":
+			fs &^= MergeKeepExisting
+		case "keep":
+			fs |= MergeKeepExisting
+		case "append":
+			fs |= MergeAppendSlice
+		}
+
+		m.mergeValue(fv, sv.Field(i), fs)
+	}
+}
+
+func (m *merger) mergeValue(dv, sv reflect.Value, strategy MergeStrategy) {
+	if sv.IsZero() {
+		return
+	}
+
+	// set the src value on the dst is zero
+	if dv.IsZero() {
+		dv.Set(m.cp.copy(sv))
+		return
+	}
+
+	switch dv.Kind() {
+	case reflect.Struct:
+		if dv.Type() != timeType {
+			m.mergeStruct(dv, sv, strategy)
+			return
+		}
+	case reflect.Ptr:
+		if dv.Elem().Kind() == reflect.Struct && dv.Type().Elem() != timeType {
+			m.mergeStruct(dv.Elem(), sv.Elem(), strategy)
+			return
+		}
+	case reflect.Map:
+		iter := sv.MapRange()
+		for iter.Next() {
+			key := iter.Key()
+			if strategy&MergeKeepExisting != 0 {
+				if ev := dv.MapIndex(key); ev.IsValid() && !ev.IsZero() {
+					continue
+				}
+			}
+			dv.SetMapIndex(m.cp.copy(key), m.cp.copy(iter.Value()))
+		}
+		return
+	case reflect.Slice:
+		if strategy&MergeAppendSlice != 0 {
+			dv.Set(reflect.AppendSlice(dv, m.cp.copy(sv)))
+			return
+		}
+	}
+
+	if strategy&MergeKeepExisting == 0 {
+		dv.Set(m.cp.copy(sv))
+	}
+}
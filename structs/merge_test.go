@@ -0,0 +1,105 @@
+package structs_test
+
+import (
+	"testing"
+
+	"github.com/gookit/goutil/structs"
+	"github.com/stretchr/testify/assert"
+)
+
+type mergeDB struct {
+	Host string
+	Port int
+}
+
+type mergeBase struct {
+	ID int
+}
+
+type mergeConfig struct {
+	mergeBase
+	Name    string
+	Debug   bool
+	Tags    []string
+	Plugins []string `merge:"append"`
+	Version string   `merge:"keep"`
+	Secret  string   `merge:"-"`
+	DB      mergeDB
+	Cache   *mergeDB
+	Labels  map[string]string
+}
+
+func TestMerge(t *testing.T) {
+	dst := &mergeConfig{
+		mergeBase: mergeBase{ID: 1},
+		Name:      "app",
+		Tags:      []string{"a"},
+		Plugins:   []string{"p1"},
+		Version:   "v1",
+		DB:        mergeDB{Host: "localhost", Port: 3306},
+		Labels:    map[string]string{"a": "1", "b": "2"},
+	}
+	src := mergeConfig{
+		mergeBase: mergeBase{ID: 2},
+		Debug:     true,
+		Tags:      []string{"b"},
+		Plugins:   []string{"p2"},
+		Version:   "v2",
+		Secret:    "abc",
+		DB:        mergeDB{Port: 3307},
+		Cache:     &mergeDB{Host: "redis"},
+		Labels:    map[string]string{"b": "3", "c": "4"},
+	}
+
+	assert.NoError(t, structs.Merge(dst, src))
+	assert.Equal(t, 2, dst.ID)
+	assert.Equal(t, "app", dst.Name)
+	assert.True(t, dst.Debug)
+	assert.Equal(t, []string{"b"}, dst.Tags)
+	assert.Equal(t, []string{"p1", "p2"}, dst.Plugins)
+	assert.Equal(t, "v1", dst.Version)
+	assert.Equal(t, "", dst.Secret)
+	assert.Equal(t, mergeDB{Host: "localhost", Port: 3307}, dst.DB)
+	assert.Equal(t, "redis", dst.Cache.Host)
+	assert.Equal(t, map[string]string{"a": "1", "b": "3", "c": "4"}, dst.Labels)
+
+	// the values are copied
+	src.Cache.Host = "new"
+	src.Tags[0] = "x"
+	assert.Equal(t, "redis", dst.Cache.Host)
+	assert.Equal(t, "b", dst.Tags[0])
+
+	// nested pointer struct
+	assert.NoError(t, structs.Merge(dst, &mergeConfig{Cache: &mergeDB{Port: 6379}}))
+	assert.Equal(t, mergeDB{Host: "redis", Port: 6379}, *dst.Cache)
+
+	assert.NoError(t, structs.Merge(dst, (*mergeConfig)(nil)))
+	assert.Error(t, structs.Merge(*dst, src))
+	assert.Error(t, structs.Merge(dst, mergeDB{}))
+}
+
+func TestMerge_keepExisting(t *testing.T) {
+	dst := &mergeConfig{
+		Name:   "app",
+		Tags:   []string{"a"},
+		DB:     mergeDB{Host: "localhost"},
+		Labels: map[string]string{"a": "1"},
+	}
+	src := &mergeConfig{
+		Name:   "new",
+		Debug:  true,
+		Tags:   []string{"b"},
+		DB:     mergeDB{Host: "remote", Port: 3306},
+		Labels: map[string]string{"a": "2", "b": "3"},
+	}
+
+	err := structs.Merge(dst, src, func(opt *structs.MergeOptions) {
+		opt.Strategy = structs.MergeKeepExisting | structs.MergeAppendSlice
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "app", dst.Name)
+	assert.True(t, dst.Debug)
+	assert.Equal(t, []string{"a", "b"}, dst.Tags)
+	assert.Equal(t, mergeDB{Host: "localhost", Port: 3306}, dst.DB)
+	assert.Equal(t, map[string]string{"a": "1", "b": "3"}, dst.Labels)
+}
@@ -0,0 +1,52 @@
+package structs
+
+import (
+	"reflect"
+
+	"github.com/gookit/goutil/internal/comfunc"
+)
+
+// getStructMeta get the cached metadata of the struct type.
+// the cache is shared with the maputil.ToStructWith(), which is used by BindMap().
+func getStructMeta(t reflect.Type, tagNames []string) *comfunc.StructMeta {
+	return comfunc.GetStructMeta(t, tagNames)
+}
+
+// Preheat parse and cache the metadata of the struct types, for avoid the reflection cost on first use.
+// the nested struct types will be preheated too.
+//
+// Usage:
+// 	func init() {
+// 		structs.Preheat(Config{}, &User{})
+// 	}
+func Preheat(types ...interface{}) {
+	visited := make(map[reflect.Type]bool)
+	for _, v := range types {
+		if v != nil {
+			preheatType(reflect.TypeOf(v), visited)
+		}
+	}
+}
+
+func preheatType(t reflect.Type, visited map[reflect.Type]bool) {
+	for {
+		switch t.Kind() {
+		case reflect.Ptr, reflect.Slice, reflect.Array, reflect.Map:
+			t = t.Elem()
+			continue
+		}
+		break
+	}
+
+	if t.Kind() != reflect.Struct || visited[t] {
+		return
+	}
+
+	visited[t] = true
+	sm := getStructMeta(t, DefaultTagNames)
+	_, _ = ParseTypeTags(t)
+
+	for _, fm := range sm.Fields {
+		preheatType(fm.Type, visited)
+	}
+}
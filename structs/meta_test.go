@@ -0,0 +1,19 @@
+package structs_test
+
+import (
+	"testing"
+
+	"github.com/gookit/goutil/structs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPreheat(t *testing.T) {
+	structs.Preheat(&pod{}, []appConfig{}, map[string]*testUser{}, nil, "abc")
+
+	p := &pod{}
+	assert.NoError(t, structs.SetValue(p, "Spec.Containers.0.name", "nginx"))
+	assert.Equal(t, "nginx", p.Spec.Containers[0].Name)
+
+	mp := structs.ToMap(p.Spec, structs.WithTagNames(), structs.WithRecursive())
+	assert.Equal(t, []interface{}{map[string]interface{}{"name": "nginx", "Ports": []int(nil)}}, mp["containers"])
+}
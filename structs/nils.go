@@ -0,0 +1,115 @@
+package structs
+
+import (
+	"errors"
+	"reflect"
+
+	"github.com/gookit/goutil/internal/comfunc"
+)
+
+// InitNilsOptions for init the nil fields
+type InitNilsOptions struct {
+	// TagName for control the field init, default is "init".
+	//
+	// allow tag values:
+	// 	"-"    skip the field
+	// 	"true" force init the nil field. eg: the slice field on Slices is false
+	TagName string
+	// Maps init the nil map fields, default is true
+	Maps bool
+	// Slices init the nil slice fields to empty slice, default is false
+	Slices bool
+}
+
+// InitNilsOptFunc define
+type InitNilsOptFunc func(opt *InitNilsOptions)
+
+// InitNils walk the struct and create the nil pointer to struct fields and the nil maps.
+// the nil slices will be created on the option Slices is true, or the field has tag `init:"true"`.
+//
+// NOTE: the self-referenced pointer fields will not be created. eg: Next *Node in the Node struct
+//
+// Usage:
+// 	conf := &Config{}
+// 	err := structs.InitNils(conf)
+// 	conf.DB.Host = "localhost" // DB is *DBConfig, will not panic
+func InitNils(ptr interface{}, optFns ...InitNilsOptFunc) error {
+	rv := reflect.ValueOf(ptr)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return errors.New("structs: InitNils the ptr must be a non-nil pointer to struct")
+	}
+
+	opt := &InitNilsOptions{TagName: "init", Maps: true}
+	for _, fn := range optFns {
+		fn(opt)
+	}
+
+	in := &nilsIniter{opt: opt, path: map[reflect.Type]bool{}}
+	in.initStruct(rv.Elem())
+	return nil
+}
+
+type nilsIniter struct {
+	opt *InitNilsOptions
+	// the struct types on the current walking path, for avoid infinite init.
+	path map[reflect.Type]bool
+}
+
+func (in *nilsIniter) initStruct(sv reflect.Value) {
+	st := sv.Type()
+	in.path[st] = true
+	defer delete(in.path, st)
+
+	for i := 0; i < st.NumField(); i++ {
+		fv := sv.Field(i)
+		if !fv.CanSet() {
+			continue
+		}
+
+		tv, _ := comfunc.LookupTag(st.Field(i), in.opt.TagName)
+		if tv.Name == "-" {
+			continue
+		}
+		in.initValue(fv, tv.Name == "true")
+	}
+}
+
+func (in *nilsIniter) initValue(fv reflect.Value, force bool) {
+	switch fv.Kind() {
+	case reflect.Struct:
+		if fv.Type() != timeType {
+			in.initStruct(fv)
+		}
+	case reflect.Ptr:
+		if !fv.IsNil() {
+			if fv.Elem().Kind() == reflect.Struct && !in.path[fv.Type().Elem()] {
+				in.initStruct(fv.Elem())
+			}
+			return
+		}
+
+		elemTyp := fv.Type().Elem()
+		if elemTyp.Kind() == reflect.Struct && !in.path[elemTyp] {
+			fv.Set(reflect.New(elemTyp))
+			in.initStruct(fv.Elem())
+		}
+	case reflect.Map:
+		if fv.IsNil() && (in.opt.Maps || force) {
+			fv.Set(reflect.MakeMap(fv.Type()))
+		}
+	case reflect.Slice:
+		if fv.IsNil() {
+			if in.opt.Slices || force {
+				fv.Set(reflect.MakeSlice(fv.Type(), 0, 0))
+			}
+			return
+		}
+
+		// init the struct elements
+		for i := 0; i < fv.Len(); i++ {
+			if ev := fv.Index(i); ev.Kind() == reflect.Struct || ev.Kind() == reflect.Ptr && !ev.IsNil() {
+				in.initValue(ev, false)
+			}
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package structs_test
+
+import (
+	"testing"
+
+	"github.com/gookit/goutil/structs"
+	"github.com/stretchr/testify/assert"
+)
+
+type nilsNode struct {
+	Name string
+	Next *nilsNode
+}
+
+type nilsDB struct {
+	Host    string
+	Options map[string]string
+	Node    *nilsNode
+}
+
+type nilsConfig struct {
+	DB      *nilsDB
+	Cache   nilsDB
+	Skip    *nilsDB `init:"-"`
+	Tags    []string
+	Ports   []int `init:"true"`
+	Nodes   []*nilsNode
+	Labels  map[string]int
+	private *nilsDB
+}
+
+func TestInitNils(t *testing.T) {
+	conf := &nilsConfig{Nodes: []*nilsNode{{Name: "a"}, nil}}
+	assert.NoError(t, structs.InitNils(conf))
+
+	assert.NotNil(t, conf.DB)
+	assert.NotNil(t, conf.DB.Options)
+	assert.NotNil(t, conf.DB.Node)
+	// self-referenced pointer is not created
+	assert.Nil(t, conf.DB.Node.Next)
+	assert.NotNil(t, conf.Cache.Options)
+	assert.Nil(t, conf.Skip)
+	assert.Nil(t, conf.Tags)
+	assert.Equal(t, []int{}, conf.Ports)
+	assert.Nil(t, conf.Nodes[1])
+	assert.NotNil(t, conf.Labels)
+	assert.Nil(t, conf.private)
+
+	conf = &nilsConfig{}
+	err := structs.InitNils(conf, func(opt *structs.InitNilsOptions) {
+		opt.Maps = false
+		opt.Slices = true
+	})
+	assert.NoError(t, err)
+	assert.Nil(t, conf.Labels)
+	assert.Nil(t, conf.DB.Options)
+	assert.Equal(t, []string{}, conf.Tags)
+
+	assert.Error(t, structs.InitNils(*conf))
+}
@@ -0,0 +1,200 @@
+package structs
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/gookit/goutil/maputil"
+)
+
+// PathSep the separator of the field path. eg: "Spec.Containers.0.Name"
+const PathSep = "."
+
+// GetValue get the value from struct by the field path. the path node can be
+// field name, tag name, slice index or map key.
+//
+// Usage:
+// 	val, err := structs.GetValue(pod, "Spec.Containers.0.Name")
+func GetValue(obj interface{}, path string) (interface{}, error) {
+	rv := reflect.ValueOf(obj)
+	if path == "" {
+		return obj, nil
+	}
+
+	for _, key := range strings.Split(path, PathSep) {
+		rv = indirectValue(rv)
+		if !rv.IsValid() {
+			return nil, fmt.Errorf("structs: cannot get %q from nil value", key)
+		}
+
+		switch rv.Kind() {
+		case reflect.Struct:
+			fv, ok := fieldByName(rv, key, false)
+			if !ok {
+				return nil, fmt.Errorf("structs: field %q not found in %s", key, rv.Type())
+			}
+			rv = fv
+		case reflect.Slice, reflect.Array:
+			idx, err := strconv.Atoi(key)
+			if err != nil || idx < 0 || idx >= rv.Len() {
+				return nil, fmt.Errorf("structs: invalid index %q of the %s(len: %d)", key, rv.Type(), rv.Len())
+			}
+			rv = rv.Index(idx)
+		case reflect.Map:
+			mk, err := mapKey(rv.Type(), key)
+			if err != nil {
+				return nil, err
+			}
+
+			if rv = rv.MapIndex(mk); !rv.IsValid() {
+				return nil, fmt.Errorf("structs: map key %q not found", key)
+			}
+		default:
+			return nil, fmt.Errorf("structs: cannot get %q from %s value", key, rv.Type())
+		}
+	}
+
+	if !rv.CanInterface() {
+		return nil, errors.New("structs: cannot get the unexported field value")
+	}
+	return rv.Interface(), nil
+}
+
+// SetValue set the value to struct by the field path. the val will be weak converted to the field type.
+// will create the nil pointers and maps on the path.
+//
+// Usage:
+// 	err := structs.SetValue(pod, "Spec.Containers.0.Name", "nginx")
+func SetValue(ptr interface{}, path string, val interface{}) error {
+	rv := reflect.ValueOf(ptr)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return errors.New("structs: SetValue the ptr must be a non-nil pointer")
+	}
+
+	if path == "" {
+		return errors.New("structs: the field path cannot be empty")
+	}
+
+	return setByKeys(rv.Elem(), strings.Split(path, PathSep), val)
+}
+
+// setByKeys set value to the settable node
+func setByKeys(node reflect.Value, keys []string, val interface{}) error {
+	if len(keys) == 0 {
+		return maputil.SetReflectValue(node, val)
+	}
+
+	key := keys[0]
+	switch node.Kind() {
+	case reflect.Ptr:
+		if node.IsNil() {
+			node.Set(reflect.New(node.Type().Elem()))
+		}
+		return setByKeys(node.Elem(), keys, val)
+	case reflect.Interface:
+		if node.IsNil() {
+			return fmt.Errorf("structs: cannot set %q on nil interface", key)
+		}
+
+		// copy the elem for make it settable, then set back.
+		elem := reflect.New(node.Elem().Type()).Elem()
+		elem.Set(node.Elem())
+		if err := setByKeys(elem, keys, val); err != nil {
+			return err
+		}
+		node.Set(elem)
+		return nil
+	case reflect.Struct:
+		fv, ok := fieldByName(node, key, true)
+		if !ok {
+			return fmt.Errorf("structs: field %q not found in %s", key, node.Type())
+		}
+
+		if !fv.CanSet() {
+			return fmt.Errorf("structs: cannot set the unexported field %q", key)
+		}
+		return setByKeys(fv, keys[1:], val)
+	case reflect.Slice, reflect.Array:
+		idx, err := strconv.Atoi(key)
+		if err != nil || idx < 0 || idx > node.Len() || idx == node.Len() && node.Kind() == reflect.Array {
+			return fmt.Errorf("structs: invalid index %q of the %s(len: %d)", key, node.Type(), node.Len())
+		}
+
+		// index == len will append new element
+		if idx == node.Len() {
+			node.Set(reflect.Append(node, reflect.Zero(node.Type().Elem())))
+		}
+		return setByKeys(node.Index(idx), keys[1:], val)
+	case reflect.Map:
+		mk, err := mapKey(node.Type(), key)
+		if err != nil {
+			return err
+		}
+
+		if node.IsNil() {
+			node.Set(reflect.MakeMap(node.Type()))
+		}
+
+		elem := reflect.New(node.Type().Elem()).Elem()
+		if ev := node.MapIndex(mk); ev.IsValid() {
+			elem.Set(ev)
+		}
+
+		if err := setByKeys(elem, keys[1:], val); err != nil {
+			return err
+		}
+		node.SetMapIndex(mk, elem)
+		return nil
+	}
+	return fmt.Errorf("structs: cannot set %q on %s value", key, node.Type())
+}
+
+func indirectValue(rv reflect.Value) reflect.Value {
+	for rv.IsValid() && (rv.Kind() == reflect.Ptr || rv.Kind() == reflect.Interface) {
+		if rv.IsNil() {
+			return reflect.Value{}
+		}
+		rv = rv.Elem()
+	}
+	return rv
+}
+
+// mapKey convert the key string to the map key type
+func mapKey(mapType reflect.Type, key string) (reflect.Value, error) {
+	mk := reflect.New(mapType.Key()).Elem()
+	if err := maputil.SetReflectValue(mk, key); err != nil {
+		return mk, fmt.Errorf("structs: invalid map key %q for %s", key, mapType)
+	}
+	return mk, nil
+}
+
+// fieldByName find the field by: field name, tag name, case-insensitive field name.
+// the result index will be cached in the struct metadata.
+func fieldByName(sv reflect.Value, name string, alloc bool) (reflect.Value, bool) {
+	idx := getStructMeta(sv.Type(), DefaultTagNames).FieldIndex(name)
+	if idx == nil {
+		return reflect.Value{}, false
+	}
+	return fieldByIndex(sv, idx, alloc)
+}
+
+// fieldByIndex like the reflect.Value.FieldByIndex, but not panic on the nil embedded pointer.
+// the nil embedded pointer will be created on alloc is true, otherwise returns false.
+func fieldByIndex(sv reflect.Value, index []int, alloc bool) (reflect.Value, bool) {
+	for i, x := range index {
+		if i > 0 && sv.Kind() == reflect.Ptr {
+			if sv.IsNil() {
+				if !alloc || !sv.CanSet() {
+					return reflect.Value{}, false
+				}
+				sv.Set(reflect.New(sv.Type().Elem()))
+			}
+			sv = sv.Elem()
+		}
+		sv = sv.Field(x)
+	}
+	return sv, true
+}
@@ -0,0 +1,102 @@
+package structs_test
+
+import (
+	"testing"
+
+	"github.com/gookit/goutil/structs"
+	"github.com/stretchr/testify/assert"
+)
+
+type PodMeta struct {
+	Labels map[string]string
+}
+
+type container struct {
+	Name  string `json:"name"`
+	Ports []int
+}
+
+type podSpec struct {
+	Containers []container `json:"containers"`
+	Replicas   *int
+}
+
+type pod struct {
+	*PodMeta
+	Spec  podSpec
+	Extra map[string]interface{}
+	Env   map[int]string
+	inner string
+}
+
+func TestGetValue(t *testing.T) {
+	p := &pod{
+		PodMeta: &PodMeta{Labels: map[string]string{"app": "web"}},
+		Spec:    podSpec{Containers: []container{{Name: "nginx", Ports: []int{80}}}},
+		Extra:   map[string]interface{}{"sub": map[string]interface{}{"key": "val"}},
+		Env:     map[int]string{1: "one"},
+	}
+
+	tests := map[string]interface{}{
+		"Spec.Containers.0.Name":    "nginx",
+		"spec.containers.0.name":    "nginx",
+		"Spec.Containers.0.Ports.0": 80,
+		"Labels.app":                "web",
+		"Extra.sub.key":             "val",
+		"Env.1":                     "one",
+	}
+	for path, want := range tests {
+		val, err := structs.GetValue(p, path)
+		assert.NoError(t, err, path)
+		assert.Equal(t, want, val, path)
+	}
+
+	val, err := structs.GetValue(p, "")
+	assert.NoError(t, err)
+	assert.Equal(t, p, val)
+
+	for _, path := range []string{"NotExist", "Spec.Containers.1", "Spec.Replicas.Num", "Labels.none", "Env.abc", "inner", "Spec.Containers.0.Name.sub"} {
+		_, err = structs.GetValue(p, path)
+		assert.Error(t, err, path)
+	}
+}
+
+func TestGetValue_nilEmbedded(t *testing.T) {
+	p := &pod{}
+
+	_, err := structs.GetValue(p, "Labels")
+	assert.Error(t, err)
+	assert.Nil(t, p.PodMeta)
+
+	// SetValue will create the nil embedded pointer
+	assert.NoError(t, structs.SetValue(p, "Labels.app", "web"))
+	assert.NotNil(t, p.PodMeta)
+
+	val, err := structs.GetValue(p, "Labels.app")
+	assert.NoError(t, err)
+	assert.Equal(t, "web", val)
+}
+
+func TestSetValue(t *testing.T) {
+	p := &pod{Extra: map[string]interface{}{"sub": map[string]interface{}{}}}
+
+	assert.NoError(t, structs.SetValue(p, "Spec.Containers.0.Name", "nginx"))
+	assert.NoError(t, structs.SetValue(p, "Spec.Containers.0.Ports.0", "8080"))
+	assert.NoError(t, structs.SetValue(p, "Spec.Replicas", 3))
+	assert.NoError(t, structs.SetValue(p, "Labels.app", "web"))
+	assert.NoError(t, structs.SetValue(p, "Extra.sub.key", "val"))
+	assert.NoError(t, structs.SetValue(p, "Env.2", "two"))
+
+	assert.Equal(t, "nginx", p.Spec.Containers[0].Name)
+	assert.Equal(t, []int{8080}, p.Spec.Containers[0].Ports)
+	assert.Equal(t, 3, *p.Spec.Replicas)
+	assert.Equal(t, "web", p.Labels["app"])
+	assert.Equal(t, "val", p.Extra["sub"].(map[string]interface{})["key"])
+	assert.Equal(t, "two", p.Env[2])
+
+	assert.Error(t, structs.SetValue(p, "Spec.Replicas", "abc"))
+	assert.Error(t, structs.SetValue(p, "Spec.Containers.3.Name", "abc"))
+	assert.Error(t, structs.SetValue(p, "inner", "abc"))
+	assert.Error(t, structs.SetValue(p, "", "abc"))
+	assert.Error(t, structs.SetValue(*p, "Spec", "abc"))
+}
@@ -0,0 +1,38 @@
+package structs
+
+// PickFields convert the struct to map, only contains the given fields.
+// the field can be the field name or the key name(tag name).
+// will use the DefaultTagNames and convert recursively, see WithRecursive().
+//
+// Usage:
+// 	mp := structs.PickFields(user, "Name", "Email")
+func PickFields(st interface{}, fields ...string) map[string]interface{} {
+	set := makeFieldSet(fields)
+	mp, _ := toMap(st, pickOptFns, func(fieldName, key string) bool {
+		return set[fieldName] || set[key]
+	})
+	return mp
+}
+
+// OmitFields convert the struct to map, exclude the given fields.
+// the field can be the field name or the key name(tag name). other options same as PickFields().
+//
+// Usage:
+// 	mp := structs.OmitFields(user, "Password")
+func OmitFields(st interface{}, fields ...string) map[string]interface{} {
+	set := makeFieldSet(fields)
+	mp, _ := toMap(st, pickOptFns, func(fieldName, key string) bool {
+		return !set[fieldName] && !set[key]
+	})
+	return mp
+}
+
+var pickOptFns = []MapOptFunc{WithTagNames(), WithRecursive()}
+
+func makeFieldSet(fields []string) map[string]bool {
+	set := make(map[string]bool, len(fields))
+	for _, field := range fields {
+		set[field] = true
+	}
+	return set
+}
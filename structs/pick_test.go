@@ -0,0 +1,44 @@
+package structs_test
+
+import (
+	"testing"
+
+	"github.com/gookit/goutil/structs"
+	"github.com/stretchr/testify/assert"
+)
+
+type pickUser struct {
+	BaseModel
+	Name     string `json:"name"`
+	Email    string `json:"email"`
+	Password string `json:"password"`
+	Addr     address
+}
+
+func TestPickFields(t *testing.T) {
+	u := &pickUser{
+		BaseModel: BaseModel{ID: 1},
+		Name:      "inhere",
+		Email:     "in@example.com",
+		Password:  "secret",
+		Addr:      address{City: "chengdu"},
+	}
+
+	mp := structs.PickFields(u, "ID", "Name", "email", "Addr")
+	assert.Equal(t, map[string]interface{}{
+		"ID":    1,
+		"name":  "inhere",
+		"email": "in@example.com",
+		"Addr":  map[string]interface{}{"city": "chengdu", "ZIPCode": ""},
+	}, mp)
+
+	assert.Empty(t, structs.PickFields(u))
+	assert.Empty(t, structs.PickFields("abc", "Name"))
+
+	mp = structs.OmitFields(u, "Password", "created_at", "Addr")
+	assert.Equal(t, map[string]interface{}{
+		"ID":    1,
+		"name":  "inhere",
+		"email": "in@example.com",
+	}, mp)
+}
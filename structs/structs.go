@@ -1,21 +1,15 @@
 package structs
 
-import "github.com/gookit/goutil/internal/comfunc"
-
-// ToMap simple convert structs to map by reflect
-func ToMap(st interface{}) map[string]interface{} {
-	mp, _ := comfunc.TryStructToMap(st)
+// ToMap convert struct to map by reflect, see TryToMap().
+// will return empty map on the st is not a struct.
+func ToMap(st interface{}, optFns ...MapOptFunc) map[string]interface{} {
+	mp, _ := TryToMap(st, optFns...)
 	return mp
 }
 
-// TryToMap simple convert structs to map by reflect
-func TryToMap(st interface{}) (map[string]interface{}, error) {
-	return comfunc.TryStructToMap(st)
-}
-
 // MustToMap alis of TryToMap, but will panic on error
-func MustToMap(st interface{}) map[string]interface{} {
-	mp, err := comfunc.TryStructToMap(st)
+func MustToMap(st interface{}, optFns ...MapOptFunc) map[string]interface{} {
+	mp, err := TryToMap(st, optFns...)
 	if err != nil {
 		panic(err)
 	}
@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"sync"
 
+	"github.com/gookit/goutil/internal/comfunc"
 	"github.com/gookit/goutil/maputil"
 	"github.com/gookit/goutil/strutil"
 )
@@ -19,50 +21,147 @@ type TagParser struct {
 	Func func(tagVal string) map[string]string
 }
 
-// ParseTags TODO for parse struct tags.
-func ParseTags(v interface{}) error {
+// TagValue the parsed value of a tag. eg: `json:"name,omitempty"` => Name: "name", Options: ["omitempty"]
+//
+// it is shared by the goutil packages for parse tags, see ParseTagValueDefault()
+type TagValue = comfunc.TagValue
+
+// ParseTagValueDefault parse the tag value by the default format "name,opt1,opt2"
+func ParseTagValueDefault(raw string) TagValue {
+	return comfunc.ParseTagValue(raw)
+}
+
+// FieldTags the all tags of a struct field
+type FieldTags struct {
+	// Name the field name
+	Name  string
+	Index int
+	// Exported the field is exported
+	Exported bool
+	// Tags map. key is tag name, eg: "json"
+	Tags map[string]TagValue
+	// TagNames keep the order of tags in the struct tag
+	TagNames []string
+}
+
+// Get the tag value by tag name
+func (ft *FieldTags) Get(tagName string) (TagValue, bool) {
+	tv, ok := ft.Tags[tagName]
+	return tv, ok
+}
+
+// Has check the field has the tag
+func (ft *FieldTags) Has(tagName string) bool {
+	_, ok := ft.Tags[tagName]
+	return ok
+}
+
+// StructTags the parsed tags of all fields in a struct
+type StructTags struct {
+	Type   reflect.Type
+	Fields []*FieldTags
+	// field name => index of Fields
+	nameIndex map[string]int
+}
+
+// Field get the field tags by field name, returns nil on not found.
+func (st *StructTags) Field(name string) *FieldTags {
+	if idx, ok := st.nameIndex[name]; ok {
+		return st.Fields[idx]
+	}
+	return nil
+}
+
+// TagMap get the tag values of each field by tag name. returns map: field name => tag value
+//
+// Usage:
+// 	st, _ := structs.ParseTags(&User{})
+// 	descMap := st.TagMap("desc")
+func (st *StructTags) TagMap(tagName string) maputil.SMap {
+	mp := make(maputil.SMap)
+	for _, ft := range st.Fields {
+		if tv, ok := ft.Tags[tagName]; ok {
+			mp[ft.Name] = tv.Raw
+		}
+	}
+	return mp
+}
+
+// cache the parsed struct tags. key is reflect.Type
+var structTagsCache sync.Map
+
+// ParseTags parse all tags of the struct fields, the result will be cached by struct type.
+//
+// Usage:
+// 	st, err := structs.ParseTags(&User{})
+// 	tv, ok := st.Field("Name").Get("json")
+func ParseTags(v interface{}) (*StructTags, error) {
 	rv := reflect.ValueOf(v)
-	return ParseReflectTags(rv)
+	if !rv.IsValid() {
+		return nil, errNotAnStruct
+	}
+	return ParseTypeTags(rv.Type())
+}
+
+// ParseReflectTags parse all tags of the struct fields by reflect.Value. see ParseTags()
+func ParseReflectTags(v reflect.Value) (*StructTags, error) {
+	return ParseTypeTags(v.Type())
 }
 
-// ParseReflectTags value
-func ParseReflectTags(v reflect.Value) error {
-	if v.Kind() == reflect.Ptr && !v.IsNil() {
-		v = v.Elem()
+// ParseTypeTags parse all tags of the struct fields by reflect.Type. see ParseTags()
+func ParseTypeTags(t reflect.Type) (*StructTags, error) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
 	}
 
-	t := v.Type()
 	if t.Kind() != reflect.Struct {
-		return errNotAnStruct
+		return nil, errNotAnStruct
+	}
+
+	if st, ok := structTagsCache.Load(t); ok {
+		return st.(*StructTags), nil
+	}
+
+	st := &StructTags{
+		Type:      t,
+		Fields:    make([]*FieldTags, 0, t.NumField()),
+		nameIndex: make(map[string]int, t.NumField()),
 	}
 
-	tagName := "xxx"
 	for i := 0; i < t.NumField(); i++ {
 		sf := t.Field(i)
-		ft := t.Field(i).Type
-
-		// skip don't exported field
-		name := sf.Name
-		if name[0] >= 'a' && name[0] <= 'z' {
-			continue
+		ft := &FieldTags{
+			Name:     sf.Name,
+			Index:    i,
+			Exported: sf.PkgPath == "",
+			Tags:     make(map[string]TagValue),
 		}
 
-		// eg: "name=int0;shorts=i;required=true;desc=int option message"
-		str := sf.Tag.Get(tagName)
-		if str == "" {
-			continue
+		kvs, err := ParseStructTag(string(sf.Tag))
+		if err != nil {
+			return nil, fmt.Errorf("structs: parse tag error on field %q: %s", sf.Name, err.Error())
 		}
 
-		fv := v.Field(i)
-		if ft.Kind() == reflect.Ptr {
-			// isPtr = true
-			ft = ft.Elem()
-			// fv = fv.Elem()
+		for _, kv := range kvs {
+			ft.Tags[kv[0]] = ParseTagValueDefault(kv[1])
+			ft.TagNames = append(ft.TagNames, kv[0])
 		}
 
-		fmt.Println(fv.String())
+		st.nameIndex[sf.Name] = len(st.Fields)
+		st.Fields = append(st.Fields, ft)
 	}
-	return nil
+
+	structTagsCache.Store(t, st)
+	return st, nil
+}
+
+// ParseStructTag parse the struct tag string to key-value pairs, keep the order of tags.
+//
+// Usage:
+// 	kvs, err := structs.ParseStructTag(`json:"name,omitempty" default:"inhere"`)
+// 	// kvs: [["json", "name,omitempty"], ["default", "inhere"]]
+func ParseStructTag(tag string) ([][2]string, error) {
+	return comfunc.ParseStructTag(tag)
 }
 
 // ParseTagValue string.
@@ -0,0 +1,68 @@
+package structs_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gookit/goutil/structs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTags(t *testing.T) {
+	type user struct {
+		Name  string `json:"name,omitempty" desc:"user name" default:"inhere"`
+		Age   int    `json:"age"  desc:"user \"age\""`
+		email string
+	}
+
+	st, err := structs.ParseTags(&user{})
+	assert.NoError(t, err)
+	assert.Len(t, st.Fields, 3)
+
+	ft := st.Field("Name")
+	assert.Equal(t, []string{"json", "desc", "default"}, ft.TagNames)
+	assert.True(t, ft.Has("default"))
+
+	tv, ok := ft.Get("json")
+	assert.True(t, ok)
+	assert.Equal(t, "name", tv.Name)
+	assert.True(t, tv.HasOption("omitempty"))
+	assert.False(t, tv.HasOption("string"))
+
+	assert.Equal(t, "user name", st.TagMap("desc")["Name"])
+	assert.Equal(t, `user "age"`, st.TagMap("desc")["Age"])
+	assert.False(t, st.Field("email").Exported)
+	assert.Nil(t, st.Field("NotExist"))
+
+	// from cache
+	st2, err := structs.ParseTags(user{})
+	assert.NoError(t, err)
+	assert.True(t, st == st2)
+
+	_, err = structs.ParseTags("abc")
+	assert.Error(t, err)
+
+	invalid := reflect.StructOf([]reflect.StructField{
+		{Name: "Name", Type: reflect.TypeOf(""), Tag: `json:name`},
+	})
+	_, err = structs.ParseTypeTags(invalid)
+	assert.ErrorContains(t, err, `field "Name"`)
+}
+
+func TestParseStructTag(t *testing.T) {
+	kvs, err := structs.ParseStructTag(` json:"name,omitempty"  default:"a b" `)
+	assert.NoError(t, err)
+	assert.Equal(t, [][2]string{{"json", "name,omitempty"}, {"default", "a b"}}, kvs)
+
+	for _, tag := range []string{`json`, `json:"name`, `:"name"`, `json: "name"`} {
+		_, err = structs.ParseStructTag(tag)
+		assert.Error(t, err, tag)
+	}
+
+	tv := structs.ParseTagValueDefault("name, omitempty,,string")
+	assert.Equal(t, []string{"omitempty", "string"}, tv.Options)
+
+	tv = structs.ParseTagValueDefault("tag, t, the tags, can be repeated")
+	assert.Equal(t, []string{"tag", "t", "the tags, can be repeated"}, tv.Parts(3))
+	assert.Equal(t, []string{"name", ""}, structs.ParseTagValueDefault("name").Parts(2))
+}
@@ -0,0 +1,171 @@
+package strutil
+
+import "strings"
+
+// diff line types for the DiffLine.Type
+const (
+	DiffKeep   byte = ' '
+	DiffInsert byte = '+'
+	DiffDelete byte = '-'
+)
+
+// DiffLine a line of the diff result
+type DiffLine struct {
+	// Type of the line. allow: DiffKeep, DiffInsert, DiffDelete
+	Type byte
+	Text string
+}
+
+// String format line. eg: "+ new line"
+func (dl DiffLine) String() string {
+	return string(dl.Type) + " " + dl.Text
+}
+
+// DiffLines compare two texts by lines, based on the Myers' O(ND) diff algorithm.
+//
+// Usage:
+// 	for _, dl := range strutil.DiffLines(oldText, newText) {
+// 		fmt.Println(dl.String())
+// 	}
+func DiffLines(oldText, newText string) []DiffLine {
+	return DiffSlices(splitLines(oldText), splitLines(newText))
+}
+
+// DiffSlices compare two string slices, based on the Myers' O(ND) diff algorithm.
+// it is the linear space version, the memory usage is O(len(a)+len(b)).
+func DiffSlices(a, b []string) []DiffLine {
+	lines := make([]DiffLine, 0, len(a)+len(b))
+	return diffMyers(lines, a, b)
+}
+
+func diffMyers(lines []DiffLine, a, b []string) []DiffLine {
+	// trim the common prefix and suffix
+	var prefix int
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+
+	var suffix int
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	lines = appendDiffLines(lines, DiffKeep, a[:prefix])
+	ma, mb := a[prefix:len(a)-suffix], b[prefix:len(b)-suffix]
+
+	switch {
+	case len(ma) == 0:
+		lines = appendDiffLines(lines, DiffInsert, mb)
+	case len(mb) == 0:
+		lines = appendDiffLines(lines, DiffDelete, ma)
+	default:
+		if x, y := diffBisect(ma, mb); x < 0 {
+			lines = appendDiffLines(lines, DiffDelete, ma)
+			lines = appendDiffLines(lines, DiffInsert, mb)
+		} else {
+			lines = diffMyers(lines, ma[:x], mb[:y])
+			lines = diffMyers(lines, ma[x:], mb[y:])
+		}
+	}
+	return appendDiffLines(lines, DiffKeep, a[len(a)-suffix:])
+}
+
+// diffBisect find the middle snake of the shortest edit path, returns the split point of a and b.
+// returns -1 on a and b has not common lines.
+func diffBisect(a, b []string) (int, int) {
+	n, m := len(a), len(b)
+	maxD := (n + m + 1) / 2
+	offset, size := maxD, 2*maxD+2
+
+	// the furthest reaching x of the forward and reverse paths on each diagonal k
+	v1, v2 := make([]int, size), make([]int, size)
+	for i := range v1 {
+		v1[i], v2[i] = -1, -1
+	}
+	v1[offset+1], v2[offset+1] = 0, 0
+
+	delta := n - m
+	// the forward path will overlap with the reverse path on delta is odd
+	front := delta%2 != 0
+
+	var k1start, k1end, k2start, k2end int
+	for d := 0; d < maxD; d++ {
+		// walk the forward path one step
+		for k1 := -d + k1start; k1 <= d-k1end; k1 += 2 {
+			k1Off := offset + k1
+			var x1 int
+			if k1 == -d || k1 != d && v1[k1Off-1] < v1[k1Off+1] {
+				x1 = v1[k1Off+1]
+			} else {
+				x1 = v1[k1Off-1] + 1
+			}
+
+			y1 := x1 - k1
+			for x1 < n && y1 < m && a[x1] == b[y1] {
+				x1++
+				y1++
+			}
+			v1[k1Off] = x1
+
+			if x1 > n {
+				k1end += 2 // off the right of the graph
+			} else if y1 > m {
+				k1start += 2 // off the bottom of the graph
+			} else if front {
+				if k2Off := offset + delta - k1; k2Off >= 0 && k2Off < size && v2[k2Off] != -1 {
+					if x1 >= n-v2[k2Off] {
+						return x1, y1
+					}
+				}
+			}
+		}
+
+		// walk the reverse path one step
+		for k2 := -d + k2start; k2 <= d-k2end; k2 += 2 {
+			k2Off := offset + k2
+			var x2 int
+			if k2 == -d || k2 != d && v2[k2Off-1] < v2[k2Off+1] {
+				x2 = v2[k2Off+1]
+			} else {
+				x2 = v2[k2Off-1] + 1
+			}
+
+			y2 := x2 - k2
+			for x2 < n && y2 < m && a[n-x2-1] == b[m-y2-1] {
+				x2++
+				y2++
+			}
+			v2[k2Off] = x2
+
+			if x2 > n {
+				k2end += 2
+			} else if y2 > m {
+				k2start += 2
+			} else if !front {
+				if k1Off := offset + delta - k2; k1Off >= 0 && k1Off < size && v1[k1Off] != -1 {
+					x1 := v1[k1Off]
+					if x1 >= n-x2 {
+						return x1, offset + x1 - k1Off
+					}
+				}
+			}
+		}
+	}
+	return -1, -1
+}
+
+func appendDiffLines(lines []DiffLine, typ byte, ss []string) []DiffLine {
+	for _, s := range ss {
+		lines = append(lines, DiffLine{Type: typ, Text: s})
+	}
+	return lines
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+
+	s = strings.Replace(s, "\r\n", "\n", -1)
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
@@ -0,0 +1,71 @@
+package strutil_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/gookit/goutil/strutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffLines(t *testing.T) {
+	lines := strutil.DiffLines("a\nb\nc\nd\n", "a\nc\nx\nd\n")
+	var ss []string
+	for _, dl := range lines {
+		ss = append(ss, dl.String())
+	}
+	assert.Equal(t, []string{"  a", "- b", "  c", "+ x", "  d"}, ss)
+
+	lines = strutil.DiffLines("", "a\r\nb")
+	assert.Len(t, lines, 2)
+	assert.Equal(t, strutil.DiffInsert, lines[0].Type)
+	assert.Equal(t, "b", lines[1].Text)
+
+	lines = strutil.DiffLines("a\nb", "a\nb\n")
+	assert.Len(t, lines, 2)
+	assert.Equal(t, strutil.DiffKeep, lines[1].Type)
+
+	assert.Empty(t, strutil.DiffLines("", ""))
+}
+
+func TestDiffSlices(t *testing.T) {
+	lines := strutil.DiffSlices([]string{"x", "y"}, []string{"z"})
+	assert.Equal(t, []strutil.DiffLine{
+		{Type: strutil.DiffDelete, Text: "x"},
+		{Type: strutil.DiffDelete, Text: "y"},
+		{Type: strutil.DiffInsert, Text: "z"},
+	}, lines)
+}
+
+func TestDiffSlices_large(t *testing.T) {
+	a := make([]string, 50000)
+	b := make([]string, 0, 50000)
+	for i := range a {
+		a[i] = "line " + strconv.Itoa(i)
+		switch i % 1000 {
+		case 10: // delete
+		case 20: // update
+			b = append(b, "new line "+strconv.Itoa(i))
+		default:
+			b = append(b, a[i])
+		}
+	}
+
+	var olds, news []string
+	var changed int
+	for _, dl := range strutil.DiffSlices(a, b) {
+		if dl.Type != strutil.DiffInsert {
+			olds = append(olds, dl.Text)
+		}
+		if dl.Type != strutil.DiffDelete {
+			news = append(news, dl.Text)
+		}
+		if dl.Type != strutil.DiffKeep {
+			changed++
+		}
+	}
+
+	assert.Equal(t, a, olds)
+	assert.Equal(t, b, news)
+	assert.Equal(t, 150, changed)
+}
@@ -0,0 +1,79 @@
+package strutil
+
+import "unicode"
+
+// wide char ranges: CJK, Hangul, full-width forms, emoji and more.
+// refer from github.com/mattn/go-runewidth
+var wideTable = [][2]rune{
+	{0x1100, 0x115F},
+	{0x2E80, 0x303E},
+	{0x3041, 0x33FF},
+	{0x3400, 0x4DBF},
+	{0x4E00, 0x9FFF},
+	{0xA000, 0xA4CF},
+	{0xAC00, 0xD7A3},
+	{0xF900, 0xFAFF},
+	{0xFE30, 0xFE4F},
+	{0xFF00, 0xFF60},
+	{0xFFE0, 0xFFE6},
+	{0x1F300, 0x1F64F},
+	{0x1F900, 0x1F9FF},
+	{0x20000, 0x2FFFD},
+	{0x30000, 0x3FFFD},
+}
+
+// RuneWidth get the display width of the rune on terminal.
+//
+// returns: 0 for control and combining chars, 2 for wide chars(eg: CJK), others is 1
+func RuneWidth(r rune) int {
+	if r == 0 || unicode.IsControl(r) || unicode.Is(unicode.Mn, r) || (r >= 0x200B && r <= 0x200F) {
+		return 0
+	}
+
+	for _, rg := range wideTable {
+		if r < rg[0] {
+			break
+		}
+		if r <= rg[1] {
+			return 2
+		}
+	}
+	return 1
+}
+
+// TextWidth get the display width of the string on terminal.
+//
+// Usage:
+// 	strutil.TextWidth("abc") // 3
+// 	strutil.TextWidth("中文") // 4
+func TextWidth(s string) (width int) {
+	for _, r := range s {
+		width += RuneWidth(r)
+	}
+	return
+}
+
+// TruncateWidth truncate the string by display width, will append the suffix on truncated.
+// the width of the result(contains suffix) will not be greater than maxWidth.
+//
+// Usage:
+// 	strutil.TruncateWidth("hello world", 8, "...") // "hello..."
+func TruncateWidth(s string, maxWidth int, suffix string) string {
+	if TextWidth(s) <= maxWidth {
+		return s
+	}
+
+	limit := maxWidth - TextWidth(suffix)
+	if limit < 0 {
+		limit, suffix = maxWidth, ""
+	}
+
+	var width int
+	for i, r := range s {
+		if width+RuneWidth(r) > limit {
+			return s[:i] + suffix
+		}
+		width += RuneWidth(r)
+	}
+	return s
+}
@@ -0,0 +1,28 @@
+package strutil_test
+
+import (
+	"testing"
+
+	"github.com/gookit/goutil/strutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTextWidth(t *testing.T) {
+	assert.Equal(t, 1, strutil.RuneWidth('a'))
+	assert.Equal(t, 2, strutil.RuneWidth('中'))
+	assert.Equal(t, 2, strutil.RuneWidth('한'))
+	assert.Equal(t, 0, strutil.RuneWidth('\n'))
+	assert.Equal(t, 0, strutil.RuneWidth('́'))
+
+	assert.Equal(t, 3, strutil.TextWidth("abc"))
+	assert.Equal(t, 7, strutil.TextWidth("abc中文"))
+	assert.Equal(t, 4, strutil.TextWidth("ＡＢ"))
+}
+
+func TestTruncateWidth(t *testing.T) {
+	assert.Equal(t, "hello...", strutil.TruncateWidth("hello world", 8, "..."))
+	assert.Equal(t, "hello world", strutil.TruncateWidth("hello world", 11, "..."))
+	assert.Equal(t, "中文...", strutil.TruncateWidth("中文字符串", 8, "..."))
+	assert.Equal(t, "中...", strutil.TruncateWidth("中文字符串", 6, "..."))
+	assert.Equal(t, "he", strutil.TruncateWidth("hello", 2, "..."))
+}
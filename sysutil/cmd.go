@@ -0,0 +1,152 @@
+package sysutil
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"os/exec"
+	"sync"
+
+	"github.com/gookit/goutil/cliutil/cmdline"
+)
+
+// Cmd an simple command struct. it can be run multiple times,
+// every run will create a new exec.Cmd instance.
+//
+// Usage:
+// 	out, err := sysutil.NewCmd("git", "status").WithDir("/path/to/repo").Output()
+type Cmd struct {
+	// Name the bin name or path of the command.
+	Name string
+	// Args the command arguments, not contains the Name.
+	Args []string
+	// Dir the work dir for run the command.
+	Dir string
+	// Env append to the os.Environ() on run. eg: {"KEY=VALUE"}
+	Env []string
+}
+
+// NewCmd instance
+func NewCmd(name string, args ...string) *Cmd {
+	return &Cmd{Name: name, Args: args}
+}
+
+// NewCmdLine create a Cmd by parse the command line string. see cmdline.Split()
+//
+// Usage:
+// 	cmd, err := sysutil.NewCmdLine(`git commit -m "the message"`)
+func NewCmdLine(line string) (*Cmd, error) {
+	args, err := cmdline.Split(line)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(args) == 0 {
+		return nil, errors.New("sysutil: the command line is empty")
+	}
+	return NewCmd(args[0], args[1:]...), nil
+}
+
+// WithDir set the work dir
+func (c *Cmd) WithDir(dir string) *Cmd {
+	c.Dir = dir
+	return c
+}
+
+// AddArgs append args to the command
+func (c *Cmd) AddArgs(args ...string) *Cmd {
+	c.Args = append(c.Args, args...)
+	return c
+}
+
+// AddEnv add an ENV var for the command
+func (c *Cmd) AddEnv(key, val string) *Cmd {
+	c.Env = append(c.Env, key+"="+val)
+	return c
+}
+
+// ExecCmd create a new exec.Cmd instance by the Cmd config.
+func (c *Cmd) ExecCmd() *exec.Cmd {
+	cmd := exec.Command(c.Name, c.Args...)
+	cmd.Dir = c.Dir
+
+	if len(c.Env) > 0 {
+		cmd.Env = append(os.Environ(), c.Env...)
+	}
+	return cmd
+}
+
+// Output run the command and return stdout output.
+func (c *Cmd) Output() (string, error) {
+	out, _, err := c.Exec()
+	return out, err
+}
+
+// Exec run the command and return stdout, stderr output. will run by the current CmdRunnerFunc
+func (c *Cmd) Exec() (stdout, stderr string, err error) {
+	runnerMu.RLock()
+	runner := cmdRunner
+	runnerMu.RUnlock()
+
+	return runner(c)
+}
+
+// CmdRunnerFunc the func for run the Cmd, returns stdout, stderr output.
+type CmdRunnerFunc func(c *Cmd) (stdout, stderr string, err error)
+
+var (
+	runnerMu  sync.RWMutex
+	cmdRunner CmdRunnerFunc = RunCmd
+)
+
+// SetCmdRunner set the func for run the Cmd, returns the old runner. set nil for restore to the RunCmd.
+// it can be replaced for stub the command execution in tests.
+//
+// see testutil.RegisterCmdStub
+func SetCmdRunner(fn CmdRunnerFunc) CmdRunnerFunc {
+	if fn == nil {
+		fn = RunCmd
+	}
+
+	runnerMu.Lock()
+	defer runnerMu.Unlock()
+
+	old := cmdRunner
+	cmdRunner = fn
+	return old
+}
+
+// RunCmd run the Cmd by exec.Cmd, returns stdout, stderr output. it is the default CmdRunner
+func RunCmd(c *Cmd) (stdout, stderr string, err error) {
+	var outBuf, errBuf bytes.Buffer
+
+	cmd := c.ExecCmd()
+	cmd.Stdout = &outBuf
+	cmd.Stderr = &errBuf
+
+	err = cmd.Run()
+	// keep the stderr output on the error, same as exec.Cmd.Output()
+	if ee, ok := err.(*exec.ExitError); ok && len(ee.Stderr) == 0 {
+		ee.Stderr = errBuf.Bytes()
+	}
+	return outBuf.String(), errBuf.String(), err
+}
+
+// String get command line string
+func (c *Cmd) String() string {
+	return cmdline.LineBuild(c.Name, c.Args)
+}
+
+// ExitCode get exit code from the command run error.
+// returns 0 on err is nil, -1 on err is not an *exec.ExitError or has ExitCode() method.
+func ExitCode(err error) int {
+	if err == nil {
+		return 0
+	}
+
+	var ee interface{ ExitCode() int }
+	if errors.As(err, &ee) {
+		return ee.ExitCode()
+	}
+	return -1
+}
@@ -0,0 +1,67 @@
+package sysutil_test
+
+import (
+	"errors"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/gookit/goutil/sysutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCmd_Exec(t *testing.T) {
+	if sysutil.IsWindows() {
+		t.Skip("skip on windows")
+	}
+
+	cmd := sysutil.NewCmd("echo", "OK").AddArgs("abc")
+	assert.Equal(t, "echo OK abc", cmd.String())
+
+	out, err := cmd.Output()
+	assert.NoError(t, err)
+	assert.Equal(t, "OK abc", strings.TrimSpace(out))
+
+	// run again
+	out, err = cmd.Output()
+	assert.NoError(t, err)
+	assert.Equal(t, "OK abc", strings.TrimSpace(out))
+
+	cmd = sysutil.NewCmd("sh", "-c", "echo $SOME_KEY; echo err-msg >&2; exit 3")
+	cmd.AddEnv("SOME_KEY", "val1")
+	out, errOut, err := cmd.Exec()
+	assert.Error(t, err)
+	assert.Equal(t, 3, sysutil.ExitCode(err))
+	assert.Equal(t, "val1", strings.TrimSpace(out))
+	assert.Equal(t, "err-msg", strings.TrimSpace(errOut))
+
+	assert.Equal(t, 0, sysutil.ExitCode(nil))
+}
+
+func TestExecCmd_exitStderr(t *testing.T) {
+	if sysutil.IsWindows() {
+		t.Skip("skip on windows")
+	}
+
+	_, err := sysutil.ExecCmd("sh", []string{"-c", "echo boom >&2; exit 1"})
+	var ee *exec.ExitError
+	assert.True(t, errors.As(err, &ee))
+	assert.Equal(t, "boom\n", string(ee.Stderr))
+
+	_, err = sysutil.ShellExec("echo boom >&2; exit 2")
+	assert.True(t, errors.As(err, &ee))
+	assert.Equal(t, 2, ee.ExitCode())
+	assert.Equal(t, "boom\n", string(ee.Stderr))
+}
+
+func TestNewCmdLine(t *testing.T) {
+	cmd, err := sysutil.NewCmdLine(`git commit -m "the message"`)
+	assert.NoError(t, err)
+	assert.Equal(t, "git", cmd.Name)
+	assert.Equal(t, []string{"commit", "-m", "the message"}, cmd.Args)
+
+	_, err = sysutil.NewCmdLine(`git commit -m "the message`)
+	assert.Error(t, err)
+	_, err = sysutil.NewCmdLine("  ")
+	assert.Error(t, err)
+}
@@ -0,0 +1,100 @@
+package sysutil
+
+import (
+	"os"
+	"strings"
+)
+
+// EnvSnapshot get a snapshot of current process ENV vars.
+//
+// Usage:
+// 	snap := sysutil.EnvSnapshot()
+// 	defer sysutil.ApplySnapshot(snap)
+// 	// do something that will change ENV vars ...
+func EnvSnapshot() map[string]string {
+	return EnvListToMap(os.Environ())
+}
+
+// EnvListToMap convert ENV list(eg: os.Environ()) to map
+func EnvListToMap(envList []string) map[string]string {
+	mp := make(map[string]string, len(envList))
+	for _, line := range envList {
+		if line == "" {
+			continue
+		}
+
+		// on windows, some special key start with "=". eg: "=C:=C:\\path"
+		if i := strings.IndexByte(line[1:], '='); i >= 0 {
+			mp[line[:i+1]] = line[i+2:]
+		}
+	}
+	return mp
+}
+
+// ApplySnapshot restore the process ENV vars by the snapshot.
+// will unset the ENV vars that not in the snapshot.
+func ApplySnapshot(snap map[string]string) error {
+	for key := range EnvSnapshot() {
+		if _, ok := snap[key]; !ok {
+			if err := os.Unsetenv(key); err != nil {
+				return err
+			}
+		}
+	}
+
+	for key, val := range snap {
+		if old, ok := os.LookupEnv(key); ok && old == val {
+			continue
+		}
+
+		if err := os.Setenv(key, val); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// EnvDiff the diff result of two ENV snapshots
+type EnvDiff struct {
+	// Added ENV vars in the new snapshot
+	Added map[string]string
+	// Removed ENV vars in the new snapshot, value is the old value
+	Removed map[string]string
+	// Changed ENV vars, value is: [old, new]
+	Changed map[string][2]string
+}
+
+// IsEmpty check there is no changes
+func (d *EnvDiff) IsEmpty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// DiffEnv compare two ENV snapshots. a is old, b is new.
+//
+// Usage:
+// 	old := sysutil.EnvSnapshot()
+// 	// do something ...
+// 	diff := sysutil.DiffEnv(old, sysutil.EnvSnapshot())
+func DiffEnv(a, b map[string]string) *EnvDiff {
+	d := &EnvDiff{
+		Added:   make(map[string]string),
+		Removed: make(map[string]string),
+		Changed: make(map[string][2]string),
+	}
+
+	for key, old := range a {
+		val, ok := b[key]
+		if !ok {
+			d.Removed[key] = old
+		} else if val != old {
+			d.Changed[key] = [2]string{old, val}
+		}
+	}
+
+	for key, val := range b {
+		if _, ok := a[key]; !ok {
+			d.Added[key] = val
+		}
+	}
+	return d
+}
@@ -0,0 +1,46 @@
+package sysutil_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/gookit/goutil/sysutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnvSnapshot(t *testing.T) {
+	is := assert.New(t)
+	is.NoError(os.Setenv("GOUTIL_SNAP_KEEP", "val0"))
+	is.NoError(os.Setenv("GOUTIL_SNAP_DEL", "val1"))
+	defer os.Unsetenv("GOUTIL_SNAP_KEEP")
+
+	snap := sysutil.EnvSnapshot()
+	is.Equal("val0", snap["GOUTIL_SNAP_KEEP"])
+
+	is.NoError(os.Unsetenv("GOUTIL_SNAP_DEL"))
+	is.NoError(os.Setenv("GOUTIL_SNAP_KEEP", "new-val"))
+	is.NoError(os.Setenv("GOUTIL_SNAP_ADD", "val2"))
+
+	diff := sysutil.DiffEnv(snap, sysutil.EnvSnapshot())
+	is.False(diff.IsEmpty())
+	is.Equal("val2", diff.Added["GOUTIL_SNAP_ADD"])
+	is.Equal("val1", diff.Removed["GOUTIL_SNAP_DEL"])
+	is.Equal([2]string{"val0", "new-val"}, diff.Changed["GOUTIL_SNAP_KEEP"])
+
+	// restore
+	is.NoError(sysutil.ApplySnapshot(snap))
+	is.Equal("val0", os.Getenv("GOUTIL_SNAP_KEEP"))
+	is.Equal("val1", os.Getenv("GOUTIL_SNAP_DEL"))
+	_, ok := os.LookupEnv("GOUTIL_SNAP_ADD")
+	is.False(ok)
+	is.True(sysutil.DiffEnv(snap, sysutil.EnvSnapshot()).IsEmpty())
+	is.NoError(os.Unsetenv("GOUTIL_SNAP_DEL"))
+}
+
+func TestEnvListToMap(t *testing.T) {
+	mp := sysutil.EnvListToMap([]string{"KEY=val", "=C:=C:\\path", "EMPTY=", "invalid"})
+	assert.Len(t, mp, 3)
+	assert.Equal(t, "val", mp["KEY"])
+	assert.Equal(t, "C:\\path", mp["=C:"])
+	assert.Equal(t, "", mp["EMPTY"])
+}
@@ -1,7 +1,6 @@
 package sysutil
 
 import (
-	"bytes"
 	"os/exec"
 
 	"github.com/gookit/goutil/cliutil/cmdline"
@@ -12,35 +11,31 @@ func QuickExec(cmdLine string, workDir ...string) (string, error) {
 	return ExecLine(cmdLine, workDir...)
 }
 
-// ExecLine quick exec an command line string
+// ExecLine quick exec an command line string. will run by the Cmd, so it can be stubbed in tests.
 func ExecLine(cmdLine string, workDir ...string) (string, error) {
-	p := cmdline.NewParser(cmdLine)
+	binName, args := cmdline.NewParser(cmdLine).BinAndArgs()
 
 	// create a new Cmd instance
-	cmd := p.NewExecCmd()
+	cmd := NewCmd(binName, args...)
 	if len(workDir) > 0 {
 		cmd.Dir = workDir[0]
 	}
-
-	bs, err := cmd.Output()
-	return string(bs), err
+	return cmd.Output()
 }
 
-// ExecCmd an command and return output.
+// ExecCmd an command and return output. will run by the Cmd, so it can be stubbed in tests.
 // Usage:
 // 	ExecCmd("ls", []string{"-al"})
 func ExecCmd(binName string, args []string, workDir ...string) (string, error) {
 	// create a new Cmd instance
-	cmd := exec.Command(binName, args...)
+	cmd := NewCmd(binName, args...)
 	if len(workDir) > 0 {
 		cmd.Dir = workDir[0]
 	}
-
-	bs, err := cmd.Output()
-	return string(bs), err
+	return cmd.Output()
 }
 
-// ShellExec exec command by shell
+// ShellExec exec command by shell. will run by the Cmd, so it can be stubbed in tests.
 // cmdStr eg. "ls -al"
 func ShellExec(cmdLine string, shells ...string) (string, error) {
 	// shell := "/bin/sh"
@@ -49,16 +44,11 @@ func ShellExec(cmdLine string, shells ...string) (string, error) {
 		shell = shells[0]
 	}
 
-	var out bytes.Buffer
-
-	cmd := exec.Command(shell, "-c", cmdLine)
-	cmd.Stdout = &out
-
-	if err := cmd.Run(); err != nil {
+	out, err := NewCmd(shell, "-c", cmdLine).Output()
+	if err != nil {
 		return "", err
 	}
-
-	return out.String(), nil
+	return out, nil
 }
 
 // FindExecutable in the system
@@ -0,0 +1,39 @@
+package sysutil
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+// MachineID get the stable machine identifier of the current OS. the raw ID is read from:
+//
+// 	- linux/bsd: /etc/machine-id, /var/lib/dbus/machine-id, /etc/hostid
+// 	- darwin: IOPlatformUUID by the "ioreg" command
+// 	- windows: MachineGuid in the registry HKLM\SOFTWARE\Microsoft\Cryptography
+//
+// NOTICE: the raw ID should be considered "confidential", recommend use ProtectedMachineID()
+func MachineID() (string, error) {
+	id, err := readMachineID()
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(id), nil
+}
+
+// ProtectedMachineID get the machine ID hashed by HMAC-SHA256, keyed by the appKey.
+// the returned is a hex string, it is stable for the same machine and appKey.
+//
+// Usage:
+// 	id, err := sysutil.ProtectedMachineID("my-app")
+func ProtectedMachineID(appKey string) (string, error) {
+	id, err := MachineID()
+	if err != nil {
+		return "", err
+	}
+
+	mac := hmac.New(sha256.New, []byte(appKey))
+	mac.Write([]byte(id))
+	return hex.EncodeToString(mac.Sum(nil)), nil
+}
@@ -0,0 +1,26 @@
+//go:build darwin
+// +build darwin
+
+package sysutil
+
+import (
+	"errors"
+	"strings"
+)
+
+func readMachineID() (string, error) {
+	out, err := ExecCmd("ioreg", []string{"-rd1", "-c", "IOPlatformExpertDevice"})
+	if err != nil {
+		return "", err
+	}
+
+	// eg: "IOPlatformUUID" = "E5C3F9A0-..."
+	for _, line := range strings.Split(out, "\n") {
+		if strings.Contains(line, "IOPlatformUUID") {
+			if nodes := strings.SplitN(line, "=", 2); len(nodes) == 2 {
+				return strings.Trim(strings.TrimSpace(nodes[1]), `"`), nil
+			}
+		}
+	}
+	return "", errors.New("the IOPlatformUUID is not found")
+}
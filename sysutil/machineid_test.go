@@ -0,0 +1,29 @@
+package sysutil_test
+
+import (
+	"testing"
+
+	"github.com/gookit/goutil/sysutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMachineID(t *testing.T) {
+	id, err := sysutil.MachineID()
+	if err != nil {
+		t.Skip("machine ID is not available: " + err.Error())
+	}
+	assert.NotEmpty(t, id)
+
+	pid1, err := sysutil.ProtectedMachineID("app1")
+	assert.NoError(t, err)
+	assert.Len(t, pid1, 64)
+	assert.NotContains(t, pid1, id)
+
+	pid2, err := sysutil.ProtectedMachineID("app1")
+	assert.NoError(t, err)
+	assert.Equal(t, pid1, pid2)
+
+	pid2, err = sysutil.ProtectedMachineID("app2")
+	assert.NoError(t, err)
+	assert.NotEqual(t, pid1, pid2)
+}
@@ -0,0 +1,24 @@
+//go:build !windows && !darwin
+// +build !windows,!darwin
+
+package sysutil
+
+import (
+	"io/ioutil"
+	"strings"
+)
+
+var machineIDFiles = []string{"/etc/machine-id", "/var/lib/dbus/machine-id", "/etc/hostid"}
+
+func readMachineID() (id string, err error) {
+	var bs []byte
+	for _, file := range machineIDFiles {
+		bs, err = ioutil.ReadFile(file)
+		if err == nil {
+			if id = strings.TrimSpace(string(bs)); id != "" {
+				return id, nil
+			}
+		}
+	}
+	return
+}
@@ -0,0 +1,95 @@
+package sysutil
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"regexp"
+	"time"
+)
+
+// BackoffFunc returns the wait duration before the next attempt.
+// the attempt is start from 1, it is the number of failed attempts.
+type BackoffFunc func(attempt int) time.Duration
+
+// ConstBackoff always wait the given duration
+func ConstBackoff(d time.Duration) BackoffFunc {
+	return func(int) time.Duration { return d }
+}
+
+// ExpBackoff exponential backoff with random jitter.
+//
+// wait: base * 2^(attempt-1), limited by maxWait(<= 0 is no limit), then add a random jitter in [0, wait/2)
+func ExpBackoff(base, maxWait time.Duration) BackoffFunc {
+	return func(attempt int) time.Duration {
+		d := base
+		for i := 1; i < attempt && (maxWait <= 0 || d < maxWait); i++ {
+			// avoid overflow on no limit(include the jitter)
+			if d > math.MaxInt64/4 {
+				break
+			}
+			d *= 2
+		}
+
+		if maxWait > 0 && d > maxWait {
+			d = maxWait
+		}
+
+		if half := int64(d / 2); half > 0 {
+			d += time.Duration(rand.Int63n(half))
+		}
+		return d
+	}
+}
+
+// ExecRetry run the command, will retry on the command exit with non-zero code,
+// or the stderr output matched one of the stderrPatterns(regexp).
+//
+// Usage:
+// 	cmd := sysutil.NewCmd("git", "fetch", "origin")
+// 	out, err := sysutil.ExecRetry(cmd, 3, sysutil.ExpBackoff(time.Second, 10*time.Second))
+//
+// 	// also retry on exit 0 but stderr contains "connection reset"
+// 	out, err = sysutil.ExecRetry(cmd, 3, sysutil.ConstBackoff(time.Second), "connection reset")
+func ExecRetry(cmd *Cmd, attempts int, backoff BackoffFunc, stderrPatterns ...string) (string, error) {
+	if attempts < 1 {
+		attempts = 1
+	}
+
+	regs := make([]*regexp.Regexp, 0, len(stderrPatterns))
+	for _, pattern := range stderrPatterns {
+		reg, err := regexp.Compile(pattern)
+		if err != nil {
+			return "", err
+		}
+		regs = append(regs, reg)
+	}
+
+	var out, stderr string
+	var err error
+	for i := 1; i <= attempts; i++ {
+		out, stderr, err = cmd.Exec()
+		if err == nil {
+			err = matchStderr(regs, stderr)
+		}
+
+		if err == nil {
+			return out, nil
+		}
+
+		if i < attempts && backoff != nil {
+			time.Sleep(backoff(i))
+		}
+	}
+
+	return out, err
+}
+
+func matchStderr(regs []*regexp.Regexp, stderr string) error {
+	for _, reg := range regs {
+		if reg.MatchString(stderr) {
+			return fmt.Errorf("stderr output matched the retry pattern %q", reg.String())
+		}
+	}
+	return nil
+}
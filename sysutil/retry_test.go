@@ -0,0 +1,73 @@
+package sysutil_test
+
+import (
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gookit/goutil/sysutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExecRetry(t *testing.T) {
+	if sysutil.IsWindows() {
+		t.Skip("skip on windows")
+	}
+
+	dir, err := ioutil.TempDir("", "retry")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	// fail on the first two runs
+	cmd := sysutil.NewCmd("sh", "-c", `echo x >> count.txt; [ $(wc -l < count.txt) -ge 3 ] && echo done`)
+	cmd.WithDir(dir)
+
+	out, err := sysutil.ExecRetry(cmd, 2, nil)
+	assert.Error(t, err)
+	assert.Equal(t, 1, sysutil.ExitCode(err))
+
+	out, err = sysutil.ExecRetry(cmd, 3, sysutil.ConstBackoff(time.Millisecond))
+	assert.NoError(t, err)
+	assert.Equal(t, "done", strings.TrimSpace(out))
+
+	bs, err := ioutil.ReadFile(dir + "/count.txt")
+	assert.NoError(t, err)
+	assert.Equal(t, 3, strings.Count(string(bs), "x"))
+
+	// matched stderr pattern
+	cmd = sysutil.NewCmd("sh", "-c", "echo 'connection reset by peer' >&2")
+	_, err = sysutil.ExecRetry(cmd, 2, nil, "connection reset")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "connection reset")
+
+	_, err = sysutil.ExecRetry(cmd, 2, nil, "[invalid")
+	assert.Error(t, err)
+}
+
+func TestExpBackoff(t *testing.T) {
+	fn := sysutil.ExpBackoff(10*time.Millisecond, 50*time.Millisecond)
+
+	d := fn(1)
+	assert.True(t, d >= 10*time.Millisecond && d < 15*time.Millisecond)
+
+	d = fn(3)
+	assert.True(t, d >= 40*time.Millisecond && d < 60*time.Millisecond)
+
+	d = fn(10)
+	assert.True(t, d >= 50*time.Millisecond && d < 75*time.Millisecond)
+}
+
+func TestExpBackoff_noMax(t *testing.T) {
+	fn := sysutil.ExpBackoff(10*time.Millisecond, 0)
+
+	d := fn(1)
+	assert.True(t, d >= 10*time.Millisecond && d < 15*time.Millisecond)
+
+	d = fn(4)
+	assert.True(t, d >= 80*time.Millisecond && d < 120*time.Millisecond)
+
+	// not overflow
+	assert.True(t, fn(100) > 0)
+}
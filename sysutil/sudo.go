@@ -0,0 +1,70 @@
+package sysutil
+
+import (
+	"errors"
+	"os"
+	"strings"
+)
+
+// ErrSudoNotSupport on windows
+var ErrSudoNotSupport = errors.New("sudo is not supported on Windows, please run the command in an elevated (Administrator) terminal")
+
+// IsAdmin check current process is run by root user. always return false on windows.
+func IsAdmin() bool {
+	return os.Geteuid() == 0
+}
+
+// HasSudo check the sudo command is available
+func HasSudo() bool {
+	return HasExecutable("sudo")
+}
+
+// SudoCmd create a Cmd, will prepend sudo if current user is not root.
+//
+// keepEnv is the ENV var names that preserved for the command.
+// if keepEnv is ["*"], will preserve all ENV vars by "sudo -E"
+//
+// Usage:
+// 	cmd, err := sysutil.SudoCmd([]string{"HTTP_PROXY"}, "apt-get", "update")
+func SudoCmd(keepEnv []string, name string, args ...string) (*Cmd, error) {
+	if IsWindows() {
+		return nil, ErrSudoNotSupport
+	}
+
+	// not need sudo
+	if IsAdmin() {
+		return NewCmd(name, args...), nil
+	}
+
+	if !HasSudo() {
+		return nil, errors.New("the command requires root privileges, but sudo is not found")
+	}
+
+	sudoArgs := make([]string, 0, len(args)+3)
+	if len(keepEnv) == 1 && keepEnv[0] == "*" {
+		sudoArgs = append(sudoArgs, "-E")
+	} else if len(keepEnv) > 0 {
+		sudoArgs = append(sudoArgs, "--preserve-env="+strings.Join(keepEnv, ","))
+	}
+
+	sudoArgs = append(sudoArgs, "--", name)
+	return NewCmd("sudo", append(sudoArgs, args...)...), nil
+}
+
+// SudoExec run the command by sudo if needed, and return output.
+//
+// Usage:
+// 	out, err := sysutil.SudoExec("apt-get", "update")
+func SudoExec(name string, args ...string) (string, error) {
+	return SudoExecEnv(nil, name, args...)
+}
+
+// SudoExecEnv run the command by sudo if needed, will preserve the keepEnv ENV vars.
+// see SudoCmd() for more.
+func SudoExecEnv(keepEnv []string, name string, args ...string) (string, error) {
+	cmd, err := SudoCmd(keepEnv, name, args...)
+	if err != nil {
+		return "", err
+	}
+	return cmd.Output()
+}
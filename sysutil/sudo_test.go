@@ -0,0 +1,39 @@
+package sysutil_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gookit/goutil/sysutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSudoCmd(t *testing.T) {
+	cmd, err := sysutil.SudoCmd([]string{"HTTP_PROXY", "LANG"}, "echo", "OK")
+	if sysutil.IsWindows() {
+		assert.ErrorIs(t, err, sysutil.ErrSudoNotSupport)
+		return
+	}
+
+	if sysutil.IsAdmin() {
+		assert.NoError(t, err)
+		assert.Equal(t, "echo OK", cmd.String())
+
+		out, err := sysutil.SudoExec("echo", "OK")
+		assert.NoError(t, err)
+		assert.Equal(t, "OK", strings.TrimSpace(out))
+		return
+	}
+
+	if !sysutil.HasSudo() {
+		assert.Error(t, err)
+		return
+	}
+
+	assert.NoError(t, err)
+	assert.Equal(t, "sudo --preserve-env=HTTP_PROXY,LANG -- echo OK", cmd.String())
+
+	cmd, err = sysutil.SudoCmd([]string{"*"}, "echo", "OK")
+	assert.NoError(t, err)
+	assert.Equal(t, "sudo -E -- echo OK", cmd.String())
+}
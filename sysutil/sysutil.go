@@ -21,3 +21,13 @@ func BinDir() string {
 func BinFile() string {
 	return os.Args[0]
 }
+
+// ExitFunc the func for exit current process. it can be replaced on testing.
+//
+// see testutil.MockOsExit
+var ExitFunc = os.Exit
+
+// Exit current process with the exit code. will call ExitFunc
+func Exit(code int) {
+	ExitFunc(code)
+}
@@ -0,0 +1,17 @@
+package sysutil
+
+import "time"
+
+// Uptime get the system uptime duration
+func Uptime() (time.Duration, error) {
+	return uptime()
+}
+
+// BootTime get the system boot time
+func BootTime() (time.Time, error) {
+	up, err := uptime()
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Now().Add(-up).Truncate(time.Second), nil
+}
@@ -0,0 +1,20 @@
+//go:build darwin || freebsd || netbsd || openbsd || dragonfly
+// +build darwin freebsd netbsd openbsd dragonfly
+
+package sysutil
+
+import (
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+func uptime() (time.Duration, error) {
+	tv, err := unix.SysctlTimeval("kern.boottime")
+	if err != nil {
+		return 0, err
+	}
+
+	sec, nsec := tv.Unix()
+	return time.Since(time.Unix(sec, nsec)), nil
+}
@@ -0,0 +1,31 @@
+//go:build linux
+// +build linux
+
+package sysutil
+
+import (
+	"errors"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"time"
+)
+
+func uptime() (time.Duration, error) {
+	// eg: "350735.47 234388.90"
+	bs, err := ioutil.ReadFile("/proc/uptime")
+	if err != nil {
+		return 0, err
+	}
+
+	fields := strings.Fields(string(bs))
+	if len(fields) == 0 {
+		return 0, errors.New("invalid contents in the /proc/uptime")
+	}
+
+	secs, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(secs * float64(time.Second)), nil
+}
@@ -0,0 +1,13 @@
+//go:build !linux && !windows && !darwin && !freebsd && !netbsd && !openbsd && !dragonfly
+// +build !linux,!windows,!darwin,!freebsd,!netbsd,!openbsd,!dragonfly
+
+package sysutil
+
+import (
+	"errors"
+	"time"
+)
+
+func uptime() (time.Duration, error) {
+	return 0, errors.New("get uptime is not supported on the OS")
+}
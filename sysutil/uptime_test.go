@@ -0,0 +1,20 @@
+package sysutil_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gookit/goutil/sysutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUptime(t *testing.T) {
+	up, err := sysutil.Uptime()
+	assert.NoError(t, err)
+	assert.True(t, up > 0)
+
+	bt, err := sysutil.BootTime()
+	assert.NoError(t, err)
+	assert.True(t, bt.Before(time.Now()))
+	assert.True(t, time.Since(bt) >= up-time.Second)
+}
@@ -0,0 +1,22 @@
+//go:build windows
+// +build windows
+
+package sysutil
+
+import (
+	"time"
+
+	"golang.org/x/sys/windows"
+)
+
+var procGetTickCount64 = windows.NewLazySystemDLL("kernel32.dll").NewProc("GetTickCount64")
+
+func uptime() (time.Duration, error) {
+	if err := procGetTickCount64.Find(); err != nil {
+		return 0, err
+	}
+
+	// returns the number of milliseconds that have elapsed since the system was started.
+	ms, _, _ := procGetTickCount64.Call()
+	return time.Duration(ms) * time.Millisecond, nil
+}
@@ -0,0 +1,381 @@
+// Package assert provides some simple assertion functions for the testing,
+// the failure message will show the readable differences of values.
+package assert
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/gookit/goutil/dump"
+)
+
+// TestingT is an interface wrapper around *testing.T
+type TestingT interface {
+	Helper()
+	Errorf(format string, args ...interface{})
+}
+
+// Eq asserts that the want and give are equal.
+//
+// Usage:
+// 	assert.Eq(t, "inhere", user.Name)
+// 	assert.Eq(t, 23, user.Age, "check user age")
+func Eq(t TestingT, want, give interface{}, fmtAndArgs ...interface{}) bool {
+	t.Helper()
+	if isEqual(want, give) {
+		return true
+	}
+
+	return fail(t, "Not equal", fmtAndArgs, "Diff:\n"+diffString(want, give))
+}
+
+// NotEq asserts that the want and give are not equal.
+func NotEq(t TestingT, want, give interface{}, fmtAndArgs ...interface{}) bool {
+	t.Helper()
+	if !isEqual(want, give) {
+		return true
+	}
+
+	return fail(t, "Should not be equal", fmtAndArgs, "Value: "+formatValue(give))
+}
+
+// Nil asserts that the give is nil
+func Nil(t TestingT, give interface{}, fmtAndArgs ...interface{}) bool {
+	t.Helper()
+	if isNil(give) {
+		return true
+	}
+
+	return fail(t, "Expected nil", fmtAndArgs, "Value: "+formatValue(give))
+}
+
+// NotNil asserts that the give is not nil
+func NotNil(t TestingT, give interface{}, fmtAndArgs ...interface{}) bool {
+	t.Helper()
+	if !isNil(give) {
+		return true
+	}
+
+	return fail(t, "Should not be nil", fmtAndArgs)
+}
+
+// Err asserts that the err is not nil
+func Err(t TestingT, err error, fmtAndArgs ...interface{}) bool {
+	t.Helper()
+	if err != nil {
+		return true
+	}
+
+	return fail(t, "An error is expected but got nil", fmtAndArgs)
+}
+
+// NoErr asserts that the err is nil
+func NoErr(t TestingT, err error, fmtAndArgs ...interface{}) bool {
+	t.Helper()
+	if err == nil {
+		return true
+	}
+
+	return fail(t, "Received unexpected error", fmtAndArgs, "Error: "+err.Error())
+}
+
+// ErrIs asserts that the err matches the target error by errors.Is()
+func ErrIs(t TestingT, err, target error, fmtAndArgs ...interface{}) bool {
+	t.Helper()
+	if errors.Is(err, target) {
+		return true
+	}
+
+	var giveMsg string
+	if err != nil {
+		giveMsg = err.Error()
+	}
+	return fail(t, "Error is not match the target", fmtAndArgs,
+		"Want: "+fmt.Sprint(target),
+		"Give: "+giveMsg,
+	)
+}
+
+// ErrMsg asserts that the err is not nil and the message is equals to the wantMsg
+func ErrMsg(t TestingT, err error, wantMsg string, fmtAndArgs ...interface{}) bool {
+	t.Helper()
+	if err == nil {
+		return fail(t, "An error is expected but got nil", fmtAndArgs)
+	}
+
+	if err.Error() == wantMsg {
+		return true
+	}
+	return fail(t, "Error message not equal", fmtAndArgs, "Diff:\n"+diffString(wantMsg, err.Error()))
+}
+
+// True asserts that the give is true
+func True(t TestingT, give bool, fmtAndArgs ...interface{}) bool {
+	t.Helper()
+	if give {
+		return true
+	}
+
+	return fail(t, "Should be true", fmtAndArgs)
+}
+
+// False asserts that the give is false
+func False(t TestingT, give bool, fmtAndArgs ...interface{}) bool {
+	t.Helper()
+	if !give {
+		return true
+	}
+
+	return fail(t, "Should be false", fmtAndArgs)
+}
+
+// Contains asserts that the given string, slice, array or map contains the elem.
+// for map, will check the key exists.
+//
+// Usage:
+// 	assert.Contains(t, "Hello World", "World")
+// 	assert.Contains(t, []string{"a", "b"}, "a")
+// 	assert.Contains(t, map[string]int{"a": 1}, "a")
+func Contains(t TestingT, src, elem interface{}, fmtAndArgs ...interface{}) bool {
+	t.Helper()
+	ok, found := includes(src, elem)
+	if !ok {
+		return fail(t, fmt.Sprintf("Cannot check contains on the %T value", src), fmtAndArgs)
+	}
+
+	if found {
+		return true
+	}
+	return fail(t, "Not contains the element", fmtAndArgs,
+		"Value:   "+formatValue(src),
+		"Element: "+formatValue(elem),
+	)
+}
+
+// NotContains asserts that the given string, slice, array or map not contains the elem.
+func NotContains(t TestingT, src, elem interface{}, fmtAndArgs ...interface{}) bool {
+	t.Helper()
+	ok, found := includes(src, elem)
+	if !ok {
+		return fail(t, fmt.Sprintf("Cannot check contains on the %T value", src), fmtAndArgs)
+	}
+
+	if !found {
+		return true
+	}
+	return fail(t, "Should not contains the element", fmtAndArgs,
+		"Value:   "+formatValue(src),
+		"Element: "+formatValue(elem),
+	)
+}
+
+// Len asserts that the length of the give is equal to the wantLen.
+// allow types: string, slice, array, map, chan
+func Len(t TestingT, give interface{}, wantLen int, fmtAndArgs ...interface{}) bool {
+	t.Helper()
+	n, ok := lengthOf(give)
+	if !ok {
+		return fail(t, fmt.Sprintf("Cannot get the length of the %T value", give), fmtAndArgs)
+	}
+
+	if n == wantLen {
+		return true
+	}
+	return fail(t, fmt.Sprintf("Should have %d item(s), but has %d", wantLen, n), fmtAndArgs,
+		"Value: "+formatValue(give),
+	)
+}
+
+// Empty asserts that the give is empty. eg: nil, "", 0, false, empty slice or map
+func Empty(t TestingT, give interface{}, fmtAndArgs ...interface{}) bool {
+	t.Helper()
+	if isEmpty(give) {
+		return true
+	}
+
+	return fail(t, "Should be empty", fmtAndArgs, "Value: "+formatValue(give))
+}
+
+// NotEmpty asserts that the give is not empty
+func NotEmpty(t TestingT, give interface{}, fmtAndArgs ...interface{}) bool {
+	t.Helper()
+	if !isEmpty(give) {
+		return true
+	}
+
+	return fail(t, "Should not be empty", fmtAndArgs, "Value: "+formatValue(give))
+}
+
+// Panics asserts that the fn will panic
+func Panics(t TestingT, fn func(), fmtAndArgs ...interface{}) bool {
+	t.Helper()
+	if panicked, _ := runPanicFunc(fn); panicked {
+		return true
+	}
+
+	return fail(t, "Func should panic", fmtAndArgs)
+}
+
+// NotPanics asserts that the fn will not panic
+func NotPanics(t TestingT, fn func(), fmtAndArgs ...interface{}) bool {
+	t.Helper()
+	panicked, val := runPanicFunc(fn)
+	if !panicked {
+		return true
+	}
+
+	return fail(t, "Func should not panic", fmtAndArgs, "Panic value: "+fmt.Sprint(val))
+}
+
+// ------------------ helper functions ------------------
+
+// fail report the failure message. returns false
+func fail(t TestingT, title string, fmtAndArgs []interface{}, details ...string) bool {
+	t.Helper()
+
+	sb := strings.Builder{}
+	sb.WriteString("\nError: " + title + "\n")
+	for _, detail := range details {
+		sb.WriteString(strings.TrimRight(detail, "\n") + "\n")
+	}
+
+	if msg := formatMessage(fmtAndArgs); msg != "" {
+		sb.WriteString("Message: " + msg + "\n")
+	}
+
+	t.Errorf("%s", sb.String())
+	return false
+}
+
+func formatMessage(fmtAndArgs []interface{}) string {
+	if len(fmtAndArgs) == 0 {
+		return ""
+	}
+
+	if format, ok := fmtAndArgs[0].(string); ok && len(fmtAndArgs) > 1 {
+		return fmt.Sprintf(format, fmtAndArgs[1:]...)
+	}
+	return fmt.Sprint(fmtAndArgs...)
+}
+
+func formatValue(v interface{}) string {
+	return fmt.Sprintf("%#v", v)
+}
+
+// diffString get the readable differences by dump.DiffString
+func diffString(want, give interface{}) string {
+	// show more details on the types are different
+	if want != nil && give != nil && reflect.TypeOf(want) != reflect.TypeOf(give) {
+		return fmt.Sprintf("  - %T(%#v)\n  + %T(%#v)", want, want, give, give)
+	}
+
+	if s := dump.DiffString(want, give); s != "" {
+		return s
+	}
+	return fmt.Sprintf("  - %#v\n  + %#v", want, give)
+}
+
+func isEqual(want, give interface{}) bool {
+	if want == nil || give == nil {
+		return want == give
+	}
+
+	wb, ok := want.([]byte)
+	if ok {
+		gb, ok := give.([]byte)
+		return ok && bytes.Equal(wb, gb)
+	}
+	return reflect.DeepEqual(want, give)
+}
+
+func isNil(v interface{}) bool {
+	if v == nil {
+		return true
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Ptr, reflect.Slice, reflect.UnsafePointer:
+		return rv.IsNil()
+	}
+	return false
+}
+
+func isEmpty(v interface{}) bool {
+	if v == nil {
+		return true
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.String, reflect.Slice, reflect.Array, reflect.Map, reflect.Chan:
+		return rv.Len() == 0
+	case reflect.Ptr:
+		if rv.IsNil() {
+			return true
+		}
+		return isEmpty(rv.Elem().Interface())
+	}
+	return rv.IsZero()
+}
+
+func lengthOf(v interface{}) (int, bool) {
+	if v == nil {
+		return 0, false
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.String, reflect.Slice, reflect.Array, reflect.Map, reflect.Chan:
+		return rv.Len(), true
+	}
+	return 0, false
+}
+
+// includes check the src contains the elem. returns ok=false on the src type is not supported.
+func includes(src, elem interface{}) (ok, found bool) {
+	if src == nil {
+		return false, false
+	}
+
+	sv := reflect.ValueOf(src)
+	switch sv.Kind() {
+	case reflect.String:
+		ev := reflect.ValueOf(elem)
+		if ev.Kind() != reflect.String {
+			return false, false
+		}
+		return true, strings.Contains(sv.String(), ev.String())
+	case reflect.Map:
+		for _, key := range sv.MapKeys() {
+			if isEqual(key.Interface(), elem) {
+				return true, true
+			}
+		}
+		return true, false
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < sv.Len(); i++ {
+			if isEqual(sv.Index(i).Interface(), elem) {
+				return true, true
+			}
+		}
+		return true, false
+	}
+	return false, false
+}
+
+func runPanicFunc(fn func()) (panicked bool, val interface{}) {
+	panicked = true
+	defer func() {
+		if panicked {
+			val = recover()
+		}
+	}()
+
+	fn()
+	panicked = false
+	return
+}
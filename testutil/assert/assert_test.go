@@ -0,0 +1,125 @@
+package assert_test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/gookit/goutil/testutil/assert"
+)
+
+// mockT record the failure messages
+type mockT struct {
+	msgs []string
+}
+
+func (m *mockT) Helper() {}
+
+func (m *mockT) Errorf(format string, args ...interface{}) {
+	m.msgs = append(m.msgs, fmt.Sprintf(format, args...))
+}
+
+func (m *mockT) last() string {
+	if len(m.msgs) == 0 {
+		return ""
+	}
+	return m.msgs[len(m.msgs)-1]
+}
+
+type user struct {
+	Name string
+	Age  int
+}
+
+func TestEq(t *testing.T) {
+	mt := &mockT{}
+
+	assert.True(t, assert.Eq(mt, 1, 1))
+	assert.True(t, assert.Eq(mt, []byte("a"), []byte("a")))
+	assert.True(t, assert.Eq(mt, nil, nil))
+	assert.True(t, assert.NotEq(mt, 1, 2))
+	assert.Len(t, mt.msgs, 0)
+
+	assert.False(t, assert.Eq(mt, user{"inhere", 23}, user{"inhere", 24}, "check %s", "user"))
+	assert.Contains(t, mt.last(), "Error: Not equal")
+	assert.Contains(t, mt.last(), "Age:")
+	assert.Contains(t, mt.last(), "- int(23)")
+	assert.Contains(t, mt.last(), "+ int(24)")
+	assert.Contains(t, mt.last(), "Message: check user")
+
+	assert.False(t, assert.Eq(mt, 1, int64(1)))
+	assert.Contains(t, mt.last(), "- int(1)\n  + int64(1)")
+
+	assert.False(t, assert.Eq(mt, nil, 1))
+	assert.False(t, assert.NotEq(mt, "a", "a"))
+	assert.Contains(t, mt.last(), "Should not be equal")
+}
+
+func TestNil_Err(t *testing.T) {
+	mt := &mockT{}
+	var nilPtr *user
+	err := fmt.Errorf("read: %w", io.EOF)
+
+	assert.True(t, assert.Nil(mt, nil))
+	assert.True(t, assert.Nil(mt, nilPtr))
+	assert.True(t, assert.NotNil(mt, &user{}))
+	assert.True(t, assert.Err(mt, err))
+	assert.True(t, assert.NoErr(mt, nil))
+	assert.True(t, assert.ErrIs(mt, err, io.EOF))
+	assert.True(t, assert.ErrMsg(mt, err, "read: EOF"))
+	assert.Len(t, mt.msgs, 0)
+
+	assert.False(t, assert.Nil(mt, 0))
+	assert.False(t, assert.NotNil(mt, nilPtr))
+	assert.False(t, assert.Err(mt, nil))
+	assert.False(t, assert.NoErr(mt, err))
+	assert.Contains(t, mt.last(), "Error: read: EOF")
+	assert.False(t, assert.ErrIs(mt, errors.New("other"), io.EOF))
+	assert.Contains(t, mt.last(), "Give: other")
+	assert.False(t, assert.ErrMsg(mt, err, "other"))
+	assert.False(t, assert.ErrMsg(mt, nil, "other"))
+	assert.Len(t, mt.msgs, 7)
+}
+
+func TestTrue_Contains_Len(t *testing.T) {
+	mt := &mockT{}
+
+	assert.True(t, assert.True(mt, true))
+	assert.True(t, assert.False(mt, false))
+	assert.True(t, assert.Contains(mt, "hello world", "world"))
+	assert.True(t, assert.Contains(mt, []int{1, 2}, 2))
+	assert.True(t, assert.Contains(mt, map[string]int{"a": 1}, "a"))
+	assert.True(t, assert.NotContains(mt, [2]string{"a", "b"}, "c"))
+	assert.True(t, assert.Len(mt, "abc", 3))
+	assert.True(t, assert.Len(mt, map[int]int{1: 1}, 1))
+	assert.True(t, assert.Empty(mt, ""))
+	assert.True(t, assert.Empty(mt, []int{}))
+	assert.True(t, assert.Empty(mt, &user{}))
+	assert.True(t, assert.NotEmpty(mt, user{Age: 1}))
+	assert.Len(t, mt.msgs, 0)
+
+	assert.False(t, assert.True(mt, false))
+	assert.False(t, assert.False(mt, true))
+	assert.False(t, assert.Contains(mt, "abc", "d"))
+	assert.False(t, assert.Contains(mt, 123, 1))
+	assert.Contains(t, mt.last(), "Cannot check contains on the int value")
+	assert.False(t, assert.NotContains(mt, []string{"a"}, "a"))
+	assert.False(t, assert.Len(mt, []int{1}, 2))
+	assert.Contains(t, mt.last(), "Should have 2 item(s), but has 1")
+	assert.False(t, assert.Len(mt, 12, 2))
+	assert.False(t, assert.Empty(mt, "a"))
+	assert.False(t, assert.NotEmpty(mt, 0))
+}
+
+func TestPanics(t *testing.T) {
+	mt := &mockT{}
+
+	assert.True(t, assert.Panics(mt, func() { panic("error") }))
+	assert.True(t, assert.NotPanics(mt, func() {}))
+	assert.Len(t, mt.msgs, 0)
+
+	assert.False(t, assert.Panics(mt, func() {}))
+	assert.False(t, assert.NotPanics(mt, func() { panic("error") }))
+	assert.Contains(t, mt.last(), "Panic value: error")
+}
@@ -0,0 +1,68 @@
+package testutil
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/gookit/color"
+)
+
+// captureMu guard the swap of os.Stdout, os.Stderr
+var captureMu sync.Mutex
+
+// CaptureOutput run the fn and capture the outputs of os.Stdout and os.Stderr.
+// the output of gookit/color will be captured too.
+//
+// NOTE: it is guarded by a lock, the concurrent calls will be run one by one.
+//
+// Usage:
+// 	stdout, stderr := testutil.CaptureOutput(func() {
+// 		fmt.Println("hello")
+// 		fmt.Fprintln(os.Stderr, "error")
+// 	})
+func CaptureOutput(fn func()) (stdout, stderr string) {
+	captureMu.Lock()
+	defer captureMu.Unlock()
+
+	outR, outW, err := os.Pipe()
+	if err != nil {
+		panic(err)
+	}
+	errR, errW, err := os.Pipe()
+	if err != nil {
+		panic(err)
+	}
+
+	// read in background, avoid block on the pipe buffer is full
+	outBuf, errBuf := &bytes.Buffer{}, &bytes.Buffer{}
+	wg := sync.WaitGroup{}
+	wg.Add(2)
+	go copyAndClose(&wg, outBuf, outR)
+	go copyAndClose(&wg, errBuf, errR)
+
+	oldOut, oldErr := os.Stdout, os.Stderr
+	os.Stdout, os.Stderr = outW, errW
+	color.SetOutput(outW)
+
+	defer func() {
+		os.Stdout, os.Stderr = oldOut, oldErr
+		color.ResetOutput()
+
+		_ = outW.Close()
+		_ = errW.Close()
+		wg.Wait()
+
+		stdout, stderr = outBuf.String(), errBuf.String()
+	}()
+
+	fn()
+	return
+}
+
+func copyAndClose(wg *sync.WaitGroup, dst io.Writer, r *os.File) {
+	_, _ = io.Copy(dst, r)
+	_ = r.Close()
+	wg.Done()
+}
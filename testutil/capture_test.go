@@ -0,0 +1,39 @@
+package testutil_test
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/gookit/color"
+	"github.com/gookit/goutil/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCaptureOutput(t *testing.T) {
+	stdout, stderr := testutil.CaptureOutput(func() {
+		fmt.Println("hello")
+		color.Println("<info>colored</>")
+		fmt.Fprint(os.Stderr, "error")
+	})
+
+	assert.Contains(t, stdout, "hello\n")
+	assert.Contains(t, stdout, "colored")
+	assert.Equal(t, "error", stderr)
+
+	// large output
+	stdout, _ = testutil.CaptureOutput(func() {
+		fmt.Print(strings.Repeat("a", 1<<20))
+	})
+	assert.Len(t, stdout, 1<<20)
+
+	// restore on panic
+	oldOut := os.Stdout
+	assert.Panics(t, func() {
+		testutil.CaptureOutput(func() {
+			panic("error")
+		})
+	})
+	assert.True(t, oldOut == os.Stdout)
+}
@@ -0,0 +1,87 @@
+package testutil
+
+import (
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/gookit/goutil/dump"
+)
+
+// Case a named test case for RunCases
+type Case struct {
+	// Input data for the case
+	Input interface{}
+	// Want the expected result
+	Want interface{}
+	// Skip the case, the value is the skip reason. "-" will skip without reason.
+	Skip string
+	// Only mark run the case only, the cases without the mark will be skipped.
+	Only bool
+	// Parallel run the case in parallel with other parallel cases.
+	Parallel bool
+}
+
+// String get the dumped string of the Input and Want
+func (c Case) String() string {
+	var sb strings.Builder
+	sb.WriteString("Input: ")
+	sb.WriteString(dump.ToString(c.Input))
+	sb.WriteString("Want: ")
+	sb.WriteString(dump.ToString(c.Want))
+	return sb.String()
+}
+
+// RunCases run the named cases as subtests, the cases will be run by sorted names.
+// will print the case Input and Want by dump on the case failed.
+//
+// Usage:
+// 	testutil.RunCases(t, map[string]testutil.Case{
+// 		"empty":   {Input: "", Want: 0},
+// 		"simple":  {Input: "abc", Want: 3, Parallel: true},
+// 		"skipped": {Input: "abc", Want: 3, Skip: "TODO"},
+// 	}, func(t *testing.T, c testutil.Case) {
+// 		assert.Equal(t, c.Want, len(c.Input.(string)))
+// 	})
+func RunCases(t *testing.T, cases map[string]Case, fn func(t *testing.T, c Case)) {
+	t.Helper()
+
+	names := make([]string, 0, len(cases))
+	hasOnly := false
+	for name, c := range cases {
+		names = append(names, name)
+		if c.Only {
+			hasOnly = true
+		}
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		// copy the loop var, the parallel case will run after the loop.
+		name := name
+		c := cases[name]
+		t.Run(name, func(t *testing.T) {
+			if hasOnly && !c.Only {
+				t.Skip("skip by other cases marked only")
+			}
+
+			if c.Skip != "" {
+				if c.Skip == "-" {
+					t.SkipNow()
+				}
+				t.Skip(c.Skip)
+			}
+
+			if c.Parallel {
+				t.Parallel()
+			}
+
+			defer func() {
+				if t.Failed() {
+					t.Logf("failed case %q:\n%s", name, c.String())
+				}
+			}()
+			fn(t, c)
+		})
+	}
+}
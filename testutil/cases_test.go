@@ -0,0 +1,82 @@
+package testutil_test
+
+import (
+	"os"
+	"os/exec"
+	"sync"
+	"testing"
+
+	"github.com/gookit/goutil/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunCases(t *testing.T) {
+	var mu sync.Mutex
+	var ran []string
+
+	t.Run("run", func(t *testing.T) {
+		testutil.RunCases(t, map[string]testutil.Case{
+			"b-simple": {Input: "abc", Want: 3},
+			"a-empty":  {Input: "", Want: 0},
+			"c-par1":   {Input: "ab", Want: 2, Parallel: true},
+			"d-par2":   {Input: "a", Want: 1, Parallel: true},
+			"e-skip":   {Input: "abc", Want: 0, Skip: "TODO"},
+		}, func(t *testing.T, c testutil.Case) {
+			mu.Lock()
+			ran = append(ran, t.Name())
+			mu.Unlock()
+
+			assert.Equal(t, c.Want, len(c.Input.(string)))
+		})
+	})
+
+	assert.Len(t, ran, 4)
+	assert.Equal(t, "TestRunCases/run/a-empty", ran[0])
+	assert.Equal(t, "TestRunCases/run/b-simple", ran[1])
+	assert.NotContains(t, ran, "TestRunCases/run/e-skip")
+}
+
+func TestRunCases_only(t *testing.T) {
+	var ran []string
+	testutil.RunCases(t, map[string]testutil.Case{
+		"case1": {Input: 1},
+		"case2": {Input: 2, Only: true},
+		"case3": {Input: 3, Skip: "-"},
+	}, func(t *testing.T, c testutil.Case) {
+		ran = append(ran, t.Name())
+	})
+
+	assert.Equal(t, []string{"TestRunCases_only/case2"}, ran)
+}
+
+func TestRunCases_parallelFailed(t *testing.T) {
+	// run the failed cases in the sub process
+	if os.Getenv("TEST_RUN_CASES_FAIL") == "1" {
+		testutil.RunCases(t, map[string]testutil.Case{
+			"a": {Input: 1, Parallel: true},
+			"b": {Input: 2, Parallel: true},
+		}, func(t *testing.T, c testutil.Case) {
+			if c.Input == 1 {
+				t.Error("case failed")
+			}
+		})
+		return
+	}
+
+	cmd := exec.Command(os.Args[0], "-test.run=^TestRunCases_parallelFailed$", "-test.v")
+	cmd.Env = append(os.Environ(), "TEST_RUN_CASES_FAIL=1")
+	out, err := cmd.CombinedOutput()
+
+	assert.Error(t, err)
+	assert.Contains(t, string(out), `failed case "a"`)
+	assert.NotContains(t, string(out), `failed case "b"`)
+}
+
+func TestCase_String(t *testing.T) {
+	c := testutil.Case{Input: map[string]int{"a": 1}, Want: "abc"}
+	s := c.String()
+
+	assert.Contains(t, s, "Input: map[string]int")
+	assert.Contains(t, s, `"a": int(1)`)
+	assert.Contains(t, s, `Want: string("abc")`)
+}
@@ -0,0 +1,123 @@
+package testutil
+
+import (
+	"sync"
+	"time"
+
+	"github.com/gookit/goutil/timex"
+)
+
+// FakeClock a testable clock, implements the timex.Clock.
+// the time only changed by Advance, Set or Sleep.
+type FakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+	// sleepers waiting on BlockSleep=true
+	sleepers []*sleeper
+	// BlockSleep on Sleep will block until the clock is advanced past the deadline.
+	//
+	// default is false, Sleep will advance the clock and return immediately.
+	BlockSleep bool
+}
+
+type sleeper struct {
+	until time.Time
+	done  chan struct{}
+}
+
+// NewFakeClock create a fake clock. default start at time.Now()
+func NewFakeClock(start ...time.Time) *FakeClock {
+	now := time.Now()
+	if len(start) > 0 {
+		now = start[0]
+	}
+	return &FakeClock{now: now}
+}
+
+// Now get current time of the clock
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// Sleep for the duration. see FakeClock.BlockSleep
+func (c *FakeClock) Sleep(d time.Duration) {
+	if d <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	if !c.BlockSleep {
+		c.mu.Unlock()
+		c.Advance(d)
+		return
+	}
+
+	s := &sleeper{until: c.now.Add(d), done: make(chan struct{})}
+	c.sleepers = append(c.sleepers, s)
+	c.mu.Unlock()
+
+	<-s.done
+}
+
+// Sleepers get the number of blocked Sleep calls
+func (c *FakeClock) Sleepers() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.sleepers)
+}
+
+// Advance the clock by the duration, will wake up the expired sleepers.
+func (c *FakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	c.setTime(c.now.Add(d))
+	c.mu.Unlock()
+}
+
+// Set the clock to the time, will wake up the expired sleepers.
+func (c *FakeClock) Set(t time.Time) {
+	c.mu.Lock()
+	c.setTime(t)
+	c.mu.Unlock()
+}
+
+func (c *FakeClock) setTime(t time.Time) {
+	c.now = t
+
+	remain := c.sleepers[:0]
+	for _, s := range c.sleepers {
+		if c.now.Before(s.until) {
+			remain = append(remain, s)
+		} else {
+			close(s.done)
+		}
+	}
+	c.sleepers = remain
+}
+
+// Install the clock into timex, returns a func for restore the old clock.
+//
+// Usage:
+// 	clock := testutil.NewFakeClock()
+// 	defer clock.Install()()
+func (c *FakeClock) Install() (restore func()) {
+	old := timex.SetClock(c)
+	return func() {
+		timex.SetClock(old)
+	}
+}
+
+// MockClock install a fake clock into timex for run the fn, will restore the old clock on end.
+//
+// Usage:
+// 	testutil.MockClock(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), func(c *testutil.FakeClock) {
+// 		c.Advance(time.Hour)
+// 		timex.NowTime() // 2022-01-01 01:00:00
+// 	})
+func MockClock(start time.Time, fn func(c *FakeClock)) {
+	c := NewFakeClock(start)
+	defer c.Install()()
+
+	fn(c)
+}
@@ -0,0 +1,63 @@
+package testutil_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gookit/goutil/testutil"
+	"github.com/gookit/goutil/timex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMockClock(t *testing.T) {
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testutil.MockClock(start, func(c *testutil.FakeClock) {
+		assert.Equal(t, start, timex.NowTime())
+		assert.Equal(t, start.Unix(), timex.NowUnix())
+		assert.Equal(t, "2022-01-01 00:00:00", timex.Now().In(time.UTC).Format(timex.DefaultLayout))
+
+		c.Advance(time.Hour)
+		assert.Equal(t, start.Add(time.Hour), timex.NowTime())
+		assert.Equal(t, time.Hour, timex.Since(start))
+
+		// sleep will advance the clock
+		timex.Sleep(time.Minute)
+		assert.Equal(t, start.Add(time.Hour+time.Minute), c.Now())
+
+		c.Set(start)
+		assert.Equal(t, start, timex.NowTime())
+	})
+
+	// restored
+	assert.IsType(t, timex.SystemClock{}, timex.CurrentClock())
+}
+
+func TestFakeClock_BlockSleep(t *testing.T) {
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := testutil.NewFakeClock(start)
+	c.BlockSleep = true
+	defer c.Install()()
+
+	done := make(chan time.Time)
+	go func() {
+		timex.Sleep(time.Minute)
+		done <- timex.NowTime()
+	}()
+
+	for c.Sleepers() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	c.Advance(30 * time.Second)
+	assert.Equal(t, 1, c.Sleepers())
+
+	c.Advance(30 * time.Second)
+	select {
+	case now := <-done:
+		assert.Equal(t, start.Add(time.Minute), now)
+	case <-time.After(time.Second):
+		t.Fatal("sleep is not wake up")
+	}
+	assert.Equal(t, 0, c.Sleepers())
+}
@@ -0,0 +1,150 @@
+package testutil
+
+import (
+	"path/filepath"
+	"strconv"
+	"sync"
+
+	"github.com/gookit/goutil/sysutil"
+)
+
+// CmdCall the recorded invocation of a stubbed command
+type CmdCall struct {
+	// Name the command name. eg: "git"
+	Name string
+	Args []string
+	Dir  string
+	Env  []string
+}
+
+// CmdHandler handle the stubbed command call, returns the stdout, stderr outputs and exit code.
+type CmdHandler func(call CmdCall) (stdout, stderr string, exitCode int)
+
+// CmdExitError the error for the stubbed command exit with non-zero code.
+// sysutil.ExitCode() can get the code from it.
+type CmdExitError struct {
+	Code   int
+	Stderr string
+}
+
+// Error string
+func (e *CmdExitError) Error() string {
+	return "exit status " + strconv.Itoa(e.Code)
+}
+
+// ExitCode get
+func (e *CmdExitError) ExitCode() int {
+	return e.Code
+}
+
+var (
+	stubMu    sync.Mutex
+	cmdStubs  = map[string]CmdHandler{}
+	cmdCalls  []CmdCall
+	oldRunner sysutil.CmdRunnerFunc
+)
+
+// RegisterCmdStub register a stub handler for the command name, the sysutil.Cmd
+// run the command will route to the handler, and record the invocations.
+// the command not registered will be run as normal.
+//
+// the sysutil.ExecCmd, ExecLine, QuickExec and ShellExec also run by the sysutil.Cmd, can be stubbed.
+// for ShellExec, should register the shell name. eg: "sh"
+//
+// NOTE: should call ResetCmdStubs on test end for restore.
+//
+// Usage:
+// 	testutil.RegisterCmdStub("git", func(call testutil.CmdCall) (string, string, int) {
+// 		return "main\n", "", 0
+// 	})
+// 	defer testutil.ResetCmdStubs()
+//
+// 	out, err := sysutil.NewCmd("git", "branch", "--show-current").Output()
+// 	// out: "main\n"
+func RegisterCmdStub(name string, handler CmdHandler) {
+	stubMu.Lock()
+	defer stubMu.Unlock()
+
+	if oldRunner == nil {
+		oldRunner = sysutil.SetCmdRunner(runStubbedCmd)
+	}
+	cmdStubs[name] = handler
+}
+
+// CmdOutput create a CmdHandler returns the fixed stdout and exit code.
+func CmdOutput(stdout string, exitCode ...int) CmdHandler {
+	code := 0
+	if len(exitCode) > 0 {
+		code = exitCode[0]
+	}
+
+	return func(_ CmdCall) (string, string, int) {
+		return stdout, "", code
+	}
+}
+
+// CmdStubCalls get the recorded invocations of the stubbed commands.
+// if name is not empty, only returns the calls of the command.
+func CmdStubCalls(name string) []CmdCall {
+	stubMu.Lock()
+	defer stubMu.Unlock()
+
+	calls := make([]CmdCall, 0, len(cmdCalls))
+	for _, call := range cmdCalls {
+		if name == "" || call.Name == name {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// ResetCmdStubs clear all stubs and recorded calls, restore the runner of the sysutil.Cmd
+func ResetCmdStubs() {
+	stubMu.Lock()
+	defer stubMu.Unlock()
+
+	if oldRunner != nil {
+		sysutil.SetCmdRunner(oldRunner)
+		oldRunner = nil
+	}
+
+	cmdStubs = map[string]CmdHandler{}
+	cmdCalls = nil
+}
+
+func runStubbedCmd(c *sysutil.Cmd) (stdout, stderr string, err error) {
+	stubMu.Lock()
+	name := c.Name
+	handler, ok := cmdStubs[name]
+	if !ok {
+		// eg: "/usr/bin/git" => "git"
+		name = filepath.Base(c.Name)
+		handler, ok = cmdStubs[name]
+	}
+
+	if !ok {
+		runner := oldRunner
+		stubMu.Unlock()
+
+		// the stubs has been reset by ResetCmdStubs()
+		if runner == nil {
+			runner = sysutil.RunCmd
+		}
+		return runner(c)
+	}
+
+	call := CmdCall{
+		Name: name,
+		Args: append([]string(nil), c.Args...),
+		Dir:  c.Dir,
+		Env:  append([]string(nil), c.Env...),
+	}
+	cmdCalls = append(cmdCalls, call)
+	stubMu.Unlock()
+
+	stdout, stderr, code := handler(call)
+	if code != 0 {
+		err = &CmdExitError{Code: code, Stderr: stderr}
+	}
+	return
+}
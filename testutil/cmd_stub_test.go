@@ -0,0 +1,99 @@
+package testutil_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gookit/goutil/sysutil"
+	"github.com/gookit/goutil/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegisterCmdStub(t *testing.T) {
+	defer testutil.ResetCmdStubs()
+
+	testutil.RegisterCmdStub("git", func(call testutil.CmdCall) (string, string, int) {
+		if len(call.Args) > 0 && call.Args[0] == "push" {
+			return "", "permission denied", 128
+		}
+		return "main\n", "", 0
+	})
+	testutil.RegisterCmdStub("not-exists-bin", testutil.CmdOutput("v1.0.0"))
+
+	out, err := sysutil.NewCmd("git", "branch", "--show-current").WithDir("/path/to/repo").Output()
+	assert.NoError(t, err)
+	assert.Equal(t, "main\n", out)
+
+	_, errOut, err := sysutil.NewCmd("/usr/bin/git", "push").Exec()
+	assert.Error(t, err)
+	assert.Equal(t, 128, sysutil.ExitCode(err))
+	assert.Equal(t, "permission denied", errOut)
+
+	out, err = sysutil.NewCmd("not-exists-bin", "--version").Output()
+	assert.NoError(t, err)
+	assert.Equal(t, "v1.0.0", out)
+
+	calls := testutil.CmdStubCalls("git")
+	assert.Len(t, calls, 2)
+	assert.Equal(t, []string{"branch", "--show-current"}, calls[0].Args)
+	assert.Equal(t, "/path/to/repo", calls[0].Dir)
+	assert.Equal(t, []string{"push"}, calls[1].Args)
+	assert.Len(t, testutil.CmdStubCalls(""), 3)
+
+	// not stubbed command run as normal
+	if !sysutil.IsWindows() {
+		out, err = sysutil.NewCmd("echo", "OK").Output()
+		assert.NoError(t, err)
+		assert.Equal(t, "OK", strings.TrimSpace(out))
+		assert.Len(t, testutil.CmdStubCalls(""), 3)
+	}
+
+	testutil.ResetCmdStubs()
+	assert.Empty(t, testutil.CmdStubCalls(""))
+	_, err = sysutil.NewCmd("not-exists-bin").Output()
+	assert.Error(t, err)
+}
+
+func TestRegisterCmdStub_execHelpers(t *testing.T) {
+	defer testutil.ResetCmdStubs()
+
+	testutil.RegisterCmdStub("not-exists-bin", testutil.CmdOutput("stubbed"))
+	testutil.RegisterCmdStub("not-exists-sh", testutil.CmdOutput("shell stubbed"))
+
+	out, err := sysutil.ExecCmd("not-exists-bin", []string{"-a"}, "/tmp")
+	assert.NoError(t, err)
+	assert.Equal(t, "stubbed", out)
+
+	out, err = sysutil.ExecLine("not-exists-bin -b 'c d'")
+	assert.NoError(t, err)
+	assert.Equal(t, "stubbed", out)
+
+	out, err = sysutil.QuickExec("not-exists-bin")
+	assert.NoError(t, err)
+	assert.Equal(t, "stubbed", out)
+
+	out, err = sysutil.ShellExec("ls -al", "not-exists-sh")
+	assert.NoError(t, err)
+	assert.Equal(t, "shell stubbed", out)
+
+	calls := testutil.CmdStubCalls("not-exists-bin")
+	assert.Len(t, calls, 3)
+	assert.Equal(t, "/tmp", calls[0].Dir)
+	assert.Equal(t, []string{"-b", "c d"}, calls[1].Args)
+	assert.Equal(t, []string{"-c", "ls -al"}, testutil.CmdStubCalls("not-exists-sh")[0].Args)
+}
+
+func TestRegisterCmdStub_afterReset(t *testing.T) {
+	if sysutil.IsWindows() {
+		t.Skip("skip on windows")
+	}
+
+	testutil.RegisterCmdStub("not-exists-bin", testutil.CmdOutput("stubbed"))
+	// hold the stub runner, like a command is running on reset
+	stubRunner := sysutil.SetCmdRunner(nil)
+	testutil.ResetCmdStubs()
+
+	out, _, err := stubRunner(sysutil.NewCmd("echo", "OK"))
+	assert.NoError(t, err)
+	assert.Equal(t, "OK", strings.TrimSpace(out))
+}
@@ -0,0 +1,204 @@
+package testutil
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// RecordedRequest the request recorded by the EchoServer
+type RecordedRequest struct {
+	Method string
+	// Path of the request URL. eg: "/api/users"
+	Path   string
+	Query  url.Values
+	Header http.Header
+	Body   []byte
+}
+
+// BodyString get the body as string
+func (r *RecordedRequest) BodyString() string {
+	return string(r.Body)
+}
+
+// EchoReply the default reply data of the EchoServer
+type EchoReply struct {
+	Method  string              `json:"method"`
+	URI     string              `json:"uri"`
+	Headers map[string]string   `json:"headers"`
+	Query   map[string][]string `json:"query"`
+	Body    string              `json:"body"`
+}
+
+// MockResponse the configured response for the EchoServer
+type MockResponse struct {
+	// Status code, default is 200
+	Status  int
+	Headers M
+	Body    string
+}
+
+// EchoServer a HTTP test server, it will record all requests,
+// and reply the request info as JSON(see EchoReply) or the configured response.
+//
+// Usage:
+// 	s := testutil.NewEchoServer()
+// 	defer s.Close()
+//
+// 	s.SetResponse("GET", "/api/users", &testutil.MockResponse{Body: `[{"id": 1}]`})
+// 	resp, err := http.Get(s.URLFor("/api/users"))
+// 	s.AssertCalled(t, "GET", "/api/users")
+type EchoServer struct {
+	*httptest.Server
+
+	mu      sync.Mutex
+	records []*RecordedRequest
+	// key is "METHOD /path", the method "*" is match any method
+	responses map[string]*MockResponse
+}
+
+// NewEchoServer create and start an EchoServer
+func NewEchoServer() *EchoServer {
+	s := &EchoServer{responses: make(map[string]*MockResponse)}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+// URLFor get the full URL of the path
+func (s *EchoServer) URLFor(path string) string {
+	return s.URL + "/" + strings.TrimLeft(path, "/")
+}
+
+// SetResponse set the response for the method and path. the empty method is match any method.
+func (s *EchoServer) SetResponse(method, path string, resp *MockResponse) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.responses[routeKey(method, path)] = resp
+}
+
+// Requests get all recorded requests
+func (s *EchoServer) Requests() []*RecordedRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*RecordedRequest(nil), s.records...)
+}
+
+// LastRequest get the last recorded request, returns nil on no requests.
+func (s *EchoServer) LastRequest() *RecordedRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.records) == 0 {
+		return nil
+	}
+	return s.records[len(s.records)-1]
+}
+
+// CallCount get the number of the recorded requests by method and path. the empty method is match any method.
+func (s *EchoServer) CallCount(method, path string) (n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, r := range s.records {
+		if r.Path == path && (method == "" || strings.EqualFold(r.Method, method)) {
+			n++
+		}
+	}
+	return
+}
+
+// AssertCalled assert the method and path has been requested.
+func (s *EchoServer) AssertCalled(t testing.TB, method, path string) bool {
+	t.Helper()
+	if s.CallCount(method, path) > 0 {
+		return true
+	}
+
+	t.Errorf("EchoServer: the request %q is not called", routeKey(method, path))
+	return false
+}
+
+// AssertNotCalled assert the method and path has not been requested.
+func (s *EchoServer) AssertNotCalled(t testing.TB, method, path string) bool {
+	t.Helper()
+	if n := s.CallCount(method, path); n > 0 {
+		t.Errorf("EchoServer: the request %q should not be called, but called %d times", routeKey(method, path), n)
+		return false
+	}
+	return true
+}
+
+// Reset clear the recorded requests and the configured responses.
+func (s *EchoServer) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.records = nil
+	s.responses = make(map[string]*MockResponse)
+}
+
+func (s *EchoServer) handle(w http.ResponseWriter, r *http.Request) {
+	body, _ := ioutil.ReadAll(r.Body)
+	rr := &RecordedRequest{
+		Method: r.Method,
+		Path:   r.URL.Path,
+		Query:  r.URL.Query(),
+		Header: r.Header.Clone(),
+		Body:   body,
+	}
+
+	s.mu.Lock()
+	s.records = append(s.records, rr)
+	resp, ok := s.responses[routeKey(r.Method, r.URL.Path)]
+	if !ok {
+		resp, ok = s.responses[routeKey("", r.URL.Path)]
+	}
+	s.mu.Unlock()
+
+	if ok {
+		for k, v := range resp.Headers {
+			w.Header().Set(k, v)
+		}
+
+		if resp.Status > 0 {
+			w.WriteHeader(resp.Status)
+		}
+		_, _ = w.Write([]byte(resp.Body))
+		return
+	}
+
+	reply := &EchoReply{
+		Method:  r.Method,
+		URI:     r.URL.RequestURI(),
+		Headers: make(map[string]string, len(r.Header)),
+		Query:   rr.Query,
+		Body:    string(body),
+	}
+	for k := range r.Header {
+		reply.Headers[k] = r.Header.Get(k)
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	_ = json.NewEncoder(w).Encode(reply)
+}
+
+func routeKey(method, path string) string {
+	if method == "" {
+		method = "*"
+	}
+	return strings.ToUpper(method) + " " + path
+}
+
+// ParseEchoReply parse the response body of the EchoServer to EchoReply
+func ParseEchoReply(resp *http.Response) (*EchoReply, error) {
+	defer resp.Body.Close()
+
+	reply := &EchoReply{}
+	err := json.NewDecoder(resp.Body).Decode(reply)
+	return reply, err
+}
@@ -0,0 +1,70 @@
+package testutil_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/gookit/goutil/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEchoServer(t *testing.T) {
+	s := testutil.NewEchoServer()
+	defer s.Close()
+
+	assert.Nil(t, s.LastRequest())
+
+	resp, err := http.Post(s.URLFor("/api/users?page=2"), "text/plain", strings.NewReader("name=inhere"))
+	assert.NoError(t, err)
+
+	reply, err := testutil.ParseEchoReply(resp)
+	assert.NoError(t, err)
+	assert.Equal(t, "POST", reply.Method)
+	assert.Equal(t, "/api/users?page=2", reply.URI)
+	assert.Equal(t, "text/plain", reply.Headers["Content-Type"])
+	assert.Equal(t, []string{"2"}, reply.Query["page"])
+	assert.Equal(t, "name=inhere", reply.Body)
+
+	rr := s.LastRequest()
+	assert.Equal(t, "/api/users", rr.Path)
+	assert.Equal(t, "name=inhere", rr.BodyString())
+	assert.Equal(t, "2", rr.Query.Get("page"))
+
+	// custom response
+	s.SetResponse("GET", "/api/users", &testutil.MockResponse{
+		Status:  201,
+		Headers: testutil.M{"X-Test": "yes"},
+		Body:    `[{"id": 1}]`,
+	})
+	s.SetResponse("", "/any", &testutil.MockResponse{Body: "any"})
+
+	resp, err = http.Get(s.URLFor("api/users"))
+	assert.NoError(t, err)
+	body, _ := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	assert.Equal(t, 201, resp.StatusCode)
+	assert.Equal(t, "yes", resp.Header.Get("X-Test"))
+	assert.Equal(t, `[{"id": 1}]`, string(body))
+
+	resp, err = http.Post(s.URLFor("/any"), "", nil)
+	assert.NoError(t, err)
+	body, _ = ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	assert.Equal(t, "any", string(body))
+
+	assert.Len(t, s.Requests(), 3)
+	assert.Equal(t, 2, s.CallCount("", "/api/users"))
+	assert.Equal(t, 1, s.CallCount("get", "/api/users"))
+	assert.True(t, s.AssertCalled(t, "POST", "/any"))
+	assert.True(t, s.AssertNotCalled(t, "DELETE", "/api/users"))
+
+	mt := &mockTB{TB: t}
+	assert.False(t, s.AssertCalled(mt, "DELETE", "/api/users"))
+	assert.False(t, s.AssertNotCalled(mt, "", "/any"))
+	assert.Len(t, mt.errs, 2)
+
+	s.Reset()
+	assert.Empty(t, s.Requests())
+}
@@ -0,0 +1,209 @@
+package testutil
+
+import (
+	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// data for generate fake values
+var (
+	fakeFirstNames = []string{
+		"James", "Mary", "John", "Linda", "Robert", "Emma", "Michael", "Olivia", "David", "Sophia",
+		"William", "Ava", "Daniel", "Mia", "Thomas", "Lucy", "Henry", "Grace", "Jack", "Chloe",
+	}
+	fakeLastNames = []string{
+		"Smith", "Johnson", "Brown", "Taylor", "Miller", "Wilson", "Moore", "Clark", "Lewis", "Walker",
+		"Hall", "Allen", "Young", "King", "Wright", "Scott", "Green", "Baker", "Adams", "Nelson",
+	}
+	fakeDomains = []string{"example.com", "example.org", "example.net", "test.com", "mail.test"}
+	fakeWords   = []string{
+		"lorem", "ipsum", "dolor", "sit", "amet", "consectetur", "adipiscing", "elit", "sed", "do",
+		"eiusmod", "tempor", "incididunt", "labore", "dolore", "magna", "aliqua", "enim", "minim", "veniam",
+		"quis", "nostrud", "exercitation", "ullamco", "laboris", "nisi", "aliquip", "commodo", "duis", "aute",
+	}
+)
+
+// Faker generate random fake data, it is safe for concurrent use.
+type Faker struct {
+	mu sync.Mutex
+	rd *rand.Rand
+}
+
+// NewFaker create a faker. can give a seed for reproducible data, default use current time.
+func NewFaker(seed ...int64) *Faker {
+	sd := time.Now().UnixNano()
+	if len(seed) > 0 {
+		sd = seed[0]
+	}
+	return &Faker{rd: rand.New(rand.NewSource(sd))}
+}
+
+// std faker for the package functions
+var stdFaker = NewFaker()
+
+// SeedFaker reset the std faker by the seed, for reproducible data.
+func SeedFaker(seed int64) {
+	stdFaker.mu.Lock()
+	stdFaker.rd = rand.New(rand.NewSource(seed))
+	stdFaker.mu.Unlock()
+}
+
+// Intn return a random int at [0, n)
+func (f *Faker) Intn(n int) int {
+	if n <= 0 {
+		return 0
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.rd.Intn(n)
+}
+
+// Int return a random int at [min, max]
+func (f *Faker) Int(min, max int) int {
+	if max <= min {
+		return min
+	}
+	return min + f.Intn(max-min+1)
+}
+
+// Float return a random float64 at [min, max)
+func (f *Faker) Float(min, max float64) float64 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return min + f.rd.Float64()*(max-min)
+}
+
+// Bool return a random bool value
+func (f *Faker) Bool() bool {
+	return f.Intn(2) == 1
+}
+
+// Pick a random element from the list
+func (f *Faker) Pick(list []string) string {
+	if len(list) == 0 {
+		return ""
+	}
+	return list[f.Intn(len(list))]
+}
+
+// FirstName return a random first name. eg: "John"
+func (f *Faker) FirstName() string {
+	return f.Pick(fakeFirstNames)
+}
+
+// LastName return a random last name. eg: "Smith"
+func (f *Faker) LastName() string {
+	return f.Pick(fakeLastNames)
+}
+
+// Name return a random full name. eg: "John Smith"
+func (f *Faker) Name() string {
+	return f.FirstName() + " " + f.LastName()
+}
+
+// Username return a random username. eg: "john.smith12"
+func (f *Faker) Username() string {
+	return strings.ToLower(f.FirstName()+"."+f.LastName()) + strconv.Itoa(f.Intn(100))
+}
+
+// Domain return a random reserved domain. eg: "example.com"
+func (f *Faker) Domain() string {
+	return f.Pick(fakeDomains)
+}
+
+// Email return a random email. eg: "john.smith12@example.com"
+func (f *Faker) Email() string {
+	return f.Username() + "@" + f.Domain()
+}
+
+// URL return a random url. eg: "https://example.com/lorem/ipsum"
+func (f *Faker) URL() string {
+	return "https://" + f.Domain() + "/" + f.Word() + "/" + f.Word()
+}
+
+// Phone return a random phone number. eg: "+1-555-0123-4567"
+func (f *Faker) Phone() string {
+	return fmt.Sprintf("+1-555-%04d-%04d", f.Intn(10000), f.Intn(10000))
+}
+
+// IPv4 return a random IPv4 address in private network 10.0.0.0/8
+func (f *Faker) IPv4() string {
+	return fmt.Sprintf("10.%d.%d.%d", f.Intn(256), f.Intn(256), f.Int(1, 254))
+}
+
+// IPv6 return a random IPv6 address in documentation network 2001:db8::/32
+func (f *Faker) IPv6() string {
+	return fmt.Sprintf("2001:db8:%x:%x:%x:%x:%x:%x",
+		f.Intn(65536), f.Intn(65536), f.Intn(65536), f.Intn(65536), f.Intn(65536), f.Intn(65536))
+}
+
+// UUID return a random UUID v4 string. eg: "9b1deb4d-3b7d-4bad-9bdd-2b0d7b3dcb6d"
+func (f *Faker) UUID() string {
+	bs := make([]byte, 16)
+	f.mu.Lock()
+	_, _ = f.rd.Read(bs)
+	f.mu.Unlock()
+
+	bs[6] = (bs[6] & 0x0f) | 0x40 // version 4
+	bs[8] = (bs[8] & 0x3f) | 0x80 // variant RFC4122
+	return fmt.Sprintf("%x-%x-%x-%x-%x", bs[0:4], bs[4:6], bs[6:8], bs[8:10], bs[10:])
+}
+
+// Word return a random word. eg: "lorem"
+func (f *Faker) Word() string {
+	return f.Pick(fakeWords)
+}
+
+// Words return n random words
+func (f *Faker) Words(n int) []string {
+	ws := make([]string, n)
+	for i := range ws {
+		ws[i] = f.Word()
+	}
+	return ws
+}
+
+// Sentence return a random sentence. the words number default is random at [4, 10]
+//
+// eg: "Lorem dolor sit amet magna."
+func (f *Faker) Sentence(words ...int) string {
+	n := f.Int(4, 10)
+	if len(words) > 0 && words[0] > 0 {
+		n = words[0]
+	}
+
+	s := strings.Join(f.Words(n), " ")
+	return strings.ToUpper(s[:1]) + s[1:] + "."
+}
+
+// Time return a random time in the past year
+func (f *Faker) Time() time.Time {
+	ago := time.Duration(f.Intn(365*24*3600)) * time.Second
+	return time.Now().Add(-ago).Truncate(time.Second)
+}
+
+// FakeName return a random full name. see Faker.Name
+func FakeName() string { return stdFaker.Name() }
+
+// FakeEmail return a random email. see Faker.Email
+func FakeEmail() string { return stdFaker.Email() }
+
+// FakeIPv4 return a random IPv4 address. see Faker.IPv4
+func FakeIPv4() string { return stdFaker.IPv4() }
+
+// FakeIPv6 return a random IPv6 address. see Faker.IPv6
+func FakeIPv6() string { return stdFaker.IPv6() }
+
+// FakeUUID return a random UUID v4 string. see Faker.UUID
+func FakeUUID() string { return stdFaker.UUID() }
+
+// FakeSentence return a random sentence. see Faker.Sentence
+func FakeSentence(words ...int) string { return stdFaker.Sentence(words...) }
+
+// FillStruct fill the zero value fields of the struct by random fake data. see Faker.FillStruct
+func FillStruct(ptr interface{}) error { return stdFaker.FillStruct(ptr) }
@@ -0,0 +1,276 @@
+package testutil
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gookit/goutil/internal/comfunc"
+)
+
+// FakeTagName the struct tag name for FillStruct
+var FakeTagName = "fake"
+
+// max depth for fill the nested struct, pointer, slice and map
+const fakeMaxDepth = 5
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// FillStruct fill the zero value fields of the struct by random fake data.
+// the nested struct, pointer, slice and map fields will be filled too.
+//
+// will guess the fake kind by the field name and type on without tag.
+// can use the tag `fake:"KIND"` or `fake:"KIND:ARGS"` for specify the kind, "-" for skip the field.
+//
+// allowed kinds:
+// 	string field: name, first_name, last_name, username, email, domain, url, phone,
+// 		ipv4(alias ip), ipv6, uuid, word, sentence(ARGS: words number), oneof(ARGS: "a|b|c")
+// 	number field: int, float. the ARGS is range "min,max". eg: `fake:"int:18,60"`
+//
+// Usage:
+// 	type User struct {
+// 		ID    string // guess as uuid
+// 		Name  string // guess as name
+// 		Email string `fake:"email"`
+// 		Age   int    `fake:"int:18,60"`
+// 		Role  string `fake:"oneof:admin|user"`
+// 	}
+//
+// 	u := &User{}
+// 	err := faker.FillStruct(u)
+func (f *Faker) FillStruct(ptr interface{}) error {
+	rv := reflect.ValueOf(ptr)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return errors.New("testutil: FillStruct require a non-nil struct pointer")
+	}
+	return f.fillStruct(rv.Elem(), 0)
+}
+
+func (f *Faker) fillStruct(sv reflect.Value, depth int) error {
+	st := sv.Type()
+	for i := 0; i < st.NumField(); i++ {
+		fd, fv := st.Field(i), sv.Field(i)
+		if !fv.CanSet() {
+			continue
+		}
+
+		// the fake args can contain comma. eg: "int:1,100"
+		tv, _ := comfunc.LookupTag(fd, FakeTagName)
+		tag := tv.Raw
+		if tag == "-" {
+			continue
+		}
+
+		// keep the exists value, the struct will fill the zero fields.
+		if fv.Kind() != reflect.Struct && fv.Kind() != reflect.Ptr && !fv.IsZero() {
+			continue
+		}
+
+		if err := f.fillValue(fv, tag, fd.Name, depth); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (f *Faker) fillValue(fv reflect.Value, tag, name string, depth int) error {
+	kind, args := tag, ""
+	if pos := strings.IndexByte(tag, ':'); pos > 0 {
+		kind, args = tag[:pos], tag[pos+1:]
+	}
+
+	if fv.Type() == timeType {
+		if fv.IsZero() {
+			fv.Set(reflect.ValueOf(f.Time()))
+		}
+		return nil
+	}
+
+	switch fv.Kind() {
+	case reflect.Ptr:
+		if depth >= fakeMaxDepth {
+			return nil
+		}
+
+		if fv.IsNil() {
+			nv := reflect.New(fv.Type().Elem())
+			if err := f.fillValue(nv.Elem(), tag, name, depth+1); err != nil {
+				return err
+			}
+			fv.Set(nv)
+			return nil
+		}
+		return f.fillValue(fv.Elem(), tag, name, depth+1)
+	case reflect.Struct:
+		if depth >= fakeMaxDepth {
+			return nil
+		}
+		return f.fillStruct(fv, depth+1)
+	case reflect.String:
+		if kind == "" {
+			kind = guessFakeKind(name)
+		}
+
+		s, err := f.stringByKind(kind, args)
+		if err != nil {
+			return fmt.Errorf("testutil: fill field %s error: %w", name, err)
+		}
+		fv.SetString(s)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		min, max, err := fakeRange(kind, args)
+		if err != nil {
+			return fmt.Errorf("testutil: fill field %s error: %w", name, err)
+		}
+
+		switch fv.Kind() {
+		case reflect.Float32, reflect.Float64:
+			fv.SetFloat(f.Float(float64(min), float64(max)))
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			fv.SetInt(int64(f.Int(min, max)))
+		default:
+			if min < 0 {
+				min = 0
+			}
+			fv.SetUint(uint64(f.Int(min, max)))
+		}
+	case reflect.Bool:
+		fv.SetBool(f.Bool())
+	case reflect.Slice:
+		if depth >= fakeMaxDepth {
+			return nil
+		}
+
+		n := f.Int(1, 3)
+		sl := reflect.MakeSlice(fv.Type(), n, n)
+		for i := 0; i < n; i++ {
+			if err := f.fillValue(sl.Index(i), tag, name, depth+1); err != nil {
+				return err
+			}
+		}
+		fv.Set(sl)
+	case reflect.Map:
+		if depth >= fakeMaxDepth || fv.Type().Key().Kind() != reflect.String {
+			return nil
+		}
+
+		mp := reflect.MakeMap(fv.Type())
+		for i := f.Int(1, 3); i > 0; i-- {
+			ev := reflect.New(fv.Type().Elem()).Elem()
+			if err := f.fillValue(ev, tag, name, depth+1); err != nil {
+				return err
+			}
+			mp.SetMapIndex(reflect.ValueOf(f.Word()).Convert(fv.Type().Key()), ev)
+		}
+		fv.Set(mp)
+	}
+	return nil
+}
+
+func (f *Faker) stringByKind(kind, args string) (string, error) {
+	switch kind {
+	case "name":
+		return f.Name(), nil
+	case "first_name":
+		return f.FirstName(), nil
+	case "last_name":
+		return f.LastName(), nil
+	case "username":
+		return f.Username(), nil
+	case "email":
+		return f.Email(), nil
+	case "domain":
+		return f.Domain(), nil
+	case "url":
+		return f.URL(), nil
+	case "phone":
+		return f.Phone(), nil
+	case "ip", "ipv4":
+		return f.IPv4(), nil
+	case "ipv6":
+		return f.IPv6(), nil
+	case "uuid":
+		return f.UUID(), nil
+	case "word":
+		return f.Word(), nil
+	case "sentence":
+		n, _ := strconv.Atoi(args)
+		return f.Sentence(n), nil
+	case "oneof":
+		if args == "" {
+			return "", errors.New("the oneof kind must give values")
+		}
+		return f.Pick(strings.Split(args, "|")), nil
+	}
+	return "", fmt.Errorf("unknown fake kind %q for string", kind)
+}
+
+// fakeRange parse the range args for number kind, default range is [1, 100]
+func fakeRange(kind, args string) (min, max int, err error) {
+	if kind != "" && kind != "int" && kind != "float" {
+		return 0, 0, fmt.Errorf("unknown fake kind %q for number", kind)
+	}
+
+	min, max = 1, 100
+	if args == "" {
+		return
+	}
+
+	nodes := strings.SplitN(args, ",", 2)
+	if len(nodes) != 2 {
+		return 0, 0, fmt.Errorf("invalid fake range %q, must be 'min,max'", args)
+	}
+
+	if min, err = strconv.Atoi(strings.TrimSpace(nodes[0])); err == nil {
+		max, err = strconv.Atoi(strings.TrimSpace(nodes[1]))
+	}
+
+	if err != nil || max < min {
+		return 0, 0, fmt.Errorf("invalid fake range %q, must be 'min,max'", args)
+	}
+	return
+}
+
+// guessFakeKind guess the fake kind by the field name
+func guessFakeKind(name string) string {
+	if name == "ID" || strings.HasSuffix(name, "ID") || strings.HasSuffix(name, "Id") {
+		return "uuid"
+	}
+
+	lower := strings.ToLower(name)
+	switch {
+	case strings.Contains(lower, "email"):
+		return "email"
+	case strings.Contains(lower, "uuid"):
+		return "uuid"
+	case strings.Contains(lower, "ipv6"):
+		return "ipv6"
+	case lower == "ip" || strings.HasSuffix(lower, "ip") || strings.Contains(lower, "ipv4"):
+		return "ipv4"
+	case strings.Contains(lower, "firstname"):
+		return "first_name"
+	case strings.Contains(lower, "lastname"):
+		return "last_name"
+	case strings.Contains(lower, "username") || lower == "user" || lower == "login":
+		return "username"
+	case strings.Contains(lower, "name"):
+		return "name"
+	case strings.Contains(lower, "url") || strings.Contains(lower, "link") || lower == "website":
+		return "url"
+	case strings.Contains(lower, "phone") || strings.Contains(lower, "mobile"):
+		return "phone"
+	case strings.Contains(lower, "domain") || strings.Contains(lower, "host"):
+		return "domain"
+	}
+
+	for _, sub := range []string{"desc", "title", "content", "text", "comment", "summary", "message"} {
+		if strings.Contains(lower, sub) {
+			return "sentence"
+		}
+	}
+	return "word"
+}
@@ -0,0 +1,116 @@
+package testutil_test
+
+import (
+	"net"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gookit/goutil/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+var uuidReg = regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+
+func TestFakers(t *testing.T) {
+	assert.Len(t, strings.Split(testutil.FakeName(), " "), 2)
+	assert.Regexp(t, `^[a-z.]+\d+@[a-z.]+$`, testutil.FakeEmail())
+	assert.Regexp(t, uuidReg, testutil.FakeUUID())
+
+	ip := net.ParseIP(testutil.FakeIPv4())
+	assert.NotNil(t, ip)
+	assert.NotNil(t, ip.To4())
+
+	ip = net.ParseIP(testutil.FakeIPv6())
+	assert.NotNil(t, ip)
+	assert.Nil(t, ip.To4())
+
+	s := testutil.FakeSentence(5)
+	assert.Len(t, strings.Split(s, " "), 5)
+	assert.True(t, strings.HasSuffix(s, "."))
+
+	// reproducible by seed
+	f1, f2 := testutil.NewFaker(23), testutil.NewFaker(23)
+	assert.Equal(t, f1.Name(), f2.Name())
+	assert.Equal(t, f1.UUID(), f2.UUID())
+	assert.Equal(t, f1.Sentence(), f2.Sentence())
+
+	n := f1.Int(3, 5)
+	assert.True(t, n >= 3 && n <= 5)
+}
+
+type fakeAddress struct {
+	City    string `fake:"oneof:Berlin|Paris"`
+	Website string
+}
+
+type fakeUser struct {
+	ID        string
+	FirstName string
+	Email     string
+	ClientIP  string
+	Age       int     `fake:"int:18,60"`
+	Score     float64 `fake:"float:0,10"`
+	Level     uint8
+	Role      string `fake:"oneof:admin|user"`
+	Remark    string `fake:"-"`
+	Desc      string `fake:"sentence:3"`
+	Active    bool
+	Tags      []string `fake:"word"`
+	Extra     map[string]int
+	Created   time.Time
+	Address   fakeAddress
+	Home      *fakeAddress
+	Parent    *fakeUser
+	internal  string
+}
+
+func TestFillStruct(t *testing.T) {
+	u := &fakeUser{Email: "inhere@example.com"}
+	assert.NoError(t, testutil.FillStruct(u))
+
+	assert.Regexp(t, uuidReg, u.ID)
+	assert.NotEmpty(t, u.FirstName)
+	assert.NotContains(t, u.FirstName, " ")
+	assert.Equal(t, "inhere@example.com", u.Email) // keep exists value
+	assert.NotNil(t, net.ParseIP(u.ClientIP))
+	assert.True(t, u.Age >= 18 && u.Age <= 60)
+	assert.True(t, u.Score >= 0 && u.Score < 10)
+	assert.NotZero(t, u.Level)
+	assert.Contains(t, []string{"admin", "user"}, u.Role)
+	assert.Empty(t, u.Remark)
+	assert.Len(t, strings.Split(u.Desc, " "), 3)
+	assert.NotEmpty(t, u.Tags)
+	assert.NotEmpty(t, u.Extra)
+	assert.False(t, u.Created.IsZero())
+	assert.Contains(t, []string{"Berlin", "Paris"}, u.Address.City)
+	assert.True(t, strings.HasPrefix(u.Address.Website, "https://"))
+	assert.NotNil(t, u.Home)
+	assert.NotEmpty(t, u.Home.City)
+	assert.Empty(t, u.internal)
+
+	// self reference is limited by depth
+	assert.NotNil(t, u.Parent)
+	assert.NotNil(t, u.Parent.Parent)
+}
+
+func TestFillStruct_error(t *testing.T) {
+	assert.Error(t, testutil.FillStruct(fakeUser{}))
+	assert.Error(t, testutil.FillStruct(nil))
+
+	st := &struct {
+		Name string `fake:"unknown"`
+	}{}
+	assert.EqualError(t, testutil.FillStruct(st), `testutil: fill field Name error: unknown fake kind "unknown" for string`)
+
+	st1 := &struct {
+		Age int `fake:"int:60,18"`
+	}{}
+	assert.ErrorContains(t, testutil.FillStruct(st1), "invalid fake range")
+
+	st2 := &struct {
+		Age int `fake:"email"`
+	}{}
+	assert.ErrorContains(t, testutil.FillStruct(st2), `unknown fake kind "email" for number`)
+}
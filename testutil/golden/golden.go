@@ -0,0 +1,154 @@
+// Package golden provides the golden file testing support.
+//
+// run the tests with the "-update" flag, or the ENV GOLDEN_UPDATE=true for rewrite the golden files:
+// 	go test ./... -update
+package golden
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/gookit/goutil/strutil"
+	"github.com/gookit/goutil/testutil/assert"
+)
+
+// UpdateEnv the ENV name for update the golden files
+const UpdateEnv = "GOLDEN_UPDATE"
+
+func init() {
+	// avoid panic on the flag has been defined by other package.
+	if flag.Lookup("update") == nil {
+		flag.Bool("update", false, "update the golden files")
+	}
+}
+
+// IsUpdate check is update mode. by the "-update" flag or the ENV GOLDEN_UPDATE=true
+func IsUpdate() bool {
+	// read from the flag set, the flag maybe registered by other package.
+	if fg := flag.Lookup("update"); fg != nil {
+		if bl, _ := strutil.ToBool(fg.Value.String()); bl {
+			return true
+		}
+	}
+
+	bl, _ := strutil.ToBool(os.Getenv(UpdateEnv))
+	return bl
+}
+
+// Normalizer for normalize the contents before compare. eg: trim timestamps
+type Normalizer func(data []byte) []byte
+
+var timestampRegex = regexp.MustCompile(`\d{4}-\d{2}-\d{2}[T ]\d{2}:\d{2}:\d{2}(\.\d+)?(Z|[+-]\d{2}:?\d{2})?`)
+
+// TrimTimestamps replace the timestamps to "<TIMESTAMP>". eg: "2022-08-01 10:00:00", "2022-08-01T10:00:00Z"
+func TrimTimestamps(data []byte) []byte {
+	return timestampRegex.ReplaceAll(data, []byte("<TIMESTAMP>"))
+}
+
+// NormalizeNewlines replace the "\r\n" to "\n"
+func NormalizeNewlines(data []byte) []byte {
+	return bytes.Replace(data, []byte("\r\n"), []byte("\n"), -1)
+}
+
+// ReplaceAll create a Normalizer for replace the old string to new.
+func ReplaceAll(old, new string) Normalizer {
+	return func(data []byte) []byte {
+		return bytes.Replace(data, []byte(old), []byte(new), -1)
+	}
+}
+
+// RegexpReplace create a Normalizer for replace the matched contents to repl.
+func RegexpReplace(pattern, repl string) Normalizer {
+	reg := regexp.MustCompile(pattern)
+	return func(data []byte) []byte {
+		return reg.ReplaceAll(data, []byte(repl))
+	}
+}
+
+// Assert the got contents is equals to the golden file contents.
+// the got can be string or []byte, other types will be converted by fmt.Sprint.
+// on update mode, will write the got contents to the golden file.
+//
+// the normalizers will be applied to both the got and golden contents.
+//
+// Usage:
+// 	golden.Assert(t, output, "testdata/render.golden", golden.TrimTimestamps)
+func Assert(t assert.TestingT, got interface{}, file string, normalizers ...Normalizer) bool {
+	t.Helper()
+
+	var data []byte
+	switch typVal := got.(type) {
+	case []byte:
+		data = typVal
+	case string:
+		data = []byte(typVal)
+	default:
+		data = []byte(fmt.Sprint(got))
+	}
+
+	if IsUpdate() {
+		if err := Update(file, data); err != nil {
+			t.Errorf("golden: update the file %q error: %s", file, err.Error())
+			return false
+		}
+		return true
+	}
+
+	want, err := ioutil.ReadFile(file)
+	if err != nil {
+		t.Errorf("golden: read the file error: %s\n(run tests with -update flag for create it)", err.Error())
+		return false
+	}
+
+	for _, fn := range normalizers {
+		data = fn(data)
+		want = fn(want)
+	}
+
+	if bytes.Equal(want, data) {
+		return true
+	}
+
+	t.Errorf("golden: the contents is not match the file %q\n%s\n(run tests with -update flag for update it)", file, diffBytes(want, data))
+	return false
+}
+
+// Update write the data to the golden file, will create the parent dir.
+func Update(file string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(file, data, 0644)
+}
+
+// diffBytes render the differences. for binary data, only show the first different position.
+func diffBytes(want, give []byte) string {
+	if utf8.Valid(want) && utf8.Valid(give) && bytes.IndexByte(want, 0) < 0 && bytes.IndexByte(give, 0) < 0 {
+		var sb strings.Builder
+		for _, dl := range strutil.DiffLines(string(want), string(give)) {
+			if dl.Type != strutil.DiffKeep {
+				sb.WriteString(dl.String() + "\n")
+			}
+		}
+
+		// only the trailing newline is different
+		if sb.Len() == 0 {
+			return "- " + strconv.Quote(string(want)) + "\n+ " + strconv.Quote(string(give))
+		}
+		return strings.TrimRight(sb.String(), "\n")
+	}
+
+	pos := 0
+	for pos < len(want) && pos < len(give) && want[pos] == give[pos] {
+		pos++
+	}
+	return fmt.Sprintf("binary contents differ at byte %d (want len: %d, give len: %d)", pos, len(want), len(give))
+}
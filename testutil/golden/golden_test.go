@@ -0,0 +1,70 @@
+package golden_test
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gookit/goutil/testutil"
+	"github.com/gookit/goutil/testutil/golden"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockT struct {
+	msgs []string
+}
+
+func (m *mockT) Helper() {}
+
+func (m *mockT) Errorf(format string, args ...interface{}) {
+	m.msgs = append(m.msgs, fmt.Sprintf(format, args...))
+}
+
+func TestAssert(t *testing.T) {
+	dir, err := ioutil.TempDir("", "golden")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	file := filepath.Join(dir, "sub/render.golden")
+	mt := &mockT{}
+
+	// not exists
+	assert.False(t, golden.Assert(mt, "hello", file))
+	assert.Contains(t, mt.msgs[0], "-update")
+
+	testutil.MockEnvValue(golden.UpdateEnv, "true", func(_ string) {
+		assert.True(t, golden.IsUpdate())
+		assert.True(t, golden.Assert(mt, "time: 2022-08-01 10:00:00\nline2\n", file))
+	})
+	assert.False(t, golden.IsUpdate())
+
+	// by the flag, it maybe registered by other package.
+	assert.NoError(t, flag.Set("update", "true"))
+	assert.True(t, golden.IsUpdate())
+	assert.NoError(t, flag.Set("update", "false"))
+	assert.False(t, golden.IsUpdate())
+
+	assert.True(t, golden.Assert(mt, []byte("time: 2022-08-01 10:00:00\nline2\n"), file))
+	assert.True(t, golden.Assert(mt, "time: 2023-01-02T11:00:00Z\r\nline2\r\n", file, golden.TrimTimestamps, golden.NormalizeNewlines))
+	assert.Len(t, mt.msgs, 1)
+
+	assert.False(t, golden.Assert(mt, "time: 2022-08-01 10:00:00\nline3\n", file))
+	assert.Contains(t, mt.msgs[1], "- line2\n+ line3")
+
+	assert.True(t, golden.Assert(mt, "time: 2022-08-01 10:00:00\nline3\n", file, golden.ReplaceAll("line3", "line2")))
+	assert.True(t, golden.Assert(mt, "time: 2022-08-01 10:00:00\nline9\n", file, golden.RegexpReplace(`line\d`, "line")))
+
+	assert.False(t, golden.Assert(mt, "time: 2022-08-01 10:00:00\nline2", file))
+	assert.Contains(t, mt.msgs[2], `+ "time: 2022-08-01 10:00:00\nline2"`)
+
+	// binary
+	assert.NoError(t, golden.Update(file, []byte{0, 1, 2, 3}))
+	assert.True(t, golden.Assert(mt, []byte{0, 1, 2, 3}, file))
+	assert.False(t, golden.Assert(mt, []byte{0, 1, 5}, file))
+	assert.Contains(t, mt.msgs[3], "binary contents differ at byte 2 (want len: 4, give len: 3)")
+
+	assert.False(t, golden.Assert(mt, 123, file))
+}
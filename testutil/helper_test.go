@@ -0,0 +1,31 @@
+package testutil_test
+
+import (
+	"fmt"
+	"testing"
+)
+
+// mockTB record the error messages, instead of fail the test
+type mockTB struct {
+	testing.TB
+	errs     []string
+	cleanups []func()
+}
+
+func (m *mockTB) Helper() {}
+
+func (m *mockTB) Cleanup(fn func()) {
+	m.cleanups = append(m.cleanups, fn)
+}
+
+// runCleanups like the test end
+func (m *mockTB) runCleanups() {
+	for i := len(m.cleanups) - 1; i >= 0; i-- {
+		m.cleanups[i]()
+	}
+	m.cleanups = nil
+}
+
+func (m *mockTB) Errorf(format string, args ...interface{}) {
+	m.errs = append(m.errs, fmt.Sprintf(format, args...))
+}
@@ -0,0 +1,146 @@
+package testutil
+
+import (
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+)
+
+// LeakWaitTimeout max wait time for the goroutines exit on check leak.
+var LeakWaitTimeout = time.Second
+
+// ignored goroutines on check leak, match by the stack contents.
+var leakIgnores = []string{
+	"testing.(*T).Run(",
+	"testing.(*T).Parallel(",
+	"testing.runTests(",
+	"testing.tRunner.func1(",
+	"created by os/signal.Notify",
+	"os/signal.signal_recv(",
+	"runtime.goexit0(",
+	"runtime.ensureSigM(",
+}
+
+// AssertNoGoroutineLeak snapshot the goroutines, will check the new goroutines exited on test end by t.Cleanup.
+// will report the stack of the leaked goroutines.
+//
+// NOTE: should call it at the first of the test, the cleanup funcs are called in last added, first called order.
+// and it cannot use for the parallel tests.
+//
+// Usage:
+// 	func TestWorker(t *testing.T) {
+// 		testutil.AssertNoGoroutineLeak(t)
+//
+// 		w := NewWorker()
+// 		defer w.Stop()
+// 		...
+// 	}
+func AssertNoGoroutineLeak(t testing.TB) {
+	t.Helper()
+
+	before := make(map[string]bool)
+	for id := range goroutineStacks() {
+		before[id] = true
+	}
+
+	t.Cleanup(func() {
+		t.Helper()
+
+		var leaked []string
+		deadline := time.Now().Add(LeakWaitTimeout)
+		for {
+			leaked = leaked[:0]
+			for id, stack := range goroutineStacks() {
+				if !before[id] {
+					leaked = append(leaked, stack)
+				}
+			}
+
+			if len(leaked) == 0 || time.Now().After(deadline) {
+				break
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+
+		if len(leaked) > 0 {
+			t.Errorf("found %d leaked goroutines:\n\n%s", len(leaked), strings.Join(leaked, "\n\n"))
+		}
+	})
+}
+
+// goroutineStacks get the goroutine id => stack map, exclude the current and ignored goroutines.
+func goroutineStacks() map[string]string {
+	buf := make([]byte, 64<<10)
+	for {
+		n := runtime.Stack(buf, true)
+		if n < len(buf) {
+			buf = buf[:n]
+			break
+		}
+		buf = make([]byte, 2*len(buf))
+	}
+
+	stacks := make(map[string]string)
+	// the first is current goroutine.
+	for _, stack := range strings.Split(string(buf), "\n\n")[1:] {
+		// eg: "goroutine 18 [chan receive]:"
+		header := stack
+		if pos := strings.IndexByte(stack, '\n'); pos > 0 {
+			header = stack[:pos]
+		}
+
+		fields := strings.Fields(header)
+		if len(fields) < 2 || fields[0] != "goroutine" || isIgnoredStack(stack) {
+			continue
+		}
+		stacks[fields[1]] = stack
+	}
+	return stacks
+}
+
+func isIgnoredStack(stack string) bool {
+	for _, sub := range leakIgnores {
+		if strings.Contains(stack, sub) {
+			return true
+		}
+	}
+	return false
+}
+
+// Eventually wait the cond returns true, will check the cond by the interval until timeout.
+// will report error and returns false on timeout.
+//
+// Usage:
+// 	testutil.Eventually(t, func() bool {
+// 		return srv.Ready()
+// 	}, time.Second, 10*time.Millisecond)
+func Eventually(t testing.TB, cond func() bool, timeout, interval time.Duration) bool {
+	t.Helper()
+
+	if cond() {
+		return true
+	}
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-timer.C:
+			// last check
+			if cond() {
+				return true
+			}
+
+			t.Errorf("the condition not satisfied in %s", timeout)
+			return false
+		case <-ticker.C:
+			if cond() {
+				return true
+			}
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package testutil_test
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gookit/goutil/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAssertNoGoroutineLeak(t *testing.T) {
+	old := testutil.LeakWaitTimeout
+	testutil.LeakWaitTimeout = 100 * time.Millisecond
+	defer func() {
+		testutil.LeakWaitTimeout = old
+	}()
+
+	// no leak
+	mt := &mockTB{TB: t}
+	testutil.AssertNoGoroutineLeak(mt)
+	done := make(chan struct{})
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		close(done)
+	}()
+	mt.runCleanups()
+	assert.Empty(t, mt.errs)
+
+	// leaked
+	mt = &mockTB{TB: t}
+	testutil.AssertNoGoroutineLeak(mt)
+	stop := make(chan struct{})
+	go leakedWorker(stop)
+	mt.runCleanups()
+	close(stop)
+
+	assert.Len(t, mt.errs, 1)
+	assert.Contains(t, mt.errs[0], "found 1 leaked goroutines")
+	assert.Contains(t, mt.errs[0], "leakedWorker")
+}
+
+func leakedWorker(stop chan struct{}) {
+	<-stop
+}
+
+func TestEventually(t *testing.T) {
+	var n int32
+	go func() {
+		time.Sleep(30 * time.Millisecond)
+		atomic.StoreInt32(&n, 1)
+	}()
+
+	ok := testutil.Eventually(t, func() bool {
+		return atomic.LoadInt32(&n) == 1
+	}, time.Second, 5*time.Millisecond)
+	assert.True(t, ok)
+
+	mt := &mockTB{TB: t}
+	ok = testutil.Eventually(mt, func() bool {
+		return false
+	}, 30*time.Millisecond, 5*time.Millisecond)
+	assert.False(t, ok)
+	assert.Equal(t, []string{"the condition not satisfied in 30ms"}, mt.errs)
+}
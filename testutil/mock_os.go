@@ -0,0 +1,66 @@
+package testutil
+
+import (
+	"os"
+	"sync"
+
+	"github.com/gookit/goutil/sysutil"
+)
+
+// exitMu guard the replace of sysutil.ExitFunc
+var exitMu sync.Mutex
+
+// exitSignal panic value for stop the fn on call exit
+type exitSignal struct {
+	code int
+}
+
+// MockOsExit run the fn and capture the exit code instead of exiting.
+// the code under test should call sysutil.Exit instead of os.Exit.
+//
+// the fn will be stopped on call exit, returns exited=false if the fn not call exit.
+//
+// Usage:
+// 	code, exited := testutil.MockOsExit(func() {
+// 		sysutil.Exit(2)
+// 	})
+// 	// code: 2, exited: true
+func MockOsExit(fn func()) (code int, exited bool) {
+	exitMu.Lock()
+	defer exitMu.Unlock()
+
+	old := sysutil.ExitFunc
+	sysutil.ExitFunc = func(code int) {
+		panic(exitSignal{code: code})
+	}
+
+	defer func() {
+		sysutil.ExitFunc = old
+		if err := recover(); err != nil {
+			sig, ok := err.(exitSignal)
+			if !ok {
+				panic(err) // re-panic other error
+			}
+			code, exited = sig.code, true
+		}
+	}()
+
+	fn()
+	return
+}
+
+// MockOsArgs set the os.Args for run the fn, will restore old args on end.
+//
+// Usage:
+// 	testutil.MockOsArgs([]string{"app", "serve", "--port", "8080"}, func() {
+// 		main()
+// 	})
+func MockOsArgs(args []string, fn func()) {
+	old := os.Args
+	os.Args = args
+	defer func() {
+		os.Args = old
+	}()
+
+	fn()
+}
@@ -0,0 +1,50 @@
+package testutil_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/gookit/goutil/sysutil"
+	"github.com/gookit/goutil/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMockOsExit(t *testing.T) {
+	var after bool
+	code, exited := testutil.MockOsExit(func() {
+		sysutil.Exit(2)
+		after = true
+	})
+	assert.True(t, exited)
+	assert.Equal(t, 2, code)
+	assert.False(t, after)
+
+	code, exited = testutil.MockOsExit(func() {})
+	assert.False(t, exited)
+	assert.Equal(t, 0, code)
+
+	// other panic
+	assert.PanicsWithValue(t, "error", func() {
+		testutil.MockOsExit(func() {
+			panic("error")
+		})
+	})
+}
+
+func TestMockOsArgs(t *testing.T) {
+	old := os.Args
+	testutil.MockOsArgs([]string{"app", "serve", "--port", "8080"}, func() {
+		assert.Equal(t, "app", sysutil.BinFile())
+		assert.Len(t, os.Args, 4)
+
+		// end-to-end like test
+		code, exited := testutil.MockOsExit(func() {
+			if len(os.Args) < 5 {
+				sysutil.Exit(1)
+			}
+		})
+		assert.True(t, exited)
+		assert.Equal(t, 1, code)
+	})
+	assert.Equal(t, old, os.Args)
+}
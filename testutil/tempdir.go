@@ -0,0 +1,140 @@
+package testutil
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TempDir a temp workspace for filesystem tests, will be removed on test end.
+type TempDir struct {
+	t testing.TB
+	// Dir the temp dir path
+	Dir string
+}
+
+// NewTempDir create a temp workspace dir, it will be removed by t.Cleanup.
+//
+// Usage:
+// 	td := testutil.NewTempDir(t)
+// 	td.WriteFile("conf/app.ini", "name = app")
+// 	td.Mkdir("logs")
+// 	cfgFile := td.Path("conf/app.ini")
+func NewTempDir(t testing.TB) *TempDir {
+	t.Helper()
+
+	dir, err := ioutil.TempDir("", "goutil-test-")
+	if err != nil {
+		t.Fatalf("testutil: create temp dir error: %v", err)
+	}
+
+	t.Cleanup(func() {
+		_ = os.RemoveAll(dir)
+	})
+	return &TempDir{t: t, Dir: dir}
+}
+
+// Path get the full path of the relative path. rel use '/' as separator.
+func (d *TempDir) Path(rel ...string) string {
+	if len(rel) == 0 {
+		return d.Dir
+	}
+	return filepath.Join(d.Dir, filepath.FromSlash(filepath.Join(rel...)))
+}
+
+// Mkdir create the dir and the parent dirs, returns the full path.
+func (d *TempDir) Mkdir(rel string) string {
+	d.t.Helper()
+
+	dir := d.Path(rel)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		d.t.Fatalf("testutil: create dir %q error: %v", rel, err)
+	}
+	return dir
+}
+
+// WriteFile write contents to the file, will create the parent dirs. returns the full path.
+//
+// allow contents type: string, []byte
+func (d *TempDir) WriteFile(rel string, contents interface{}) string {
+	d.t.Helper()
+
+	var data []byte
+	switch typVal := contents.(type) {
+	case string:
+		data = []byte(typVal)
+	case []byte:
+		data = typVal
+	default:
+		d.t.Fatalf("testutil: invalid contents type %T for write file", contents)
+	}
+
+	file := d.Path(rel)
+	if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+		d.t.Fatalf("testutil: create dir for %q error: %v", rel, err)
+	}
+
+	if err := ioutil.WriteFile(file, data, 0644); err != nil {
+		d.t.Fatalf("testutil: write file %q error: %v", rel, err)
+	}
+	return file
+}
+
+// ReadFile read the file contents as string
+func (d *TempDir) ReadFile(rel string) string {
+	d.t.Helper()
+
+	bs, err := ioutil.ReadFile(d.Path(rel))
+	if err != nil {
+		d.t.Fatalf("testutil: read file %q error: %v", rel, err)
+	}
+	return string(bs)
+}
+
+// Exists check the file or dir is exists
+func (d *TempDir) Exists(rel string) bool {
+	_, err := os.Stat(d.Path(rel))
+	return err == nil
+}
+
+// Chdir change the workdir to the temp dir, will restore on test end.
+func (d *TempDir) Chdir() {
+	d.t.Helper()
+	Chdir(d.t, d.Dir)
+}
+
+// Chdir change the workdir to the dir, will restore old workdir by t.Cleanup.
+func Chdir(t testing.TB, dir string) {
+	t.Helper()
+
+	old, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("testutil: get workdir error: %v", err)
+	}
+
+	if err := os.Chdir(dir); err != nil {
+		t.Fatalf("testutil: change workdir to %q error: %v", dir, err)
+	}
+
+	t.Cleanup(func() {
+		_ = os.Chdir(old)
+	})
+}
+
+// MockChdir change the workdir to the dir for run the fn, will restore old workdir on end.
+func MockChdir(dir string, fn func()) {
+	old, err := os.Getwd()
+	if err != nil {
+		panic(err)
+	}
+
+	if err := os.Chdir(dir); err != nil {
+		panic(err)
+	}
+
+	defer func() {
+		_ = os.Chdir(old)
+	}()
+	fn()
+}
@@ -0,0 +1,59 @@
+package testutil_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gookit/goutil/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewTempDir(t *testing.T) {
+	var dir string
+	t.Run("workspace", func(t *testing.T) {
+		td := testutil.NewTempDir(t)
+		dir = td.Dir
+		assert.DirExists(t, dir)
+
+		file := td.WriteFile("conf/app.ini", "name = app")
+		assert.Equal(t, filepath.Join(dir, "conf", "app.ini"), file)
+		assert.Equal(t, file, td.Path("conf", "app.ini"))
+		assert.Equal(t, "name = app", td.ReadFile("conf/app.ini"))
+
+		td.WriteFile("data.bin", []byte{1, 2})
+		assert.True(t, td.Exists("data.bin"))
+
+		logDir := td.Mkdir("logs/app")
+		assert.DirExists(t, logDir)
+		assert.False(t, td.Exists("not-exist"))
+	})
+
+	// removed on the sub test end
+	assert.NoDirExists(t, dir)
+}
+
+func TestTempDir_Chdir(t *testing.T) {
+	old, err := os.Getwd()
+	assert.NoError(t, err)
+
+	t.Run("chdir", func(t *testing.T) {
+		td := testutil.NewTempDir(t)
+		td.WriteFile("app.ini", "name = app")
+		td.Chdir()
+
+		assert.FileExists(t, "app.ini")
+	})
+
+	wd, _ := os.Getwd()
+	assert.Equal(t, old, wd)
+
+	td := testutil.NewTempDir(t)
+	testutil.MockChdir(td.Dir, func() {
+		td.WriteFile("a.txt", "a")
+		assert.FileExists(t, "a.txt")
+	})
+
+	wd, _ = os.Getwd()
+	assert.Equal(t, old, wd)
+}
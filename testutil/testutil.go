@@ -128,22 +128,30 @@ func MockEnvValue(key, val string, fn func(nv string)) {
 
 // MockEnvValues will store old env value, set new val. will restore old value on end.
 func MockEnvValues(kvMap map[string]string, fn func()) {
-	backups := make(map[string]string, len(kvMap))
+	type backup struct {
+		val    string
+		exists bool
+	}
+	backups := make(map[string]backup, len(kvMap))
 
 	for key, val := range kvMap {
-		backups[key] = os.Getenv(key)
+		old, ok := os.LookupEnv(key)
+		backups[key] = backup{val: old, exists: ok}
 		_ = os.Setenv(key, val)
 	}
 
-	fn()
-
-	for key := range kvMap {
-		if old := backups[key]; old == "" {
-			_ = os.Unsetenv(key)
-		} else {
-			_ = os.Setenv(key, old)
+	// restore on fn panic too
+	defer func() {
+		for key, bak := range backups {
+			if bak.exists {
+				_ = os.Setenv(key, bak.val)
+			} else {
+				_ = os.Unsetenv(key)
+			}
 		}
-	}
+	}()
+
+	fn()
 }
 
 // MockOsEnvByText by env text string.
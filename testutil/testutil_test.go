@@ -58,3 +58,21 @@ func TestMockEnvValues(t *testing.T) {
 
 	ris.Equal("", os.Getenv("APP_COMMAND"))
 }
+
+func TestMockEnvValues_restore(t *testing.T) {
+	assert.NoError(t, os.Setenv("TEST_MOCK_EMPTY", ""))
+	defer os.Unsetenv("TEST_MOCK_EMPTY")
+
+	testutil.MockEnvValues(map[string]string{
+		"TEST_MOCK_EMPTY": "val0",
+		"TEST_MOCK_NEW":   "val1",
+	}, func() {
+		assert.Equal(t, "val0", os.Getenv("TEST_MOCK_EMPTY"))
+		assert.Equal(t, "val1", os.Getenv("TEST_MOCK_NEW"))
+	})
+
+	_, ok := os.LookupEnv("TEST_MOCK_EMPTY")
+	assert.True(t, ok)
+	_, ok = os.LookupEnv("TEST_MOCK_NEW")
+	assert.False(t, ok)
+}
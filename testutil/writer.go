@@ -0,0 +1,164 @@
+package testutil
+
+import (
+	"bytes"
+	"errors"
+	"sync"
+)
+
+// ErrWriterClosed error for write to the closed TestWriter
+var ErrWriterClosed = errors.New("testutil: write to closed writer")
+
+// TestWriter a buffer for testing, implements the io.Writer, io.StringWriter,
+// io.Closer, http.Flusher and Sync() error. it is safe for concurrent use.
+//
+// can inject errors for test the error paths of code that writes output.
+//
+// Usage:
+// 	w := testutil.NewTestWriter()
+// 	w.ErrOnWrite = errors.New("disk full")
+// 	w.FailAfter = 10 // fail after 10 bytes written
+type TestWriter struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+	// written bytes count, include the reset contents
+	written int
+	closed  bool
+
+	// ErrOnWrite error for return on write, will fail after FailAfter bytes written.
+	ErrOnWrite error
+	// FailAfter the total number of bytes can be written before return ErrOnWrite. see Written()
+	FailAfter int
+	// ErrOnClose error for return on Close()
+	ErrOnClose error
+	// ErrOnFlush error for return on Flush()
+	ErrOnFlush error
+	// ErrOnSync error for return on Sync()
+	ErrOnSync error
+
+	// CloseNum the number of Close() called
+	CloseNum int
+	// FlushNum the number of Flush() called
+	FlushNum int
+	// SyncNum the number of Sync() called
+	SyncNum int
+}
+
+// NewTestWriter instance
+func NewTestWriter() *TestWriter {
+	return &TestWriter{}
+}
+
+// Write data to the buffer, implements io.Writer
+func (w *TestWriter) Write(p []byte) (n int, err error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.closed {
+		return 0, ErrWriterClosed
+	}
+
+	if w.ErrOnWrite != nil {
+		remain := w.FailAfter - w.written
+		if remain < 0 {
+			remain = 0
+		}
+
+		if len(p) > remain {
+			n, _ = w.buf.Write(p[:remain])
+			w.written += n
+			return n, w.ErrOnWrite
+		}
+	}
+
+	n, err = w.buf.Write(p)
+	w.written += n
+	return
+}
+
+// WriteString to the buffer, implements io.StringWriter
+func (w *TestWriter) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
+
+// Close the writer, implements io.Closer. write after closed will return ErrWriterClosed
+func (w *TestWriter) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.CloseNum++
+	if w.ErrOnClose != nil {
+		return w.ErrOnClose
+	}
+
+	w.closed = true
+	return nil
+}
+
+// Flush implements http.Flusher
+func (w *TestWriter) Flush() {
+	_ = w.FlushErr()
+}
+
+// FlushErr like Flush, but returns the ErrOnFlush. eg: for bufio.Writer like interface
+func (w *TestWriter) FlushErr() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.FlushNum++
+	return w.ErrOnFlush
+}
+
+// Sync implements the syncer interface, like the os.File.Sync()
+func (w *TestWriter) Sync() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.SyncNum++
+	return w.ErrOnSync
+}
+
+// Closed check the writer is closed
+func (w *TestWriter) Closed() bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.closed
+}
+
+// Written get the total written bytes count, include the reset contents
+func (w *TestWriter) Written() int {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.written
+}
+
+// String get the buffer contents
+func (w *TestWriter) String() string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.buf.String()
+}
+
+// Bytes get a copy of the buffer contents
+func (w *TestWriter) Bytes() []byte {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return append([]byte(nil), w.buf.Bytes()...)
+}
+
+// Len get the buffer contents length
+func (w *TestWriter) Len() int {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.buf.Len()
+}
+
+// Reset the buffer contents and the closed status.
+// the injected errors and the Written() count will be kept.
+func (w *TestWriter) Reset() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.buf.Reset()
+	w.closed = false
+}
@@ -0,0 +1,76 @@
+package testutil_test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/gookit/goutil/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTestWriter(t *testing.T) {
+	w := testutil.NewTestWriter()
+
+	// check interfaces
+	var _ io.StringWriter = w
+	var _ io.WriteCloser = w
+	var _ http.Flusher = w
+	var _ interface{ Sync() error } = w
+
+	_, err := fmt.Fprint(w, "hello")
+	assert.NoError(t, err)
+	_, err = io.WriteString(w, " world")
+	assert.NoError(t, err)
+	assert.Equal(t, "hello world", w.String())
+	assert.Equal(t, 11, w.Len())
+
+	w.Flush()
+	assert.NoError(t, w.Sync())
+	assert.Equal(t, 1, w.FlushNum)
+	assert.Equal(t, 1, w.SyncNum)
+
+	assert.NoError(t, w.Close())
+	assert.True(t, w.Closed())
+	_, err = w.WriteString("abc")
+	assert.Equal(t, testutil.ErrWriterClosed, err)
+
+	w.Reset()
+	assert.Equal(t, "", w.String())
+	assert.False(t, w.Closed())
+
+	// the written count is kept after reset
+	_, err = w.WriteString("abc")
+	assert.NoError(t, err)
+	assert.Equal(t, 3, w.Len())
+	assert.Equal(t, 14, w.Written())
+}
+
+func TestTestWriter_errors(t *testing.T) {
+	w := testutil.NewTestWriter()
+	w.ErrOnWrite = errors.New("disk full")
+	w.FailAfter = 8
+
+	n, err := w.WriteString("hello")
+	assert.NoError(t, err)
+	assert.Equal(t, 5, n)
+
+	n, err = w.WriteString(" world")
+	assert.EqualError(t, err, "disk full")
+	assert.Equal(t, 3, n)
+	assert.Equal(t, "hello wo", w.String())
+	assert.Equal(t, 8, w.Written())
+
+	_, err = w.WriteString("a")
+	assert.Error(t, err)
+
+	w.ErrOnClose = errors.New("close error")
+	w.ErrOnFlush = errors.New("flush error")
+	w.ErrOnSync = errors.New("sync error")
+	assert.EqualError(t, w.Close(), "close error")
+	assert.False(t, w.Closed())
+	assert.EqualError(t, w.FlushErr(), "flush error")
+	assert.EqualError(t, w.Sync(), "sync error")
+}
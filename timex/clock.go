@@ -0,0 +1,71 @@
+package timex
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock interface for get the current time and sleep.
+// it can be replaced by SetClock, useful for testing. see testutil.FakeClock
+type Clock interface {
+	Now() time.Time
+	Sleep(d time.Duration)
+}
+
+// SystemClock the Clock implementation by the time package
+type SystemClock struct{}
+
+// Now get current time
+func (SystemClock) Now() time.Time {
+	return time.Now()
+}
+
+// Sleep for the duration
+func (SystemClock) Sleep(d time.Duration) {
+	time.Sleep(d)
+}
+
+var (
+	clockMu sync.RWMutex
+	clock   Clock = SystemClock{}
+)
+
+// SetClock set the clock for timex, returns the old clock.
+// set nil will reset to the SystemClock.
+func SetClock(c Clock) (old Clock) {
+	if c == nil {
+		c = SystemClock{}
+	}
+
+	clockMu.Lock()
+	old, clock = clock, c
+	clockMu.Unlock()
+	return
+}
+
+// ResetClock reset the clock to the SystemClock
+func ResetClock() {
+	SetClock(nil)
+}
+
+// CurrentClock get the current used clock
+func CurrentClock() Clock {
+	clockMu.RLock()
+	defer clockMu.RUnlock()
+	return clock
+}
+
+// NowTime get current time by the clock.
+func NowTime() time.Time {
+	return CurrentClock().Now()
+}
+
+// Sleep for the duration by the clock.
+func Sleep(d time.Duration) {
+	CurrentClock().Sleep(d)
+}
+
+// Since get the duration elapsed since t by the clock.
+func Since(t time.Time) time.Duration {
+	return NowTime().Sub(t)
+}
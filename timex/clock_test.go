@@ -0,0 +1,34 @@
+package timex_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gookit/goutil/timex"
+	"github.com/stretchr/testify/assert"
+)
+
+type fixedClock struct {
+	now   time.Time
+	slept time.Duration
+}
+
+func (c *fixedClock) Now() time.Time        { return c.now }
+func (c *fixedClock) Sleep(d time.Duration) { c.slept += d }
+
+func TestSetClock(t *testing.T) {
+	fc := &fixedClock{now: time.Date(2022, 2, 3, 10, 20, 30, 0, time.UTC)}
+	old := timex.SetClock(fc)
+	defer timex.SetClock(old)
+
+	assert.Equal(t, fc.now, timex.NowTime())
+	assert.Equal(t, fc.now.Unix(), timex.NowUnix())
+	assert.Equal(t, fc.now.AddDate(0, 0, 1), timex.NowAddDay(1))
+	assert.Equal(t, time.Hour, timex.Since(fc.now.Add(-time.Hour)))
+
+	timex.Sleep(time.Second)
+	assert.Equal(t, time.Second, fc.slept)
+
+	timex.ResetClock()
+	assert.IsType(t, timex.SystemClock{}, timex.CurrentClock())
+}
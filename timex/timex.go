@@ -38,7 +38,7 @@ type TimeX struct {
 // Now time
 func Now() *TimeX {
 	return &TimeX{
-		Time:   time.Now(),
+		Time:   NowTime(),
 		Layout: DefaultLayout,
 	}
 }
@@ -53,7 +53,7 @@ func New(t time.Time) *TimeX {
 
 // Local time for now
 func Local() *TimeX {
-	return New(time.Now().In(time.Local))
+	return New(NowTime().In(time.Local))
 }
 
 // FromUnix create from unix time
@@ -79,7 +79,7 @@ func LocalByName(tzName string) *TimeX {
 		panic(err)
 	}
 
-	return New(time.Now().In(loc))
+	return New(NowTime().In(loc))
 }
 
 // SetLocalByName set local by tz name. eg: UTC, PRC
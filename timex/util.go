@@ -9,7 +9,7 @@ import (
 
 // NowUnix is short of time.Now().Unix()
 func NowUnix() int64 {
-	return time.Now().Unix()
+	return NowTime().Unix()
 }
 
 // Format use default layout
@@ -58,22 +58,22 @@ func FormatUnixByTpl(sec int64, template string) string {
 
 // NowAddDay add some day time from now
 func NowAddDay(day int) time.Time {
-	return time.Now().AddDate(0, 0, day)
+	return NowTime().AddDate(0, 0, day)
 }
 
 // NowAddHour add some hour time from now
 func NowAddHour(hour int) time.Time {
-	return time.Now().Add(time.Duration(hour) * OneHour)
+	return NowTime().Add(time.Duration(hour) * OneHour)
 }
 
 // NowAddMinutes add some minutes time from now
 func NowAddMinutes(minutes int) time.Time {
-	return time.Now().Add(time.Duration(minutes) * OneMin)
+	return NowTime().Add(time.Duration(minutes) * OneMin)
 }
 
 // NowAddSeconds add some seconds time from now
 func NowAddSeconds(seconds int) time.Time {
-	return time.Now().Add(time.Duration(seconds) * time.Second)
+	return NowTime().Add(time.Duration(seconds) * time.Second)
 }
 
 // AddDay add some day time for given time
@@ -122,17 +122,17 @@ func DayEnd(t time.Time) time.Time {
 
 // NowHourStart time
 func NowHourStart() time.Time {
-	return HourStart(time.Now())
+	return HourStart(NowTime())
 }
 
 // TodayStart time
 func TodayStart() time.Time {
-	return DayStart(time.Now())
+	return DayStart(NowTime())
 }
 
 // TodayEnd time
 func TodayEnd() time.Time {
-	return DayEnd(time.Now())
+	return DayEnd(NowTime())
 }
 
 // HowLongAgo format given timestamp to string.